@@ -9,7 +9,10 @@ import (
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/database/rpcdb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/chitlog"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/decisiontrace"
 	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/rpc"
 )
@@ -25,6 +28,9 @@ type Client interface {
 	Alias(ctx context.Context, endpoint string, alias string, options ...rpc.Option) error
 	AliasChain(ctx context.Context, chainID string, alias string, options ...rpc.Option) error
 	GetChainAliases(ctx context.Context, chainID string, options ...rpc.Option) ([]string, error)
+	GetDecisionTrace(ctx context.Context, chainID string, blkID ids.ID, options ...rpc.Option) ([]decisiontrace.Event, error)
+	GetChits(ctx context.Context, chain string, filter chitlog.Filter, options ...rpc.Option) ([]chitlog.Chit, error)
+	ExportSnapshot(ctx context.Context, chain string, path string, options ...rpc.Option) (*ExportSnapshotReply, error)
 	Stacktrace(context.Context, ...rpc.Option) error
 	LoadVMs(context.Context, ...rpc.Option) (map[ids.ID][]string, map[ids.ID]string, error)
 	SetLoggerLevel(ctx context.Context, loggerName, logLevel, displayLevel string, options ...rpc.Option) (map[string]LogAndDisplayLevels, error)
@@ -83,6 +89,40 @@ func (c *client) GetChainAliases(ctx context.Context, chain string, options ...r
 	return res.Aliases, err
 }
 
+func (c *client) GetDecisionTrace(ctx context.Context, chain string, blkID ids.ID, options ...rpc.Option) ([]decisiontrace.Event, error) {
+	res := &GetDecisionTraceReply{}
+	err := c.requester.SendRequest(ctx, "admin.getDecisionTrace", &GetDecisionTraceArgs{
+		Chain:   chain,
+		BlockID: blkID,
+	}, res, options...)
+	return res.Events, err
+}
+
+func (c *client) GetChits(ctx context.Context, chain string, filter chitlog.Filter, options ...rpc.Option) ([]chitlog.Chit, error) {
+	args := &GetChitsArgs{
+		Chain:  chain,
+		NodeID: filter.NodeID,
+		Limit:  json.Uint32(filter.Limit),
+	}
+	if filter.RequestedHeight != nil {
+		height := json.Uint64(*filter.RequestedHeight)
+		args.RequestedHeight = &height
+	}
+
+	res := &GetChitsReply{}
+	err := c.requester.SendRequest(ctx, "admin.getChits", args, res, options...)
+	return res.Chits, err
+}
+
+func (c *client) ExportSnapshot(ctx context.Context, chain string, path string, options ...rpc.Option) (*ExportSnapshotReply, error) {
+	res := &ExportSnapshotReply{}
+	err := c.requester.SendRequest(ctx, "admin.exportSnapshot", &ExportSnapshotArgs{
+		Chain: chain,
+		Path:  path,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) Stacktrace(ctx context.Context, options ...rpc.Option) error {
 	return c.requester.SendRequest(ctx, "admin.stacktrace", struct{}{}, &api.EmptyReply{}, options...)
 }
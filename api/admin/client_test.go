@@ -12,6 +12,8 @@ import (
 
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/chitlog"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/decisiontrace"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/rpc"
 )
@@ -59,6 +61,15 @@ func (mc *mockClient) SendRequest(_ context.Context, _ string, _ interface{}, re
 	case *GetChainAliasesReply:
 		response := mc.response.(*GetChainAliasesReply)
 		*p = *response
+	case *GetDecisionTraceReply:
+		response := mc.response.(*GetDecisionTraceReply)
+		*p = *response
+	case *GetChitsReply:
+		response := mc.response.(*GetChitsReply)
+		*p = *response
+	case *ExportSnapshotReply:
+		response := mc.response.(*ExportSnapshotReply)
+		*p = *response
 	case *LoadVMsReply:
 		response := mc.response.(*LoadVMsReply)
 		*p = *response
@@ -155,6 +166,98 @@ func TestGetChainAliases(t *testing.T) {
 	})
 }
 
+func TestGetDecisionTrace(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		require := require.New(t)
+
+		blkID := ids.GenerateTestID()
+		expectedReply := []decisiontrace.Event{
+			{
+				Type:      decisiontrace.PollStarted,
+				RequestID: 1,
+				BlockID:   blkID,
+			},
+			{
+				Type:    decisiontrace.BlockAccepted,
+				BlockID: blkID,
+				Height:  1,
+			},
+		}
+		mockClient := client{requester: NewMockClient(&GetDecisionTraceReply{
+			Events: expectedReply,
+		}, nil)}
+
+		reply, err := mockClient.GetDecisionTrace(context.Background(), "chain", blkID)
+		require.NoError(err)
+		require.Equal(expectedReply, reply)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&GetDecisionTraceReply{}, errTest)}
+		_, err := mockClient.GetDecisionTrace(context.Background(), "chain", ids.GenerateTestID())
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
+func TestGetChits(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		require := require.New(t)
+
+		nodeID := ids.GenerateTestNodeID()
+		expectedReply := []chitlog.Chit{
+			{
+				RequestID:           1,
+				NodeID:              nodeID,
+				RequestedHeight:     10,
+				PreferredID:         ids.GenerateTestID(),
+				PreferredIDAtHeight: ids.GenerateTestID(),
+				AcceptedID:          ids.GenerateTestID(),
+				AcceptedHeight:      9,
+			},
+		}
+		mockClient := client{requester: NewMockClient(&GetChitsReply{
+			Chits: expectedReply,
+		}, nil)}
+
+		height := uint64(10)
+		reply, err := mockClient.GetChits(context.Background(), "chain", chitlog.Filter{
+			NodeID:          &nodeID,
+			RequestedHeight: &height,
+		})
+		require.NoError(err)
+		require.Equal(expectedReply, reply)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&GetChitsReply{}, errTest)}
+		_, err := mockClient.GetChits(context.Background(), "chain", chitlog.Filter{})
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
+func TestExportSnapshot(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		require := require.New(t)
+
+		expectedReply := &ExportSnapshotReply{
+			BlockID:   ids.GenerateTestID(),
+			Height:    10,
+			SummaryID: ids.GenerateTestID(),
+		}
+		mockClient := client{requester: NewMockClient(expectedReply, nil)}
+
+		reply, err := mockClient.ExportSnapshot(context.Background(), "chain", "snapshot.gz")
+		require.NoError(err)
+		require.Equal(expectedReply, reply)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&ExportSnapshotReply{}, errTest)}
+		_, err := mockClient.ExportSnapshot(context.Background(), "chain", "snapshot.gz")
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
 func TestStacktrace(t *testing.T) {
 	for _, test := range SuccessResponseTests {
 		t.Run(test.name, func(t *testing.T) {
@@ -6,6 +6,7 @@ package admin
 import (
 	"errors"
 	"net/http"
+	"os"
 	"path"
 	"sync"
 
@@ -18,6 +19,8 @@ import (
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/rpcdb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/chitlog"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/decisiontrace"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/formatting"
@@ -39,8 +42,9 @@ const (
 )
 
 var (
-	errAliasTooLong = errors.New("alias length is too long")
-	errNoLogLevel   = errors.New("need to specify either displayLevel or logLevel")
+	errAliasTooLong   = errors.New("alias length is too long")
+	errNoLogLevel     = errors.New("need to specify either displayLevel or logLevel")
+	errNoSnapshotPath = errors.New("need to specify the snapshot path")
 )
 
 type Config struct {
@@ -53,6 +57,10 @@ type Config struct {
 	HTTPServer   server.PathAdderWithReadLock
 	VMRegistry   registry.VMRegistry
 	VMManager    vms.Manager
+	// DecisionTraces may be nil if decisions aren't being traced.
+	DecisionTraces *decisiontrace.Manager
+	// ChitLogs may be nil if chits aren't being logged.
+	ChitLogs *chitlog.Manager
 }
 
 // Admin is the API service for node admin management
@@ -214,6 +222,146 @@ func (a *Admin) GetChainAliases(_ *http.Request, args *GetChainAliasesArgs, repl
 	return err
 }
 
+// GetDecisionTraceArgs are the arguments for calling GetDecisionTrace
+type GetDecisionTraceArgs struct {
+	Chain   string `json:"chain"`
+	BlockID ids.ID `json:"blockID"`
+}
+
+// GetDecisionTraceReply are the traced events that reference a block
+type GetDecisionTraceReply struct {
+	Events []decisiontrace.Event `json:"events"`
+}
+
+// GetDecisionTrace returns the recorded polls and decisions that reference the
+// block
+func (a *Admin) GetDecisionTrace(_ *http.Request, args *GetDecisionTraceArgs, reply *GetDecisionTraceReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "getDecisionTrace"),
+		logging.UserString("chain", args.Chain),
+		zap.Stringer("blockID", args.BlockID),
+	)
+
+	if a.DecisionTraces == nil {
+		return decisiontrace.ErrDisabled
+	}
+
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+
+	reply.Events, err = a.DecisionTraces.Trace(chainID, args.BlockID)
+	return err
+}
+
+// GetChitsArgs are the arguments for calling GetChits
+type GetChitsArgs struct {
+	Chain string `json:"chain"`
+	// NodeID, if non-nil, only returns chits sent to this node
+	NodeID *ids.NodeID `json:"nodeID"`
+	// RequestedHeight, if non-nil, only returns chits that responded to
+	// queries for this height
+	RequestedHeight *json.Uint64 `json:"requestedHeight"`
+	// Limit is the maximum number of chits to return
+	Limit json.Uint32 `json:"limit"`
+}
+
+// GetChitsReply are the chits sent by the node
+type GetChitsReply struct {
+	Chits []chitlog.Chit `json:"chits"`
+}
+
+// GetChits returns the most recent chits sent by the node on the chain
+func (a *Admin) GetChits(_ *http.Request, args *GetChitsArgs, reply *GetChitsReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "getChits"),
+		logging.UserString("chain", args.Chain),
+	)
+
+	if a.ChitLogs == nil {
+		return chitlog.ErrDisabled
+	}
+
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+
+	filter := chitlog.Filter{
+		NodeID: args.NodeID,
+		Limit:  int(args.Limit),
+	}
+	if args.RequestedHeight != nil {
+		height := uint64(*args.RequestedHeight)
+		filter.RequestedHeight = &height
+	}
+	reply.Chits, err = a.ChitLogs.Chits(chainID, filter)
+	return err
+}
+
+// ExportSnapshotArgs are the arguments for calling ExportSnapshot
+type ExportSnapshotArgs struct {
+	Chain string `json:"chain"`
+	Path  string `json:"path"`
+}
+
+// ExportSnapshotReply describes the exported snapshot
+type ExportSnapshotReply struct {
+	BlockID   ids.ID      `json:"blockID"`
+	Height    json.Uint64 `json:"height"`
+	SummaryID ids.ID      `json:"summaryID"`
+}
+
+// ExportSnapshot writes a snapshot of the accepted state of the chain to the
+// provided path
+func (a *Admin) ExportSnapshot(_ *http.Request, args *ExportSnapshotArgs, reply *ExportSnapshotReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "exportSnapshot"),
+		logging.UserString("chain", args.Chain),
+		logging.UserString("path", args.Path),
+	)
+
+	if args.Path == "" {
+		return errNoSnapshotPath
+	}
+
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+
+	// The snapshot is written to a temporary file so that a partially written
+	// snapshot is never left at [args.Path].
+	tmpPath := args.Path + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perms.ReadWrite)
+	if err != nil {
+		return err
+	}
+	header, err := a.ChainManager.ExportSnapshot(chainID, f)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, args.Path)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	reply.BlockID = header.BlockID
+	reply.Height = json.Uint64(header.Height)
+	reply.SummaryID = header.SummaryID
+	return nil
+}
+
 // Stacktrace returns the current global stacktrace
 func (a *Admin) Stacktrace(_ *http.Request, _ *struct{}, _ *api.EmptyReply) error {
 	a.Log.Debug("API called",
@@ -27,6 +27,7 @@ type Client interface {
 	GetBlockchainID(context.Context, string, ...rpc.Option) (ids.ID, error)
 	Peers(context.Context, []ids.NodeID, ...rpc.Option) ([]Peer, error)
 	IsBootstrapped(context.Context, string, ...rpc.Option) (bool, error)
+	GetStateSyncProgress(context.Context, string, ...rpc.Option) (*GetStateSyncProgressReply, error)
 	Upgrades(context.Context, ...rpc.Option) (*upgrade.Config, error)
 	Uptime(context.Context, ...rpc.Option) (*UptimeResponse, error)
 	GetVMs(context.Context, ...rpc.Option) (map[ids.ID][]string, error)
@@ -98,6 +99,14 @@ func (c *client) IsBootstrapped(ctx context.Context, chainID string, options ...
 	return res.IsBootstrapped, err
 }
 
+func (c *client) GetStateSyncProgress(ctx context.Context, chainID string, options ...rpc.Option) (*GetStateSyncProgressReply, error) {
+	res := &GetStateSyncProgressReply{}
+	err := c.requester.SendRequest(ctx, "info.getStateSyncProgress", &GetStateSyncProgressArgs{
+		Chain: chainID,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) Upgrades(ctx context.Context, options ...rpc.Option) (*upgrade.Config, error) {
 	res := &upgrade.Config{}
 	err := c.requester.SendRequest(ctx, "info.upgrades", struct{}{}, res, options...)
@@ -16,6 +16,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/upgrade"
@@ -317,6 +318,41 @@ func (i *Info) IsBootstrapped(_ *http.Request, args *IsBootstrappedArgs, reply *
 	return nil
 }
 
+// GetStateSyncProgressArgs are the arguments for calling GetStateSyncProgress
+type GetStateSyncProgressArgs struct {
+	// Alias of the chain
+	// Can also be the string representation of the chain's ID
+	Chain string `json:"chain"`
+}
+
+// GetStateSyncProgressReply are the results from calling GetStateSyncProgress
+type GetStateSyncProgressReply struct {
+	// True iff the chain is currently state syncing
+	IsStateSyncing bool                    `json:"isStateSyncing"`
+	Progress       block.StateSyncProgress `json:"progress"`
+}
+
+// GetStateSyncProgress returns the state sync progress of [args.Chain]
+// Returns an error if the chain doesn't exist or its VM doesn't report state
+// sync progress
+func (i *Info) GetStateSyncProgress(_ *http.Request, args *GetStateSyncProgressArgs, reply *GetStateSyncProgressReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "getStateSyncProgress"),
+		logging.UserString("chain", args.Chain),
+	)
+
+	if args.Chain == "" {
+		return errNoChainProvided
+	}
+	chainID, err := i.chainManager.Lookup(args.Chain)
+	if err != nil {
+		return fmt.Errorf("there is no chain with alias/ID '%s'", args.Chain)
+	}
+	reply.Progress, reply.IsStateSyncing, err = i.chainManager.StateSyncProgress(chainID)
+	return err
+}
+
 // Upgrades returns the upgrade schedule this node is running.
 func (i *Info) Upgrades(_ *http.Request, _ *struct{}, reply *upgrade.Config) error {
 	i.log.Debug("API called",
@@ -0,0 +1,304 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package cache
+
+import (
+	"sync"
+
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/linked"
+)
+
+var _ resizableCacher[struct{}, any] = (*sizedARC[struct{}, any])(nil)
+
+// sizedARC is a key value store with bounded size that evicts elements using
+// the Adaptive Replacement Cache (ARC) policy.
+//
+// Elements that have been accessed once are held in [recent], and elements
+// that have been accessed more than once are held in [frequent]. The keys of
+// elements evicted from each list are remembered in [recentGhosts] and
+// [frequentGhosts]. Accesses to ghost keys adapt the portion of the cache that
+// is given to [recent]. This makes the cache resistant to scans, which only
+// ever evict elements from [recent], while still adapting to workloads that
+// favor recency.
+//
+// Unlike the original ARC policy, the lists are bounded by the size of their
+// elements rather than by the number of elements.
+type sizedARC[K comparable, V any] struct {
+	lock sync.Mutex
+
+	recent   *linked.Hashmap[K, *sizedElement[V]]
+	frequent *linked.Hashmap[K, *sizedElement[V]]
+	// The ghost lists map the keys of evicted elements to their sizes.
+	recentGhosts   *linked.Hashmap[K, int]
+	frequentGhosts *linked.Hashmap[K, int]
+
+	recentSize         int
+	frequentSize       int
+	recentGhostsSize   int
+	frequentGhostsSize int
+
+	// targetRecentSize is the size that [recent] is adapted towards.
+	targetRecentSize int
+	maxSize          int
+	size             func(K, V) int
+}
+
+// NewSizedARC returns a cache that holds at most [maxSize] worth of elements,
+// as measured by [size], and evicts elements using an adaptive replacement
+// policy. Compared to [NewSizedLRU], the returned cache retains frequently
+// accessed elements when many elements are accessed only once.
+func NewSizedARC[K comparable, V any](maxSize int, size func(K, V) int) Cacher[K, V] {
+	return newSizedARC(maxSize, size)
+}
+
+func newSizedARC[K comparable, V any](maxSize int, size func(K, V) int) *sizedARC[K, V] {
+	return &sizedARC[K, V]{
+		recent:         linked.NewHashmap[K, *sizedElement[V]](),
+		frequent:       linked.NewHashmap[K, *sizedElement[V]](),
+		recentGhosts:   linked.NewHashmap[K, int](),
+		frequentGhosts: linked.NewHashmap[K, int](),
+		maxSize:        maxSize,
+		size:           size,
+	}
+}
+
+func (c *sizedARC[K, V]) Put(key K, value V) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.put(key, value)
+}
+
+func (c *sizedARC[K, V]) Get(key K) (V, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.get(key)
+}
+
+func (c *sizedARC[K, V]) Evict(key K) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.evict(key)
+}
+
+func (c *sizedARC[K, V]) Flush() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.flush()
+}
+
+func (c *sizedARC[_, _]) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.len()
+}
+
+func (c *sizedARC[_, _]) PortionFilled() float64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.portionFilled()
+}
+
+func (c *sizedARC[_, _]) setMaxSize(maxSize int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.maxSize = maxSize
+	c.targetRecentSize = min(c.targetRecentSize, maxSize)
+	c.makeSpace(0, false)
+	c.trimGhosts()
+}
+
+func (c *sizedARC[_, _]) usedSize() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.recentSize + c.frequentSize
+}
+
+func (c *sizedARC[K, V]) put(key K, value V) {
+	newEntrySize := c.size(key, value)
+	if newEntrySize > c.maxSize {
+		// The element can never fit, so it isn't cached. Unlike the LRU
+		// policy, the other elements are kept.
+		c.evict(key)
+		return
+	}
+
+	element := &sizedElement[V]{
+		value: value,
+		size:  newEntrySize,
+	}
+
+	// Updating a cached element counts as an access.
+	if c.removeResident(key) {
+		c.makeSpace(newEntrySize, false)
+		c.putFrequent(key, element)
+		return
+	}
+
+	// A ghost hit means the element would still be cached if the list it was
+	// evicted from had been larger, so that list is grown.
+	if ghostSize, ok := c.recentGhosts.Get(key); ok {
+		delta := adaptationDelta(ghostSize, c.frequentGhostsSize, c.recentGhostsSize)
+		c.targetRecentSize = min(c.targetRecentSize+delta, c.maxSize)
+		c.removeRecentGhost(key, ghostSize)
+
+		c.makeSpace(newEntrySize, false)
+		c.putFrequent(key, element)
+		return
+	}
+	if ghostSize, ok := c.frequentGhosts.Get(key); ok {
+		delta := adaptationDelta(ghostSize, c.recentGhostsSize, c.frequentGhostsSize)
+		c.targetRecentSize = max(c.targetRecentSize-delta, 0)
+		c.removeFrequentGhost(key, ghostSize)
+
+		c.makeSpace(newEntrySize, true)
+		c.putFrequent(key, element)
+		return
+	}
+
+	c.makeSpace(newEntrySize, false)
+	c.recent.Put(key, element)
+	c.recentSize += newEntrySize
+	c.trimGhosts()
+}
+
+func (c *sizedARC[K, V]) get(key K) (V, bool) {
+	if element, ok := c.recent.Get(key); ok {
+		// The element has now been accessed more than once.
+		c.recent.Delete(key)
+		c.recentSize -= element.size
+		c.putFrequent(key, element)
+		return element.value, true
+	}
+	if element, ok := c.frequent.Get(key); ok {
+		c.frequent.Put(key, element) // Mark [k] as MRU.
+		return element.value, true
+	}
+	return utils.Zero[V](), false
+}
+
+func (c *sizedARC[K, _]) evict(key K) {
+	if c.removeResident(key) {
+		return
+	}
+	if ghostSize, ok := c.recentGhosts.Get(key); ok {
+		c.removeRecentGhost(key, ghostSize)
+		return
+	}
+	if ghostSize, ok := c.frequentGhosts.Get(key); ok {
+		c.removeFrequentGhost(key, ghostSize)
+	}
+}
+
+func (c *sizedARC[_, _]) flush() {
+	c.recent.Clear()
+	c.frequent.Clear()
+	c.recentGhosts.Clear()
+	c.frequentGhosts.Clear()
+	c.recentSize = 0
+	c.frequentSize = 0
+	c.recentGhostsSize = 0
+	c.frequentGhostsSize = 0
+	c.targetRecentSize = 0
+}
+
+func (c *sizedARC[_, _]) len() int {
+	return c.recent.Len() + c.frequent.Len()
+}
+
+func (c *sizedARC[_, _]) portionFilled() float64 {
+	if c.maxSize == 0 {
+		return 0
+	}
+	return float64(c.recentSize+c.frequentSize) / float64(c.maxSize)
+}
+
+// removeResident removes [key] from the cached elements and returns true if it
+// was cached.
+func (c *sizedARC[K, _]) removeResident(key K) bool {
+	if element, ok := c.recent.Get(key); ok {
+		c.recent.Delete(key)
+		c.recentSize -= element.size
+		return true
+	}
+	if element, ok := c.frequent.Get(key); ok {
+		c.frequent.Delete(key)
+		c.frequentSize -= element.size
+		return true
+	}
+	return false
+}
+
+func (c *sizedARC[K, V]) putFrequent(key K, element *sizedElement[V]) {
+	c.frequent.Put(key, element)
+	c.frequentSize += element.size
+	c.trimGhosts()
+}
+
+// makeSpace evicts elements until an element of [newEntrySize] can be added
+// without exceeding [c.maxSize]. [frequentGhostHit] should be true if the new
+// element was previously evicted from [frequent].
+func (c *sizedARC[_, _]) makeSpace(newEntrySize int, frequentGhostHit bool) {
+	for c.recentSize+c.frequentSize > c.maxSize-newEntrySize {
+		evictRecent := c.recent.Len() > 0 &&
+			(c.frequent.Len() == 0 ||
+				c.recentSize > c.targetRecentSize ||
+				(frequentGhostHit && c.recentSize == c.targetRecentSize))
+		if evictRecent {
+			key, element, _ := c.recent.Oldest()
+			c.recent.Delete(key)
+			c.recentSize -= element.size
+			c.recentGhosts.Put(key, element.size)
+			c.recentGhostsSize += element.size
+		} else {
+			key, element, _ := c.frequent.Oldest()
+			c.frequent.Delete(key)
+			c.frequentSize -= element.size
+			c.frequentGhosts.Put(key, element.size)
+			c.frequentGhostsSize += element.size
+		}
+	}
+}
+
+// trimGhosts removes the oldest ghost keys so that [recent] and its ghosts
+// don't exceed [c.maxSize], and so that all of the lists don't exceed twice
+// [c.maxSize].
+func (c *sizedARC[_, _]) trimGhosts() {
+	for c.recentGhosts.Len() > 0 && c.recentSize+c.recentGhostsSize > c.maxSize {
+		key, ghostSize, _ := c.recentGhosts.Oldest()
+		c.removeRecentGhost(key, ghostSize)
+	}
+	for c.frequentGhosts.Len() > 0 && c.recentSize+c.frequentSize+c.recentGhostsSize+c.frequentGhostsSize > 2*c.maxSize {
+		key, ghostSize, _ := c.frequentGhosts.Oldest()
+		c.removeFrequentGhost(key, ghostSize)
+	}
+}
+
+func (c *sizedARC[K, _]) removeRecentGhost(key K, ghostSize int) {
+	c.recentGhosts.Delete(key)
+	c.recentGhostsSize -= ghostSize
+}
+
+func (c *sizedARC[K, _]) removeFrequentGhost(key K, ghostSize int) {
+	c.frequentGhosts.Delete(key)
+	c.frequentGhostsSize -= ghostSize
+}
+
+// adaptationDelta returns how much the target size of a list should change
+// after a hit of [ghostSize] on its ghosts. The change is larger when the
+// ghosts are small relative to [otherGhostsSize], as hits are then less likely.
+func adaptationDelta(ghostSize int, otherGhostsSize int, ghostsSize int) int {
+	if ghostsSize == 0 {
+		return ghostSize
+	}
+	return max(ghostSize, ghostSize*otherGhostsSize/ghostsSize)
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package cache
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+const benchmarkIDSize = ids.IDLen + 8
+
+func benchmarkIDSizeFunc(ids.ID, int) int {
+	return benchmarkIDSize
+}
+
+func BenchmarkSizedARCPut(b *testing.B) {
+	for _, size := range []int{5, 250, 10000} {
+		b.Run(fmt.Sprintf("%d", size), func(b *testing.B) {
+			cache := NewSizedARC[ids.ID, int](size*benchmarkIDSize, benchmarkIDSizeFunc)
+			for n := 0; n < b.N; n++ {
+				for i := 0; i < size; i++ {
+					var id ids.ID
+					_, err := rand.Read(id[:])
+					require.NoError(b, err)
+					cache.Put(id, n)
+				}
+				b.StopTimer()
+				cache.Flush()
+				b.StartTimer()
+			}
+		})
+	}
+}
+
+// BenchmarkSizedCacheScan compares the hit rates of the sized caches when a
+// frequently accessed working set is interleaved with scans of elements that
+// are only accessed once.
+func BenchmarkSizedCacheScan(b *testing.B) {
+	const (
+		cacheSize  = 1000
+		workingSet = cacheSize / 2
+		scanLen    = 2 * cacheSize
+	)
+	caches := map[string]func() Cacher[ids.ID, int]{
+		"lru": func() Cacher[ids.ID, int] {
+			return NewSizedLRU[ids.ID, int](cacheSize*benchmarkIDSize, benchmarkIDSizeFunc)
+		},
+		"arc": func() Cacher[ids.ID, int] {
+			return NewSizedARC[ids.ID, int](cacheSize*benchmarkIDSize, benchmarkIDSizeFunc)
+		},
+	}
+	for name, newCache := range caches {
+		b.Run(name, func(b *testing.B) {
+			cache := newCache()
+			var (
+				hits     int
+				accesses int
+				scanned  uint64
+			)
+			access := func(id ids.ID) {
+				accesses++
+				if _, ok := cache.Get(id); ok {
+					hits++
+					return
+				}
+				cache.Put(id, 0)
+			}
+			for n := 0; n < b.N; n++ {
+				for j := 0; j < 2; j++ {
+					for i := 0; i < workingSet; i++ {
+						access(ids.Empty.Prefix(uint64(i)))
+					}
+				}
+				for i := 0; i < scanLen; i++ {
+					scanned++
+					access(ids.Empty.Prefix(uint64(workingSet) + scanned))
+				}
+			}
+			b.ReportMetric(float64(hits)/float64(accesses), "hits/access")
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/cache/cachetest"
+	"github.com/ava-labs/avalanchego/ids"
+
+	. "github.com/ava-labs/avalanchego/cache"
+)
+
+func TestSizedARC(t *testing.T) {
+	cache := NewSizedARC[ids.ID, int64](cachetest.IntSize, cachetest.IntSizeFunc)
+
+	cachetest.TestBasic(t, cache)
+}
+
+func TestSizedARCEviction(t *testing.T) {
+	cache := NewSizedARC[ids.ID, int64](2*cachetest.IntSize, cachetest.IntSizeFunc)
+
+	cachetest.TestEviction(t, cache)
+}
+
+func TestSizedARCScanResistance(t *testing.T) {
+	require := require.New(t)
+
+	const (
+		numHot  = 4
+		numScan = 100
+	)
+	cache := NewSizedARC[ids.ID, int64](2*numHot*cachetest.IntSize, cachetest.IntSizeFunc)
+
+	// Access the hot elements more than once.
+	for i := int64(0); i < numHot; i++ {
+		cache.Put(ids.ID{byte(i)}, i)
+		_, ok := cache.Get(ids.ID{byte(i)})
+		require.True(ok)
+	}
+
+	// Scan through many elements that are only accessed once.
+	for i := int64(numHot); i < numHot+numScan; i++ {
+		cache.Put(ids.ID{byte(i)}, i)
+	}
+
+	for i := int64(0); i < numHot; i++ {
+		value, ok := cache.Get(ids.ID{byte(i)})
+		require.True(ok)
+		require.Equal(i, value)
+	}
+	require.Equal(2*numHot, cache.Len())
+	require.Equal(1.0, cache.PortionFilled())
+}
+
+func TestSizedARCAdaptsToRecency(t *testing.T) {
+	require := require.New(t)
+
+	cache := NewSizedARC[ids.ID, int64](2*cachetest.IntSize, cachetest.IntSizeFunc)
+
+	// Fill the cache with frequently accessed elements.
+	for i := int64(0); i < 2; i++ {
+		cache.Put(ids.ID{byte(i)}, i)
+		cache.Get(ids.ID{byte(i)})
+	}
+
+	// [2] is evicted from the recent elements by [3], so re-inserting [2]
+	// grows the portion of the cache given to recent elements.
+	cache.Put(ids.ID{2}, 2)
+	cache.Put(ids.ID{3}, 3)
+	cache.Put(ids.ID{2}, 2)
+
+	// Because recent elements have been useful, a new element evicts the
+	// frequent element [2] rather than the recent element [3].
+	cache.Put(ids.ID{4}, 4)
+	_, ok := cache.Get(ids.ID{2})
+	require.False(ok)
+	_, ok = cache.Get(ids.ID{3})
+	require.True(ok)
+	_, ok = cache.Get(ids.ID{4})
+	require.True(ok)
+}
+
+func TestSizedARCTooLarge(t *testing.T) {
+	require := require.New(t)
+
+	cache := NewSizedARC[string, struct{}](
+		3,
+		func(key string, _ struct{}) int {
+			return len(key)
+		},
+	)
+
+	cache.Put("a", struct{}{})
+	cache.Put("bb", struct{}{})
+	cache.Put("cccc", struct{}{})
+
+	_, ok := cache.Get("a")
+	require.True(ok)
+	_, ok = cache.Get("bb")
+	require.True(ok)
+	_, ok = cache.Get("cccc")
+	require.False(ok)
+	require.Equal(1.0, cache.PortionFilled())
+}
+
+func TestSizedARCSizeUpdate(t *testing.T) {
+	require := require.New(t)
+
+	cache := NewSizedARC[string, string](
+		5,
+		func(key string, value string) int {
+			return len(key) + len(value)
+		},
+	)
+
+	cache.Put("a", "b")
+	cache.Put("c", "d")
+	require.Equal(0.8, cache.PortionFilled())
+
+	// Growing [a] requires evicting [c].
+	cache.Put("a", "bcd")
+	require.Equal(0.8, cache.PortionFilled())
+
+	value, ok := cache.Get("a")
+	require.True(ok)
+	require.Equal("bcd", value)
+	_, ok = cache.Get("c")
+	require.False(ok)
+
+	cache.Evict("a")
+	require.Zero(cache.Len())
+	require.Zero(cache.PortionFilled())
+}
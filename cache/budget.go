@@ -0,0 +1,290 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// DefaultRebalanceInterval is the default number of Get calls, across all
+	// of the caches registered with a [Budget], between rebalances.
+	DefaultRebalanceInterval = 10_000
+
+	// evenShareDivisor determines the portion of a [Budget] that is split
+	// evenly between its caches, regardless of their hit rates. This guarantees
+	// that an idle cache can warm up once it starts being used.
+	evenShareDivisor = 2
+)
+
+var (
+	ErrDuplicateCache = errors.New("duplicate cache")
+
+	_ Cacher[struct{}, any] = (*budgetedCache[struct{}, any])(nil)
+)
+
+// resizableCacher is a cache whose maximum size can be changed by a [Budget].
+type resizableCacher[K comparable, V any] interface {
+	Cacher[K, V]
+
+	// setMaxSize changes the maximum size of the cache, evicting elements if
+	// the cache is larger than [maxSize].
+	setMaxSize(maxSize int)
+
+	// usedSize returns the size of the elements in the cache.
+	usedSize() int
+}
+
+// budgetMember is the type-independent view of a cache registered with a
+// [Budget].
+type budgetMember interface {
+	name() string
+	setMaxSize(maxSize int)
+	usedSize() int
+	// takeWindow returns the number of hits and misses since the last call.
+	takeWindow() (uint64, uint64)
+	hitsAndMisses() (uint64, uint64)
+}
+
+// BudgetedStats describes a cache registered with a [Budget].
+type BudgetedStats struct {
+	Name string
+	// MaxSize is the portion of the budget currently given to the cache.
+	MaxSize int
+	// Size is the size of the elements in the cache.
+	Size   int
+	Hits   uint64
+	Misses uint64
+}
+
+// Budget bounds the total size of the caches registered with it.
+//
+// The budget is periodically redistributed between the caches. Half of the
+// budget is split evenly, and the other half is split in proportion to the
+// recent hit rate of each cache. This gives memory held by caches that rarely
+// hit to the caches that are benefiting from it, regardless of how often each
+// cache is accessed.
+type Budget struct {
+	maxSize           int
+	rebalanceInterval uint64
+	// accesses is the number of Get calls since the last rebalance.
+	accesses atomic.Uint64
+
+	lock sync.Mutex
+	// members is sorted by name.
+	members []*budgetEntry
+}
+
+type budgetEntry struct {
+	member  budgetMember
+	maxSize int
+	// score is an exponentially decaying average of the member's hit rate.
+	// Windows without any Get calls count as a hit rate of 0.
+	score float64
+}
+
+// NewBudget returns a budget that bounds the total size of its caches to
+// [maxSize]. The budget is rebalanced after every [rebalanceInterval] Get
+// calls. If [rebalanceInterval] is 0, the budget is only rebalanced when caches
+// are registered or unregistered, or when [Budget.Rebalance] is called.
+func NewBudget(maxSize int, rebalanceInterval uint64) *Budget {
+	return &Budget{
+		maxSize:           maxSize,
+		rebalanceInterval: rebalanceInterval,
+	}
+}
+
+// NewBudgetedSizedLRU returns an LRU cache, as returned by [NewSizedLRU], whose
+// size is bounded by [budget]. [name] must be unique in [budget].
+func NewBudgetedSizedLRU[K comparable, V any](budget *Budget, name string, size func(K, V) int) (Cacher[K, V], error) {
+	return register(budget, name, newSizedLRU(0, size))
+}
+
+// NewBudgetedSizedARC returns an ARC cache, as returned by [NewSizedARC], whose
+// size is bounded by [budget]. [name] must be unique in [budget].
+func NewBudgetedSizedARC[K comparable, V any](budget *Budget, name string, size func(K, V) int) (Cacher[K, V], error) {
+	return register(budget, name, newSizedARC(0, size))
+}
+
+func register[K comparable, V any](budget *Budget, name string, cache resizableCacher[K, V]) (Cacher[K, V], error) {
+	c := &budgetedCache[K, V]{
+		resizableCacher: cache,
+		budget:          budget,
+		cacheName:       name,
+	}
+	return c, budget.register(c)
+}
+
+func (b *Budget) register(member budgetMember) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	index, found := slices.BinarySearchFunc(b.members, member.name(), compareEntryName)
+	if found {
+		return fmt.Errorf("%w: %s", ErrDuplicateCache, member.name())
+	}
+	b.members = slices.Insert(b.members, index, &budgetEntry{
+		member: member,
+	})
+	b.rebalance()
+	return nil
+}
+
+// Unregister removes the cache named [name] from the budget and gives its
+// portion of the budget to the other caches. The removed cache is emptied and
+// can no longer hold any elements.
+func (b *Budget) Unregister(name string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	index, found := slices.BinarySearchFunc(b.members, name, compareEntryName)
+	if !found {
+		return
+	}
+	b.members[index].member.setMaxSize(0)
+	b.members = slices.Delete(b.members, index, index+1)
+	b.rebalance()
+}
+
+// Rebalance redistributes the budget between the caches based on their hit
+// rates since the last rebalance.
+func (b *Budget) Rebalance() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.rebalance()
+}
+
+// MaxSize returns the total size that the caches may use.
+func (b *Budget) MaxSize() int {
+	return b.maxSize
+}
+
+// Stats returns the current state of each cache registered with the budget,
+// sorted by name.
+func (b *Budget) Stats() []BudgetedStats {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	stats := make([]BudgetedStats, len(b.members))
+	for i, entry := range b.members {
+		hits, misses := entry.member.hitsAndMisses()
+		stats[i] = BudgetedStats{
+			Name:    entry.member.name(),
+			MaxSize: entry.maxSize,
+			Size:    entry.member.usedSize(),
+			Hits:    hits,
+			Misses:  misses,
+		}
+	}
+	return stats
+}
+
+// accessed is called after every Get call on a registered cache.
+//
+// The caller must not hold the lock of any registered cache.
+func (b *Budget) accessed() {
+	if b.rebalanceInterval == 0 {
+		return
+	}
+	if b.accesses.Add(1)%b.rebalanceInterval == 0 {
+		b.Rebalance()
+	}
+}
+
+// Assumes [b.lock] is held.
+func (b *Budget) rebalance() {
+	numMembers := len(b.members)
+	if numMembers == 0 {
+		return
+	}
+
+	var totalScore float64
+	for _, entry := range b.members {
+		var (
+			hits, misses = entry.member.takeWindow()
+			hitRate      float64
+		)
+		if accesses := hits + misses; accesses > 0 {
+			hitRate = float64(hits) / float64(accesses)
+		}
+		entry.score = entry.score/2 + hitRate
+		totalScore += entry.score
+	}
+
+	var (
+		evenShare     = b.maxSize / evenShareDivisor / numMembers
+		weightedShare = b.maxSize - evenShare*numMembers
+		newMaxSizes   = make([]int, numMembers)
+	)
+	for i, entry := range b.members {
+		newMaxSizes[i] = evenShare
+		if totalScore > 0 {
+			newMaxSizes[i] += int(float64(weightedShare) * entry.score / totalScore)
+		} else {
+			newMaxSizes[i] += weightedShare / numMembers
+		}
+	}
+
+	// Shrink caches before growing others so that the total size of the
+	// caches never exceeds the budget.
+	for i, entry := range b.members {
+		if newMaxSizes[i] < entry.maxSize {
+			entry.maxSize = newMaxSizes[i]
+			entry.member.setMaxSize(entry.maxSize)
+		}
+	}
+	for i, entry := range b.members {
+		if newMaxSizes[i] > entry.maxSize {
+			entry.maxSize = newMaxSizes[i]
+			entry.member.setMaxSize(entry.maxSize)
+		}
+	}
+}
+
+func compareEntryName(entry *budgetEntry, name string) int {
+	return strings.Compare(entry.member.name(), name)
+}
+
+// budgetedCache counts the hits and misses of a cache registered with a [Budget].
+type budgetedCache[K comparable, V any] struct {
+	resizableCacher[K, V]
+
+	budget       *Budget
+	cacheName    string
+	hits         atomic.Uint64
+	misses       atomic.Uint64
+	windowHits   atomic.Uint64
+	windowMisses atomic.Uint64
+}
+
+func (c *budgetedCache[K, V]) Get(key K) (V, bool) {
+	value, ok := c.resizableCacher.Get(key)
+	if ok {
+		c.hits.Add(1)
+		c.windowHits.Add(1)
+	} else {
+		c.misses.Add(1)
+		c.windowMisses.Add(1)
+	}
+	c.budget.accessed()
+	return value, ok
+}
+
+func (c *budgetedCache[_, _]) name() string {
+	return c.cacheName
+}
+
+func (c *budgetedCache[_, _]) takeWindow() (uint64, uint64) {
+	return c.windowHits.Swap(0), c.windowMisses.Swap(0)
+}
+
+func (c *budgetedCache[_, _]) hitsAndMisses() (uint64, uint64) {
+	return c.hits.Load(), c.misses.Load()
+}
@@ -0,0 +1,214 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/cache/cachetest"
+	"github.com/ava-labs/avalanchego/ids"
+
+	. "github.com/ava-labs/avalanchego/cache"
+)
+
+var budgetedCaches = []struct {
+	name     string
+	newCache func(*Budget, string) (Cacher[ids.ID, int64], error)
+}{
+	{
+		name: "sized LRU",
+		newCache: func(b *Budget, name string) (Cacher[ids.ID, int64], error) {
+			return NewBudgetedSizedLRU(b, name, cachetest.IntSizeFunc)
+		},
+	},
+	{
+		name: "sized ARC",
+		newCache: func(b *Budget, name string) (Cacher[ids.ID, int64], error) {
+			return NewBudgetedSizedARC(b, name, cachetest.IntSizeFunc)
+		},
+	},
+}
+
+func TestBudgetedCache(t *testing.T) {
+	for _, budgetedCache := range budgetedCaches {
+		t.Run(budgetedCache.name, func(t *testing.T) {
+			for _, test := range cachetest.Tests {
+				budget := NewBudget(test.Size*cachetest.IntSize, DefaultRebalanceInterval)
+				cache, err := budgetedCache.newCache(budget, "cache")
+				require.NoError(t, err)
+				test.Func(t, cache)
+			}
+		})
+	}
+}
+
+func TestBudgetRegister(t *testing.T) {
+	require := require.New(t)
+
+	budget := NewBudget(100, 0)
+	_, err := NewBudgetedSizedLRU(budget, "b", cachetest.IntSizeFunc)
+	require.NoError(err)
+	require.Equal(
+		[]BudgetedStats{
+			{Name: "b", MaxSize: 100},
+		},
+		budget.Stats(),
+	)
+
+	_, err = NewBudgetedSizedARC(budget, "a", cachetest.IntSizeFunc)
+	require.NoError(err)
+	require.Equal(
+		[]BudgetedStats{
+			{Name: "a", MaxSize: 50},
+			{Name: "b", MaxSize: 50},
+		},
+		budget.Stats(),
+	)
+
+	_, err = NewBudgetedSizedLRU(budget, "a", cachetest.IntSizeFunc)
+	require.ErrorIs(err, ErrDuplicateCache)
+}
+
+func TestBudgetRebalance(t *testing.T) {
+	require := require.New(t)
+
+	const maxElements = 8
+	budget := NewBudget(maxElements*cachetest.IntSize, 0)
+	busy, err := NewBudgetedSizedLRU(budget, "busy", cachetest.IntSizeFunc)
+	require.NoError(err)
+	idle, err := NewBudgetedSizedLRU(budget, "idle", cachetest.IntSizeFunc)
+	require.NoError(err)
+
+	for i := int64(0); i < maxElements/2; i++ {
+		busy.Put(ids.ID{byte(i)}, i)
+		idle.Put(ids.ID{byte(i)}, i)
+	}
+	for i := int64(0); i < maxElements/2; i++ {
+		_, ok := busy.Get(ids.ID{byte(i)})
+		require.True(ok)
+	}
+	_, ok := idle.Get(ids.ID{maxElements})
+	require.False(ok)
+
+	// The idle cache keeps its even share of the budget, and the busy cache
+	// is given the rest.
+	budget.Rebalance()
+	require.Equal(
+		[]BudgetedStats{
+			{
+				Name:    "busy",
+				MaxSize: 3 * maxElements / 4 * cachetest.IntSize,
+				Size:    maxElements / 2 * cachetest.IntSize,
+				Hits:    maxElements / 2,
+			},
+			{
+				Name:    "idle",
+				MaxSize: maxElements / 4 * cachetest.IntSize,
+				Size:    maxElements / 4 * cachetest.IntSize,
+				Misses:  1,
+			},
+		},
+		budget.Stats(),
+	)
+	require.Equal(maxElements/4, idle.Len())
+
+	// Once the idle cache has a higher recent hit rate, it is given more of
+	// the budget.
+	idle.Put(ids.Empty, 0)
+	for i := 0; i < maxElements; i++ {
+		_, ok := idle.Get(ids.Empty)
+		require.True(ok)
+	}
+	budget.Rebalance()
+
+	stats := budget.Stats()
+	require.Less(stats[0].MaxSize, stats[1].MaxSize)
+	require.LessOrEqual(stats[0].MaxSize+stats[1].MaxSize, budget.MaxSize())
+}
+
+func TestBudgetRebalanceHitRate(t *testing.T) {
+	require := require.New(t)
+
+	budget := NewBudget(100, 0)
+	frequent, err := NewBudgetedSizedLRU(budget, "frequent", cachetest.IntSizeFunc)
+	require.NoError(err)
+	rare, err := NewBudgetedSizedLRU(budget, "rare", cachetest.IntSizeFunc)
+	require.NoError(err)
+
+	frequent.Put(ids.Empty, 0)
+	rare.Put(ids.Empty, 0)
+
+	// The frequently accessed cache has more hits, but only half of its Get
+	// calls hit.
+	for i := 0; i < 10; i++ {
+		_, ok := frequent.Get(ids.Empty)
+		require.True(ok)
+		_, ok = frequent.Get(ids.ID{1})
+		require.False(ok)
+	}
+	_, ok := rare.Get(ids.Empty)
+	require.True(ok)
+
+	// The budget is split by hit rate rather than by the number of hits.
+	budget.Rebalance()
+	stats := budget.Stats()
+	require.Equal(uint64(10), stats[0].Hits)
+	require.Equal(uint64(1), stats[1].Hits)
+	require.Equal(25+50/3, stats[0].MaxSize)
+	require.Equal(25+100/3, stats[1].MaxSize)
+}
+
+func TestBudgetAutomaticRebalance(t *testing.T) {
+	require := require.New(t)
+
+	const rebalanceInterval = 4
+	budget := NewBudget(100, rebalanceInterval)
+	busy, err := NewBudgetedSizedLRU(budget, "busy", cachetest.IntSizeFunc)
+	require.NoError(err)
+	_, err = NewBudgetedSizedLRU(budget, "idle", cachetest.IntSizeFunc)
+	require.NoError(err)
+
+	busy.Put(ids.Empty, 0)
+	for i := 0; i < rebalanceInterval-1; i++ {
+		busy.Get(ids.Empty)
+	}
+	require.Equal(50, budget.Stats()[0].MaxSize)
+
+	busy.Get(ids.Empty)
+	require.Equal(75, budget.Stats()[0].MaxSize)
+}
+
+func TestBudgetUnregister(t *testing.T) {
+	require := require.New(t)
+
+	budget := NewBudget(2*cachetest.IntSize, 0)
+	a, err := NewBudgetedSizedARC(budget, "a", cachetest.IntSizeFunc)
+	require.NoError(err)
+	b, err := NewBudgetedSizedARC(budget, "b", cachetest.IntSizeFunc)
+	require.NoError(err)
+
+	a.Put(ids.Empty, 0)
+	b.Put(ids.Empty, 0)
+
+	budget.Unregister("b")
+	budget.Unregister("unknown")
+	require.Zero(b.Len())
+	require.Zero(b.PortionFilled())
+	require.Equal(
+		[]BudgetedStats{
+			{
+				Name:    "a",
+				MaxSize: 2 * cachetest.IntSize,
+				Size:    cachetest.IntSize,
+			},
+		},
+		budget.Stats(),
+	)
+
+	// The name can be reused after being unregistered.
+	_, err = NewBudgetedSizedARC(budget, "b", cachetest.IntSizeFunc)
+	require.NoError(err)
+}
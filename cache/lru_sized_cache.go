@@ -10,7 +10,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/linked"
 )
 
-var _ Cacher[struct{}, any] = (*sizedLRU[struct{}, any])(nil)
+var _ resizableCacher[struct{}, any] = (*sizedLRU[struct{}, any])(nil)
 
 // sizedElement is used to store the element with its size, so we don't
 // calculate the size multiple times.
@@ -34,6 +34,10 @@ type sizedLRU[K comparable, V any] struct {
 }
 
 func NewSizedLRU[K comparable, V any](maxSize int, size func(K, V) int) Cacher[K, V] {
+	return newSizedLRU(maxSize, size)
+}
+
+func newSizedLRU[K comparable, V any](maxSize int, size func(K, V) int) *sizedLRU[K, V] {
 	return &sizedLRU[K, V]{
 		elements: linked.NewHashmap[K, *sizedElement[V]](),
 		maxSize:  maxSize,
@@ -83,6 +87,21 @@ func (c *sizedLRU[_, _]) PortionFilled() float64 {
 	return c.portionFilled()
 }
 
+func (c *sizedLRU[_, _]) setMaxSize(maxSize int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.maxSize = maxSize
+	c.evictUntilSize(maxSize)
+}
+
+func (c *sizedLRU[_, _]) usedSize() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.currentSize
+}
+
 func (c *sizedLRU[K, V]) put(key K, value V) {
 	newEntrySize := c.size(key, value)
 	if newEntrySize > c.maxSize {
@@ -95,11 +114,7 @@ func (c *sizedLRU[K, V]) put(key K, value V) {
 	}
 
 	// Remove elements until the size of elements in the cache <= [c.maxSize].
-	for c.currentSize > c.maxSize-newEntrySize {
-		oldestKey, oldestElement, _ := c.elements.Oldest()
-		c.elements.Delete(oldestKey)
-		c.currentSize -= oldestElement.size
-	}
+	c.evictUntilSize(c.maxSize - newEntrySize)
 
 	c.elements.Put(key, &sizedElement[V]{
 		value: value,
@@ -135,5 +150,21 @@ func (c *sizedLRU[_, _]) len() int {
 }
 
 func (c *sizedLRU[_, _]) portionFilled() float64 {
+	if c.maxSize == 0 {
+		return 0
+	}
 	return float64(c.currentSize) / float64(c.maxSize)
 }
+
+// evictUntilSize removes the least recently used elements until the size of
+// the elements in the cache is at most [size].
+func (c *sizedLRU[_, _]) evictUntilSize(size int) {
+	for c.currentSize > size {
+		oldestKey, oldestElement, ok := c.elements.Oldest()
+		if !ok {
+			return
+		}
+		c.elements.Delete(oldestKey)
+		c.currentSize -= oldestElement.size
+	}
+}
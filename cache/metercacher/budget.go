@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package metercacher
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/cache"
+	"github.com/ava-labs/avalanchego/utils/metric"
+)
+
+const cacheLabel = "cache"
+
+var _ prometheus.Collector = (*budgetCollector)(nil)
+
+// RegisterBudget exports the metrics of [budget] and of the caches registered
+// with it. The metrics are read from [budget] when they are collected.
+func RegisterBudget(
+	namespace string,
+	registerer prometheus.Registerer,
+	budget *cache.Budget,
+) error {
+	return registerer.Register(&budgetCollector{
+		budget: budget,
+		maxSize: prometheus.NewDesc(
+			metric.AppendNamespace(namespace, "budget_max_size"),
+			"total size the budgeted caches may use",
+			nil,
+			nil,
+		),
+		cacheMaxSize: prometheus.NewDesc(
+			metric.AppendNamespace(namespace, "budgeted_max_size"),
+			"portion of the budget given to the cache",
+			[]string{cacheLabel},
+			nil,
+		),
+		cacheSize: prometheus.NewDesc(
+			metric.AppendNamespace(namespace, "budgeted_size"),
+			"size of the elements in the cache",
+			[]string{cacheLabel},
+			nil,
+		),
+		cacheGetCount: prometheus.NewDesc(
+			metric.AppendNamespace(namespace, "budgeted_get_count"),
+			"number of get calls",
+			[]string{cacheLabel, resultLabel},
+			nil,
+		),
+	})
+}
+
+type budgetCollector struct {
+	budget *cache.Budget
+
+	maxSize       *prometheus.Desc
+	cacheMaxSize  *prometheus.Desc
+	cacheSize     *prometheus.Desc
+	cacheGetCount *prometheus.Desc
+}
+
+func (c *budgetCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.maxSize
+	ch <- c.cacheMaxSize
+	ch <- c.cacheSize
+	ch <- c.cacheGetCount
+}
+
+func (c *budgetCollector) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(c.maxSize, prometheus.GaugeValue, float64(c.budget.MaxSize()))
+	for _, stats := range c.budget.Stats() {
+		ch <- prometheus.MustNewConstMetric(c.cacheMaxSize, prometheus.GaugeValue, float64(stats.MaxSize), stats.Name)
+		ch <- prometheus.MustNewConstMetric(c.cacheSize, prometheus.GaugeValue, float64(stats.Size), stats.Name)
+		ch <- prometheus.MustNewConstMetric(c.cacheGetCount, prometheus.CounterValue, float64(stats.Hits), stats.Name, hitResult)
+		ch <- prometheus.MustNewConstMetric(c.cacheGetCount, prometheus.CounterValue, float64(stats.Misses), stats.Name, missResult)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package metercacher
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/cache"
+	"github.com/ava-labs/avalanchego/cache/cachetest"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestRegisterBudget(t *testing.T) {
+	require := require.New(t)
+
+	budget := cache.NewBudget(2*cachetest.IntSize, 0)
+	c, err := cache.NewBudgetedSizedLRU(budget, "test", cachetest.IntSizeFunc)
+	require.NoError(err)
+
+	c.Put(ids.Empty, 0)
+	c.Get(ids.Empty)
+	c.Get(ids.ID{1})
+
+	registry := prometheus.NewRegistry()
+	require.NoError(RegisterBudget("node", registry, budget))
+
+	expected := `
+# HELP node_budget_max_size total size the budgeted caches may use
+# TYPE node_budget_max_size gauge
+node_budget_max_size 80
+# HELP node_budgeted_get_count number of get calls
+# TYPE node_budgeted_get_count counter
+node_budgeted_get_count{cache="test",result="hit"} 1
+node_budgeted_get_count{cache="test",result="miss"} 1
+# HELP node_budgeted_max_size portion of the budget given to the cache
+# TYPE node_budgeted_max_size gauge
+node_budgeted_max_size{cache="test"} 80
+# HELP node_budgeted_size size of the elements in the cache
+# TYPE node_budgeted_size gauge
+node_budgeted_size{cache="test"} 40
+`
+	require.NoError(testutil.GatherAndCompare(registry, strings.NewReader(expected)))
+}
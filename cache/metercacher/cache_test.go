@@ -33,6 +33,12 @@ func TestInterface(t *testing.T) {
 				return cache.NewSizedLRU[ids.ID, int64](size*cachetest.IntSize, cachetest.IntSizeFunc)
 			},
 		},
+		{
+			description: "sized cache ARC",
+			setup: func(size int) cache.Cacher[ids.ID, int64] {
+				return cache.NewSizedARC[ids.ID, int64](size*cachetest.IntSize, cachetest.IntSizeFunc)
+			},
+		},
 	}
 
 	for _, scenario := range scenarios {
@@ -8,6 +8,7 @@ import (
 	"crypto"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"sync"
@@ -19,7 +20,9 @@ import (
 	"github.com/ava-labs/avalanchego/api/health"
 	"github.com/ava-labs/avalanchego/api/metrics"
 	"github.com/ava-labs/avalanchego/api/server"
+	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/chains/snapshot"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/meterdb"
 	"github.com/ava-labs/avalanchego/database/prefixdb"
@@ -35,6 +38,8 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/common/tracker"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/chitlog"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/decisiontrace"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/syncer"
 	"github.com/ava-labs/avalanchego/snow/networking/handler"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
@@ -61,6 +66,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/proposervm"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/vms/tracedvm"
+	"github.com/ava-labs/avalanchego/vms/txs/waiter"
 
 	p2ppb "github.com/ava-labs/avalanchego/proto/pb/p2p"
 	smcon "github.com/ava-labs/avalanchego/snow/consensus/snowman"
@@ -79,6 +85,14 @@ const (
 	defaultChannelSize = 1
 	initialQueueSize   = 3
 
+	// txWaiterName is the name that the tx waiters of VMs are registered with
+	// in the block acceptor group.
+	txWaiterName = "txWaiter"
+
+	// proposerHealthCheckSuffix is appended to the primary alias of a Snowman
+	// chain to name the health check of its proposer slots.
+	proposerHealthCheckSuffix = "-proposer"
+
 	avalancheNamespace    = constants.PlatformName + metric.NamespaceSeparator + "avalanche"
 	handlerNamespace      = constants.PlatformName + metric.NamespaceSeparator + "handler"
 	meterchainvmNamespace = constants.PlatformName + metric.NamespaceSeparator + "meterchainvm"
@@ -106,6 +120,7 @@ var (
 	errCreatePlatformVM        = errors.New("attempted to create a chain running the PlatformVM")
 	errNotBootstrapped         = errors.New("subnets not bootstrapped")
 	errPartialSyncAsAValidator = errors.New("partial sync should not be configured for a validator")
+	errUnknownChain            = errors.New("unknown chain")
 
 	fxs = map[ids.ID]fx.Factory{
 		secp256k1fx.ID: &secp256k1fx.Factory{},
@@ -144,6 +159,15 @@ type Manager interface {
 	// Returns true iff the chain with the given ID exists and is finished bootstrapping
 	IsBootstrapped(ids.ID) bool
 
+	// ExportSnapshot writes a snapshot of the accepted state of the chain with
+	// the given ID to the writer.
+	ExportSnapshot(ids.ID, io.Writer) (snapshot.Header, error)
+
+	// StateSyncProgress returns the state sync progress reported by the VM of
+	// the chain with the given ID and whether the chain is currently state
+	// syncing.
+	StateSyncProgress(ids.ID) (block.StateSyncProgress, bool, error)
+
 	// Starts the chain creator with the initial platform chain parameters, must
 	// be called once.
 	StartChainCreator(platformChain ChainParameters) error
@@ -171,6 +195,8 @@ type chain struct {
 	Name    string
 	Context *snow.ConsensusContext
 	VM      common.VM
+	// VMDB is the database of the VM, if snapshots of the chain are supported.
+	VMDB    database.Database
 	Handler handler.Handler
 }
 
@@ -243,6 +269,20 @@ type ManagerConfig struct {
 	ChainDataDir string
 
 	Subnets *Subnets
+
+	// DecisionTraces provides the tracers of each Snowman chain's decisions.
+	DecisionTraces *decisiontrace.Manager
+
+	// ChitLogs provides the logs of the chits sent by each Snowman chain.
+	ChitLogs *chitlog.Manager
+
+	// CacheBudget, if non-nil, is shared with the chains so that their
+	// database caches are bounded by a single budget.
+	CacheBudget *cache.Budget
+
+	// SnapshotImport, if non-nil, is imported into its chain before the chain
+	// is initialized for the first time.
+	SnapshotImport *SnapshotImport
 }
 
 type manager struct {
@@ -267,6 +307,12 @@ type manager struct {
 	// Key: Chain's ID
 	// Value: The chain
 	chains map[ids.ID]handler.Handler
+	// Key: Chain's ID
+	// Value: The VM of the chain
+	vms map[ids.ID]common.VM
+	// Key: Chain's ID
+	// Value: The database of the chain's VM, if snapshots are supported
+	vmDBs map[ids.ID]database.Database
 
 	// snowman++ related interface to allow validators retrieval
 	validatorState validators.State
@@ -328,6 +374,8 @@ func New(config *ManagerConfig) (Manager, error) {
 		Aliaser:                ids.NewAliaser(),
 		ManagerConfig:          *config,
 		chains:                 make(map[ids.ID]handler.Handler),
+		vms:                    make(map[ids.ID]common.VM),
+		vmDBs:                  make(map[ids.ID]database.Database),
 		chainsQueue:            buffer.NewUnboundedBlockingDeque[ChainParameters](initialQueueSize),
 		unblockChainCreatorCh:  make(chan struct{}),
 		chainCreatorShutdownCh: make(chan struct{}),
@@ -434,6 +482,10 @@ func (m *manager) createChain(chainParams ChainParameters) {
 
 	m.chainsLock.Lock()
 	m.chains[chainParams.ID] = chain.Handler
+	m.vms[chainParams.ID] = chain.VM
+	if chain.VMDB != nil {
+		m.vmDBs[chainParams.ID] = chain.VMDB
+	}
 	m.chainsLock.Unlock()
 
 	// Associate the newly created chain with its default alias
@@ -512,6 +564,7 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 
 			ValidatorState: m.validatorState,
 			ChainDataDir:   chainDataDir,
+			CacheBudget:    m.CacheBudget,
 		},
 		PrimaryAlias:   primaryAlias,
 		Registerer:     prometheus.NewRegistry(),
@@ -582,6 +635,14 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 		return nil, errUnknownVMType
 	}
 
+	// Wake up the API calls of the VM that are waiting for txs whenever a
+	// block of the chain is accepted.
+	if waiterVM, ok := vm.(waiter.VM); ok {
+		if err := m.BlockAcceptorGroup.RegisterAcceptor(ctx.ChainID, txWaiterName, waiterVM.TxWaiter(), false); err != nil {
+			return nil, err
+		}
+	}
+
 	// Register the chain with the timeout manager
 	if err := m.TimeoutManager.RegisterChain(ctx); err != nil {
 		return nil, err
@@ -789,6 +850,7 @@ func (m *manager) createAvalancheChain(
 			StakingLeafSigner:   m.StakingTLSSigner,
 			StakingCertLeaf:     m.StakingTLSCert,
 			Registerer:          proposervmReg,
+			ProposerPolicy:      subnetCfg.ProposerPolicy,
 		},
 	)
 
@@ -916,7 +978,15 @@ func (m *manager) createAvalancheChain(
 		return nil, fmt.Errorf("couldn't initialize snow base message handler: %w", err)
 	}
 
-	var snowmanConsensus smcon.Consensus = &smcon.Topological{Factory: snowball.SnowflakeFactory}
+	decisionTracer := m.DecisionTraces.New(ctx.ChainID)
+	chitLog, err := m.ChitLogs.New(ctx.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	var snowmanConsensus smcon.Consensus = &smcon.Topological{
+		Factory:  snowball.SnowflakeFactory,
+		Listener: decisionTracer,
+	}
 	if m.TracingEnabled {
 		snowmanConsensus = smcon.Trace(snowmanConsensus, m.Tracer)
 	}
@@ -932,6 +1002,8 @@ func (m *manager) createAvalancheChain(
 		ConnectedValidators: connectedValidators,
 		Params:              consensusParams,
 		Consensus:           snowmanConsensus,
+		DecisionTracer:      decisionTracer,
+		ChitLog:             chitLog,
 	}
 	var snowmanEngine common.Engine
 	snowmanEngine, err = smeng.New(snowmanEngineConfig)
@@ -1039,6 +1111,13 @@ func (m *manager) createAvalancheChain(
 	if err := m.Health.RegisterHealthCheck(primaryAlias, h, ctx.SubnetID.String()); err != nil {
 		return nil, fmt.Errorf("couldn't add health check for chain %s: %w", primaryAlias, err)
 	}
+	if err := m.Health.RegisterHealthCheck(
+		primaryAlias+proposerHealthCheckSuffix,
+		health.CheckerFunc(proposerVM.ProposerHealthCheck),
+		ctx.SubnetID.String(),
+	); err != nil {
+		return nil, fmt.Errorf("couldn't add proposer health check for chain %s: %w", primaryAlias, err)
+	}
 
 	return &chain{
 		Name:    primaryAlias,
@@ -1084,6 +1163,11 @@ func (m *manager) createSnowmanChain(
 	vmDB := prefixdb.New(VMDBPrefix, prefixDB)
 	bootstrappingDB := prefixdb.New(ChainBootstrappingDBPrefix, prefixDB)
 
+	importedSnapshot, err := m.importSnapshot(ctx.ChainID, prefixDB, vmDB)
+	if err != nil {
+		return nil, err
+	}
+
 	// Passes messages from the consensus engine to the network
 	messageSender, err := sender.New(
 		ctx,
@@ -1182,6 +1266,7 @@ func (m *manager) createSnowmanChain(
 			StakingLeafSigner:   m.StakingTLSSigner,
 			StakingCertLeaf:     m.StakingTLSCert,
 			Registerer:          proposervmReg,
+			ProposerPolicy:      subnetCfg.ProposerPolicy,
 		},
 	)
 
@@ -1220,6 +1305,12 @@ func (m *manager) createSnowmanChain(
 		return nil, err
 	}
 
+	if importedSnapshot {
+		if err := m.verifyImportedSnapshot(context.TODO(), prefixDB, vm); err != nil {
+			return nil, err
+		}
+	}
+
 	bootstrapWeight, err := beacons.TotalWeight(ctx.SubnetID)
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching weight for subnet %s: %w", ctx.SubnetID, err)
@@ -1308,7 +1399,15 @@ func (m *manager) createSnowmanChain(
 		return nil, fmt.Errorf("couldn't initialize snow base message handler: %w", err)
 	}
 
-	var consensus smcon.Consensus = &smcon.Topological{Factory: snowball.SnowflakeFactory}
+	decisionTracer := m.DecisionTraces.New(ctx.ChainID)
+	chitLog, err := m.ChitLogs.New(ctx.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	var consensus smcon.Consensus = &smcon.Topological{
+		Factory:  snowball.SnowflakeFactory,
+		Listener: decisionTracer,
+	}
 	if m.TracingEnabled {
 		consensus = smcon.Trace(consensus, m.Tracer)
 	}
@@ -1325,6 +1424,8 @@ func (m *manager) createSnowmanChain(
 		Params:              consensusParams,
 		Consensus:           consensus,
 		PartialSync:         m.PartialSyncPrimaryNetwork && ctx.ChainID == constants.PlatformChainID,
+		DecisionTracer:      decisionTracer,
+		ChitLog:             chitLog,
 	}
 	var engine common.Engine
 	engine, err = smeng.New(engineConfig)
@@ -1403,11 +1504,19 @@ func (m *manager) createSnowmanChain(
 	if err := m.Health.RegisterHealthCheck(primaryAlias, h, ctx.SubnetID.String()); err != nil {
 		return nil, fmt.Errorf("couldn't add health check for chain %s: %w", primaryAlias, err)
 	}
+	if err := m.Health.RegisterHealthCheck(
+		primaryAlias+proposerHealthCheckSuffix,
+		health.CheckerFunc(proposerVM.ProposerHealthCheck),
+		ctx.SubnetID.String(),
+	); err != nil {
+		return nil, fmt.Errorf("couldn't add proposer health check for chain %s: %w", primaryAlias, err)
+	}
 
 	return &chain{
 		Name:    primaryAlias,
 		Context: ctx,
 		VM:      vm,
+		VMDB:    vmDB,
 		Handler: h,
 	}, nil
 }
@@ -1423,6 +1532,29 @@ func (m *manager) IsBootstrapped(id ids.ID) bool {
 	return chain.Context().State.Get().State == snow.NormalOp
 }
 
+func (m *manager) StateSyncProgress(id ids.ID) (block.StateSyncProgress, bool, error) {
+	m.chainsLock.Lock()
+	chain, exists := m.chains[id]
+	vm := m.vms[id]
+	m.chainsLock.Unlock()
+	if !exists {
+		return block.StateSyncProgress{}, false, fmt.Errorf("%w: %s", errUnknownChain, id)
+	}
+
+	ctx := chain.Context()
+	isStateSyncing := ctx.State.Get().State == snow.StateSyncing
+	progressVM, ok := vm.(block.StateSyncProgressVM)
+	if !ok {
+		return block.StateSyncProgress{}, isStateSyncing, block.ErrStateSyncProgressNotImplemented
+	}
+
+	ctx.Lock.Lock()
+	defer ctx.Lock.Unlock()
+
+	progress, err := progressVM.StateSyncProgress(context.TODO())
+	return progress, isStateSyncing, err
+}
+
 func (m *manager) registerBootstrappedHealthChecks() error {
 	bootstrappedCheck := health.CheckerFunc(func(context.Context) (interface{}, error) {
 		if subnetIDs := m.Subnets.Bootstrapping(); len(subnetIDs) != 0 {
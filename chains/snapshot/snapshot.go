@@ -0,0 +1,342 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package snapshot implements a portable archive of the accepted state of a
+// chain. Archives can be imported by a new node to skip bootstrapping the
+// chain up to the height of the snapshot.
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+const (
+	version uint64 = 0
+
+	entryTag byte = 1
+	endTag   byte = 0
+
+	// maxSummaryLen is the maximum size of the state summary included in the
+	// header.
+	maxSummaryLen = units.MiB
+	// maxEntryLen is the maximum size of a key or value.
+	maxEntryLen = 256 * units.MiB
+	// importBatchSize is the size of the batches written to the database
+	// while importing a snapshot.
+	importBatchSize = 4 * units.MiB
+)
+
+var (
+	magic = []byte("avasnap")
+
+	ErrUntrustedSnapshot = errors.New("snapshot doesn't match the trusted ID")
+	ErrInvalidChecksum   = errors.New("invalid snapshot checksum")
+	errInvalidMagic      = errors.New("invalid snapshot magic")
+	errUnknownVersion    = errors.New("unknown snapshot version")
+	errUnknownTag        = errors.New("unknown snapshot entry tag")
+	errTooLarge          = errors.New("snapshot field too large")
+	errWrongNumEntries   = errors.New("wrong number of snapshot entries")
+)
+
+// Header describes the state contained in a snapshot.
+type Header struct {
+	// ChainID is the chain the state belongs to.
+	ChainID ids.ID `json:"chainID"`
+	// BlockID is the ID of the last accepted block of the state.
+	BlockID ids.ID `json:"blockID"`
+	// Height is the height of [BlockID].
+	Height uint64 `json:"height"`
+	// SummaryID is the ID of the last state summary of the chain, or
+	// ids.Empty if the VM doesn't support state sync.
+	SummaryID ids.ID `json:"summaryID"`
+	// Summary is the last state summary of the chain, if any.
+	Summary []byte `json:"summary"`
+}
+
+// Verify that the snapshot claims to be identified by [trustedID], which can
+// either be the ID of the last accepted block or the ID of the state summary
+// of the snapshot.
+//
+// The IDs in the header aren't derived from its content, so this only rejects
+// snapshots of a different state. If [trustedID] is the ID of the state
+// summary, [Header.VerifySummary] must be called once the VM is able to parse
+// the summary.
+func (h *Header) Verify(trustedID ids.ID) error {
+	if trustedID == ids.Empty || (trustedID != h.BlockID && trustedID != h.SummaryID) {
+		return fmt.Errorf("%w: expected %s but snapshot has block %s and summary %s",
+			ErrUntrustedSnapshot,
+			trustedID,
+			h.BlockID,
+			h.SummaryID,
+		)
+	}
+	return nil
+}
+
+// VerifySummary verifies that the state summary of the snapshot, as parsed by
+// [vm], is identified by [trustedID].
+func (h *Header) VerifySummary(ctx context.Context, vm block.StateSyncableVM, trustedID ids.ID) error {
+	summary, err := vm.ParseStateSummary(ctx, h.Summary)
+	if err != nil {
+		return fmt.Errorf("failed to parse snapshot summary: %w", err)
+	}
+	if summaryID := summary.ID(); summaryID != trustedID {
+		return fmt.Errorf("%w: expected summary %s but got %s",
+			ErrUntrustedSnapshot,
+			trustedID,
+			summaryID,
+		)
+	}
+	return nil
+}
+
+// Write a snapshot of [header] and the key-value pairs of [iterator] to [w].
+//
+// Returns the number of key-value pairs that were written.
+func Write(w io.Writer, header Header, iterator database.Iterator) (uint64, error) {
+	gzipWriter := gzip.NewWriter(w)
+	checksum := sha256.New()
+	writer := bufio.NewWriter(io.MultiWriter(gzipWriter, checksum))
+	e := encoder{w: writer}
+
+	e.bytes(magic)
+	e.uint64(version)
+	e.bytes(header.ChainID[:])
+	e.bytes(header.BlockID[:])
+	e.uint64(header.Height)
+	e.bytes(header.SummaryID[:])
+	e.uvarint(uint64(len(header.Summary)))
+	e.bytes(header.Summary)
+
+	var numEntries uint64
+	for e.err == nil && iterator.Next() {
+		key := iterator.Key()
+		value := iterator.Value()
+		e.bytes([]byte{entryTag})
+		e.uvarint(uint64(len(key)))
+		e.bytes(key)
+		e.uvarint(uint64(len(value)))
+		e.bytes(value)
+		numEntries++
+	}
+	if err := iterator.Error(); err != nil {
+		return 0, err
+	}
+
+	e.bytes([]byte{endTag})
+	e.uint64(numEntries)
+	if e.err != nil {
+		return 0, e.err
+	}
+	if err := writer.Flush(); err != nil {
+		return 0, err
+	}
+
+	// The checksum covers everything that was written before it.
+	if _, err := gzipWriter.Write(checksum.Sum(nil)); err != nil {
+		return 0, err
+	}
+	return numEntries, gzipWriter.Close()
+}
+
+// ReadHeader reads the header of the snapshot in [r] without verifying the
+// rest of the snapshot.
+func ReadHeader(r io.Reader) (Header, error) {
+	d, err := newDecoder(r)
+	if err != nil {
+		return Header{}, err
+	}
+	return d.header()
+}
+
+// Import the snapshot in [r] into [db], after verifying that it is identified
+// by [trustedID].
+//
+// The key-value pairs are written to [db] before the checksum of the snapshot
+// can be verified. If an error is returned, [db] may contain a partially
+// imported snapshot.
+func Import(r io.Reader, db database.Database, trustedID ids.ID) (Header, uint64, error) {
+	d, err := newDecoder(r)
+	if err != nil {
+		return Header{}, 0, err
+	}
+	header, err := d.header()
+	if err != nil {
+		return Header{}, 0, err
+	}
+	if err := header.Verify(trustedID); err != nil {
+		return Header{}, 0, err
+	}
+
+	var (
+		batch      = db.NewBatch()
+		numEntries uint64
+	)
+	for {
+		tag := d.bytes(1)
+		if d.err != nil {
+			return Header{}, 0, d.err
+		}
+		if tag[0] == endTag {
+			break
+		}
+		if tag[0] != entryTag {
+			return Header{}, 0, fmt.Errorf("%w: %d", errUnknownTag, tag[0])
+		}
+
+		key := d.sizedBytes(maxEntryLen)
+		value := d.sizedBytes(maxEntryLen)
+		if d.err != nil {
+			return Header{}, 0, d.err
+		}
+		if err := batch.Put(key, value); err != nil {
+			return Header{}, 0, err
+		}
+		numEntries++
+
+		if batch.Size() >= importBatchSize {
+			if err := batch.Write(); err != nil {
+				return Header{}, 0, err
+			}
+			batch.Reset()
+		}
+	}
+
+	expectedNumEntries := d.uint64()
+	if d.err != nil {
+		return Header{}, 0, d.err
+	}
+	if numEntries != expectedNumEntries {
+		return Header{}, 0, fmt.Errorf("%w: expected %d but read %d",
+			errWrongNumEntries,
+			expectedNumEntries,
+			numEntries,
+		)
+	}
+
+	expectedChecksum := d.checksum.Sum(nil)
+	checksum := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(d.r, checksum); err != nil {
+		return Header{}, 0, err
+	}
+	if !bytes.Equal(expectedChecksum, checksum) {
+		return Header{}, 0, ErrInvalidChecksum
+	}
+	return header, numEntries, batch.Write()
+}
+
+type encoder struct {
+	w   io.Writer
+	err error
+}
+
+func (e *encoder) bytes(b []byte) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.w.Write(b)
+}
+
+func (e *encoder) uint64(v uint64) {
+	e.bytes(binary.BigEndian.AppendUint64(nil, v))
+}
+
+func (e *encoder) uvarint(v uint64) {
+	e.bytes(binary.AppendUvarint(nil, v))
+}
+
+type decoder struct {
+	// r is the decompressed snapshot.
+	r *bufio.Reader
+	// checksum of everything that was read through [reader].
+	checksum hash.Hash
+	// reader reads from [r] while updating [checksum].
+	reader io.Reader
+	err    error
+}
+
+func newDecoder(r io.Reader) (*decoder, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	d := &decoder{
+		r:        bufio.NewReader(gzipReader),
+		checksum: sha256.New(),
+	}
+	d.reader = io.TeeReader(d.r, d.checksum)
+	return d, nil
+}
+
+func (d *decoder) header() (Header, error) {
+	if m := d.bytes(len(magic)); d.err == nil && !bytes.Equal(m, magic) {
+		return Header{}, errInvalidMagic
+	}
+	if v := d.uint64(); d.err == nil && v != version {
+		return Header{}, fmt.Errorf("%w: %d", errUnknownVersion, v)
+	}
+
+	var header Header
+	copy(header.ChainID[:], d.bytes(ids.IDLen))
+	copy(header.BlockID[:], d.bytes(ids.IDLen))
+	header.Height = d.uint64()
+	copy(header.SummaryID[:], d.bytes(ids.IDLen))
+	if summary := d.sizedBytes(maxSummaryLen); len(summary) > 0 {
+		header.Summary = summary
+	}
+	return header, d.err
+}
+
+func (d *decoder) bytes(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	b := make([]byte, n)
+	_, d.err = io.ReadFull(d.reader, b)
+	return b
+}
+
+// ReadByte implements [io.ByteReader] to read uvarints.
+func (d *decoder) ReadByte() (byte, error) {
+	var b [1]byte
+	_, err := io.ReadFull(d.reader, b[:])
+	return b[0], err
+}
+
+func (d *decoder) uint64() uint64 {
+	b := d.bytes(8)
+	if d.err != nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+func (d *decoder) sizedBytes(maxLen uint64) []byte {
+	if d.err != nil {
+		return nil
+	}
+	var n uint64
+	n, d.err = binary.ReadUvarint(d)
+	if d.err != nil {
+		return nil
+	}
+	if n > maxLen {
+		d.err = fmt.Errorf("%w: %d > %d", errTooLarge, n, maxLen)
+		return nil
+	}
+	return d.bytes(int(n))
+}
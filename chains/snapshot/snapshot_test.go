@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snapshot
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+)
+
+func newTestSnapshot(t *testing.T) (Header, database.Database, []byte) {
+	require := require.New(t)
+
+	header := Header{
+		ChainID:   ids.GenerateTestID(),
+		BlockID:   ids.GenerateTestID(),
+		Height:    1234,
+		SummaryID: ids.GenerateTestID(),
+		Summary:   []byte("summary"),
+	}
+
+	db := memdb.New()
+	for i := 0; i < 1000; i++ {
+		key := utils.RandomBytes(32)
+		value := utils.RandomBytes(i)
+		require.NoError(db.Put(key, value))
+	}
+
+	iterator := db.NewIterator()
+	defer iterator.Release()
+
+	var snapshot bytes.Buffer
+	numEntries, err := Write(&snapshot, header, iterator)
+	require.NoError(err)
+	require.Equal(uint64(1000), numEntries)
+	return header, db, snapshot.Bytes()
+}
+
+func TestImport(t *testing.T) {
+	header, expectedDB, snapshot := newTestSnapshot(t)
+
+	tests := []struct {
+		name      string
+		trustedID ids.ID
+	}{
+		{
+			name:      "trusted block ID",
+			trustedID: header.BlockID,
+		},
+		{
+			name:      "trusted summary ID",
+			trustedID: header.SummaryID,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			readHeader, err := ReadHeader(bytes.NewReader(snapshot))
+			require.NoError(err)
+			require.Equal(header, readHeader)
+
+			db := memdb.New()
+			importedHeader, numEntries, err := Import(bytes.NewReader(snapshot), db, test.trustedID)
+			require.NoError(err)
+			require.Equal(header, importedHeader)
+			require.Equal(uint64(1000), numEntries)
+
+			iterator := expectedDB.NewIterator()
+			defer iterator.Release()
+			for iterator.Next() {
+				value, err := db.Get(iterator.Key())
+				require.NoError(err)
+				require.Equal(iterator.Value(), value)
+			}
+			require.NoError(iterator.Error())
+
+			count, err := database.Count(db)
+			require.NoError(err)
+			require.Equal(1000, count)
+		})
+	}
+}
+
+func TestImportUntrusted(t *testing.T) {
+	require := require.New(t)
+
+	_, _, snapshot := newTestSnapshot(t)
+
+	tests := []ids.ID{
+		ids.Empty,
+		ids.GenerateTestID(),
+	}
+	for _, trustedID := range tests {
+		db := memdb.New()
+		_, _, err := Import(bytes.NewReader(snapshot), db, trustedID)
+		require.ErrorIs(err, ErrUntrustedSnapshot)
+
+		count, err := database.Count(db)
+		require.NoError(err)
+		require.Zero(count)
+	}
+}
+
+func TestImportCorrupted(t *testing.T) {
+	require := require.New(t)
+
+	header, _, snapshot := newTestSnapshot(t)
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(snapshot))
+	require.NoError(err)
+	decompressed, err := io.ReadAll(gzipReader)
+	require.NoError(err)
+
+	// Flip a bit of the last value, which isn't covered by the header.
+	corrupted := bytes.Clone(decompressed)
+	corrupted[len(corrupted)-50] ^= 1
+
+	var compressed bytes.Buffer
+	gzipWriter := gzip.NewWriter(&compressed)
+	_, err = gzipWriter.Write(corrupted)
+	require.NoError(err)
+	require.NoError(gzipWriter.Close())
+
+	_, _, err = Import(&compressed, memdb.New(), header.BlockID)
+	require.ErrorIs(err, ErrInvalidChecksum)
+}
+
+func TestReadHeaderInvalidMagic(t *testing.T) {
+	require := require.New(t)
+
+	var compressed bytes.Buffer
+	gzipWriter := gzip.NewWriter(&compressed)
+	_, err := gzipWriter.Write([]byte("not a snapshot at all"))
+	require.NoError(err)
+	require.NoError(gzipWriter.Close())
+
+	_, err = ReadHeader(&compressed)
+	require.ErrorIs(err, errInvalidMagic)
+}
@@ -0,0 +1,312 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/chains/snapshot"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+)
+
+const (
+	snapshotImporting byte = iota
+	snapshotImported
+
+	clearBatchSize = 1024
+)
+
+var (
+	snapshotStatusKey = []byte("snapshot")
+
+	errSnapshotNotSupported   = errors.New("chain doesn't support snapshots")
+	errChainNotBootstrapped   = errors.New("chain not bootstrapped")
+	errChainNotEmpty          = errors.New("chain database isn't empty")
+	errSnapshotMismatch       = errors.New("imported snapshot doesn't match the last accepted block")
+	errInvalidSnapshotStatus  = errors.New("invalid snapshot status")
+	errSnapshotWrongChain     = errors.New("snapshot is of a different chain")
+	errSnapshotAlreadyApplied = errors.New("a different snapshot was already imported")
+)
+
+// SnapshotImport is a snapshot to import into a chain before its VM is
+// initialized for the first time.
+type SnapshotImport struct {
+	// Path of the snapshot file.
+	Path string
+	// TrustedID is the ID of the last accepted block or state summary that
+	// the snapshot must match.
+	TrustedID ids.ID
+	// Header of the snapshot.
+	Header snapshot.Header
+}
+
+// ReadSnapshotImport reads the header of the snapshot at [path] and verifies
+// that it matches [trustedID].
+func ReadSnapshotImport(path string, trustedID ids.ID) (*SnapshotImport, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	header, err := snapshot.ReadHeader(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot %q: %w", path, err)
+	}
+	if err := header.Verify(trustedID); err != nil {
+		return nil, err
+	}
+	return &SnapshotImport{
+		Path:      path,
+		TrustedID: trustedID,
+		Header:    header,
+	}, nil
+}
+
+// importSnapshot imports [m.SnapshotImport] into [vmDB] if it is a snapshot of
+// [chainID] and it wasn't previously imported.
+//
+// The import status is tracked in [chainDB] so that an interrupted import is
+// restarted. The import is only marked as complete by
+// [manager.verifyImportedSnapshot].
+//
+// Returns true if the snapshot was imported.
+func (m *manager) importSnapshot(chainID ids.ID, chainDB database.Database, vmDB database.Database) (bool, error) {
+	imp := m.SnapshotImport
+	if imp == nil || imp.Header.ChainID != chainID {
+		return false, nil
+	}
+
+	status, err := chainDB.Get(snapshotStatusKey)
+	switch {
+	case err == database.ErrNotFound:
+		isEmpty, err := isEmpty(vmDB)
+		if err != nil {
+			return false, err
+		}
+		if !isEmpty {
+			// The chain was previously run without the snapshot.
+			return false, errChainNotEmpty
+		}
+	case err != nil:
+		return false, err
+	case len(status) != 1+ids.IDLen:
+		return false, fmt.Errorf("%w: %x", errInvalidSnapshotStatus, status)
+	case status[0] == snapshotImported:
+		blkID := ids.ID(status[1:])
+		if blkID != imp.Header.BlockID {
+			return false, fmt.Errorf("%w: %s", errSnapshotAlreadyApplied, blkID)
+		}
+		// The snapshot was already imported by a previous run.
+		return false, nil
+	default:
+		m.Log.Info("clearing partially imported snapshot",
+			zap.Stringer("chainID", chainID),
+		)
+		if err := database.Clear(vmDB, clearBatchSize); err != nil {
+			return false, err
+		}
+	}
+
+	m.Log.Info("importing snapshot",
+		zap.Stringer("chainID", chainID),
+		zap.String("path", imp.Path),
+		zap.Stringer("blkID", imp.Header.BlockID),
+		zap.Uint64("height", imp.Header.Height),
+		zap.Stringer("summaryID", imp.Header.SummaryID),
+	)
+	if err := putSnapshotStatus(chainDB, snapshotImporting, imp.Header.BlockID); err != nil {
+		return false, err
+	}
+
+	f, err := os.Open(imp.Path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	header, numEntries, err := snapshot.Import(f, vmDB, imp.TrustedID)
+	if err != nil {
+		return false, fmt.Errorf("failed to import snapshot %q: %w", imp.Path, err)
+	}
+	if header.ChainID != chainID {
+		return false, fmt.Errorf("%w: %s", errSnapshotWrongChain, header.ChainID)
+	}
+
+	m.Log.Info("imported snapshot",
+		zap.Stringer("chainID", chainID),
+		zap.Uint64("numEntries", numEntries),
+	)
+	return true, nil
+}
+
+func isEmpty(db database.Iteratee) (bool, error) {
+	iterator := db.NewIterator()
+	defer iterator.Release()
+
+	return !iterator.Next(), iterator.Error()
+}
+
+func putSnapshotStatus(db database.KeyValueWriter, status byte, blkID ids.ID) error {
+	value := make([]byte, 1+ids.IDLen)
+	value[0] = status
+	copy(value[1:], blkID[:])
+	return db.Put(snapshotStatusKey, value)
+}
+
+// verifyImportedSnapshot verifies that [vm], initialized on the imported
+// snapshot, derives the state that the snapshot is trusted to contain. Once
+// verified, the import is marked as complete in [chainDB]. Otherwise, the
+// snapshot is imported again by the next run.
+//
+// The ID of the last accepted block is derived from its bytes in the imported
+// database. If the snapshot is trusted by the ID of its state summary, the
+// summary in the header and the last state summary derived by [vm] from the
+// imported database must both be identified by the trusted ID. If the snapshot
+// is trusted by the ID of its last accepted block, the rest of the imported
+// state isn't verified.
+func (m *manager) verifyImportedSnapshot(
+	ctx context.Context,
+	chainDB database.KeyValueWriter,
+	vm block.ChainVM,
+) error {
+	var (
+		imp    = m.SnapshotImport
+		header = imp.Header
+	)
+	lastAcceptedID, err := vm.LastAccepted(ctx)
+	if err != nil {
+		return err
+	}
+	lastAccepted, err := vm.GetBlock(ctx, lastAcceptedID)
+	if err != nil {
+		return err
+	}
+	blk, err := vm.ParseBlock(ctx, lastAccepted.Bytes())
+	if err != nil {
+		return err
+	}
+	if blkID := blk.ID(); lastAcceptedID != header.BlockID || blkID != header.BlockID || blk.Height() != header.Height {
+		return fmt.Errorf("%w: expected %s at height %d but got %s at height %d",
+			errSnapshotMismatch,
+			header.BlockID,
+			header.Height,
+			blkID,
+			blk.Height(),
+		)
+	}
+
+	if imp.TrustedID != header.BlockID {
+		ssVM, ok := vm.(block.StateSyncableVM)
+		if !ok {
+			return fmt.Errorf("%w: %s", errSnapshotNotSupported, header.ChainID)
+		}
+		if err := header.VerifySummary(ctx, ssVM, imp.TrustedID); err != nil {
+			return err
+		}
+		summary, err := ssVM.GetLastStateSummary(ctx)
+		if err != nil {
+			return err
+		}
+		if summaryID := summary.ID(); summaryID != imp.TrustedID {
+			return fmt.Errorf("%w: expected summary %s but got %s",
+				errSnapshotMismatch,
+				imp.TrustedID,
+				summaryID,
+			)
+		}
+	}
+	return putSnapshotStatus(chainDB, snapshotImported, header.BlockID)
+}
+
+func (m *manager) ExportSnapshot(chainID ids.ID, w io.Writer) (snapshot.Header, error) {
+	m.chainsLock.Lock()
+	chain, exists := m.chains[chainID]
+	vm := m.vms[chainID]
+	vmDB, supported := m.vmDBs[chainID]
+	m.chainsLock.Unlock()
+	if !exists {
+		return snapshot.Header{}, fmt.Errorf("%w: %s", errUnknownChain, chainID)
+	}
+	chainVM, ok := vm.(block.ChainVM)
+	if !supported || !ok {
+		return snapshot.Header{}, fmt.Errorf("%w: %s", errSnapshotNotSupported, chainID)
+	}
+
+	// The context lock is held until the snapshot is written so that no
+	// blocks are accepted while the database is being iterated.
+	ctx := chain.Context()
+	ctx.Lock.Lock()
+	defer ctx.Lock.Unlock()
+
+	header, iterator, err := snapshotState(ctx, chainVM, vmDB)
+	if err != nil {
+		return snapshot.Header{}, err
+	}
+	defer iterator.Release()
+
+	numEntries, err := snapshot.Write(w, header, iterator)
+	if err != nil {
+		return snapshot.Header{}, err
+	}
+
+	m.Log.Info("exported snapshot",
+		zap.Stringer("chainID", chainID),
+		zap.Stringer("blkID", header.BlockID),
+		zap.Uint64("height", header.Height),
+		zap.Uint64("numEntries", numEntries),
+	)
+	return header, nil
+}
+
+// snapshotState returns the header of a snapshot of the current accepted state
+// and an iterator over the database at that state.
+//
+// Assumes the context lock is held, and must remain held until the iterator is
+// released.
+func snapshotState(
+	ctx *snow.ConsensusContext,
+	vm block.ChainVM,
+	vmDB database.Database,
+) (snapshot.Header, database.Iterator, error) {
+	if ctx.State.Get().State != snow.NormalOp {
+		return snapshot.Header{}, nil, fmt.Errorf("%w: %s", errChainNotBootstrapped, ctx.ChainID)
+	}
+
+	lastAcceptedID, err := vm.LastAccepted(context.TODO())
+	if err != nil {
+		return snapshot.Header{}, nil, err
+	}
+	lastAccepted, err := vm.GetBlock(context.TODO(), lastAcceptedID)
+	if err != nil {
+		return snapshot.Header{}, nil, err
+	}
+
+	header := snapshot.Header{
+		ChainID: ctx.ChainID,
+		BlockID: lastAcceptedID,
+		Height:  lastAccepted.Height(),
+	}
+	if ssVM, ok := vm.(block.StateSyncableVM); ok {
+		summary, err := ssVM.GetLastStateSummary(context.TODO())
+		switch {
+		case err == nil:
+			header.SummaryID = summary.ID()
+			header.Summary = summary.Bytes()
+		case errors.Is(err, database.ErrNotFound), errors.Is(err, block.ErrStateSyncableVMNotImplemented):
+		default:
+			return snapshot.Header{}, nil, err
+		}
+	}
+	return header, vmDB.NewIterator(), nil
+}
@@ -0,0 +1,366 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/chains/snapshot"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
+	"github.com/ava-labs/avalanchego/snow/engine/enginetest"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blocktest"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+var (
+	lastAcceptedIDKey = []byte("lastAcceptedID")
+	lastAcceptedKey   = []byte("lastAccepted")
+	summaryKey        = []byte("summary")
+
+	testBlockBytes   = []byte("block")
+	testSummaryBytes = []byte("summary")
+)
+
+const testHeight = 100
+
+type snapshotTestVM struct {
+	*blocktest.VM
+	*blocktest.StateSyncableVM
+}
+
+// newSnapshotTestVM returns a VM whose state is read from [db]. Block and
+// summary IDs are derived from their bytes.
+func newSnapshotTestVM(t *testing.T, db database.KeyValueReader) *snapshotTestVM {
+	parseBlock := func(_ context.Context, blkBytes []byte) (snowman.Block, error) {
+		return &snowmantest.Block{
+			Decidable: snowtest.Decidable{
+				IDV: hashing.ComputeHash256Array(blkBytes),
+			},
+			HeightV: testHeight,
+			BytesV:  blkBytes,
+		}, nil
+	}
+	parseSummary := func(_ context.Context, summaryBytes []byte) (block.StateSummary, error) {
+		return &blocktest.StateSummary{
+			IDV:     hashing.ComputeHash256Array(summaryBytes),
+			HeightV: testHeight,
+			BytesV:  summaryBytes,
+		}, nil
+	}
+	return &snapshotTestVM{
+		VM: &blocktest.VM{
+			VM: enginetest.VM{T: t},
+			LastAcceptedF: func(context.Context) (ids.ID, error) {
+				return database.GetID(db, lastAcceptedIDKey)
+			},
+			GetBlockF: func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+				blkBytes, err := db.Get(lastAcceptedKey)
+				if err != nil {
+					return nil, err
+				}
+				// The block is indexed by [blkID], regardless of its bytes.
+				return &snowmantest.Block{
+					Decidable: snowtest.Decidable{
+						IDV: blkID,
+					},
+					HeightV: testHeight,
+					BytesV:  blkBytes,
+				}, nil
+			},
+			ParseBlockF: parseBlock,
+		},
+		StateSyncableVM: &blocktest.StateSyncableVM{
+			T: t,
+			GetLastStateSummaryF: func(ctx context.Context) (block.StateSummary, error) {
+				summaryBytes, err := db.Get(summaryKey)
+				if err != nil {
+					return nil, err
+				}
+				return parseSummary(ctx, summaryBytes)
+			},
+			ParseStateSummaryF: parseSummary,
+		},
+	}
+}
+
+// newTestSnapshotHeader returns the header of a snapshot of the state returned
+// by [newTestSnapshotState].
+func newTestSnapshotHeader() snapshot.Header {
+	return snapshot.Header{
+		ChainID:   ids.GenerateTestID(),
+		BlockID:   hashing.ComputeHash256Array(testBlockBytes),
+		Height:    testHeight,
+		SummaryID: hashing.ComputeHash256Array(testSummaryBytes),
+		Summary:   testSummaryBytes,
+	}
+}
+
+func newTestSnapshotState(t *testing.T) database.Database {
+	require := require.New(t)
+
+	stateDB := memdb.New()
+	for i := byte(0); i < 100; i++ {
+		require.NoError(stateDB.Put([]byte{i}, []byte{i, i}))
+	}
+	require.NoError(database.PutID(stateDB, lastAcceptedIDKey, hashing.ComputeHash256Array(testBlockBytes)))
+	require.NoError(stateDB.Put(lastAcceptedKey, testBlockBytes))
+	require.NoError(stateDB.Put(summaryKey, testSummaryBytes))
+	return stateDB
+}
+
+// writeTestSnapshot writes a snapshot of [stateDB] with [header] and returns a
+// manager that imports it if it is identified by [trustedID].
+func writeTestSnapshot(
+	t *testing.T,
+	header snapshot.Header,
+	stateDB database.Database,
+	trustedID ids.ID,
+) *manager {
+	require := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "snapshot")
+	f, err := os.Create(path)
+	require.NoError(err)
+
+	iterator := stateDB.NewIterator()
+	defer iterator.Release()
+
+	_, err = snapshot.Write(f, header, iterator)
+	require.NoError(err)
+	require.NoError(f.Close())
+
+	imp, err := ReadSnapshotImport(path, trustedID)
+	require.NoError(err)
+	require.Equal(header, imp.Header)
+
+	return &manager{
+		ManagerConfig: ManagerConfig{
+			Log:            logging.NoLog{},
+			SnapshotImport: imp,
+		},
+	}
+}
+
+func newTestSnapshotManager(t *testing.T) (*manager, database.Database) {
+	header := newTestSnapshotHeader()
+	stateDB := newTestSnapshotState(t)
+	return writeTestSnapshot(t, header, stateDB, header.BlockID), stateDB
+}
+
+func TestReadSnapshotImportUntrusted(t *testing.T) {
+	m, _ := newTestSnapshotManager(t)
+
+	_, err := ReadSnapshotImport(m.SnapshotImport.Path, ids.GenerateTestID())
+	require.ErrorIs(t, err, snapshot.ErrUntrustedSnapshot)
+}
+
+func TestImportSnapshot(t *testing.T) {
+	require := require.New(t)
+
+	m, stateDB := newTestSnapshotManager(t)
+	chainID := m.SnapshotImport.Header.ChainID
+
+	chainDB := memdb.New()
+	vmDB := prefixdb.New(VMDBPrefix, chainDB)
+
+	// Snapshots of other chains are ignored.
+	imported, err := m.importSnapshot(ids.GenerateTestID(), chainDB, vmDB)
+	require.NoError(err)
+	require.False(imported)
+
+	imported, err = m.importSnapshot(chainID, chainDB, vmDB)
+	require.NoError(err)
+	require.True(imported)
+	requireEqualDB(t, stateDB, vmDB)
+	require.NoError(m.verifyImportedSnapshot(context.Background(), chainDB, newSnapshotTestVM(t, vmDB)))
+
+	// Restarting the chain doesn't import the snapshot again.
+	require.NoError(vmDB.Put([]byte{0}, []byte{1}))
+	imported, err = m.importSnapshot(chainID, chainDB, vmDB)
+	require.NoError(err)
+	require.False(imported)
+
+	value, err := vmDB.Get([]byte{0})
+	require.NoError(err)
+	require.Equal([]byte{1}, value)
+}
+
+func TestImportSnapshotPartial(t *testing.T) {
+	require := require.New(t)
+
+	m, stateDB := newTestSnapshotManager(t)
+	chainID := m.SnapshotImport.Header.ChainID
+
+	chainDB := memdb.New()
+	vmDB := prefixdb.New(VMDBPrefix, chainDB)
+
+	// Simulate an import that was interrupted.
+	require.NoError(putSnapshotStatus(chainDB, snapshotImporting, m.SnapshotImport.Header.BlockID))
+	require.NoError(vmDB.Put([]byte("stale"), []byte("stale")))
+
+	imported, err := m.importSnapshot(chainID, chainDB, vmDB)
+	require.NoError(err)
+	require.True(imported)
+	requireEqualDB(t, stateDB, vmDB)
+}
+
+func TestImportSnapshotErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		setup       func(chainDB database.Database, vmDB database.Database) error
+		expectedErr error
+	}{
+		{
+			name: "chain not empty",
+			setup: func(_ database.Database, vmDB database.Database) error {
+				return vmDB.Put([]byte("key"), []byte("value"))
+			},
+			expectedErr: errChainNotEmpty,
+		},
+		{
+			name: "different snapshot imported",
+			setup: func(chainDB database.Database, _ database.Database) error {
+				return putSnapshotStatus(chainDB, snapshotImported, ids.GenerateTestID())
+			},
+			expectedErr: errSnapshotAlreadyApplied,
+		},
+		{
+			name: "invalid status",
+			setup: func(chainDB database.Database, _ database.Database) error {
+				return chainDB.Put(snapshotStatusKey, []byte{snapshotImported})
+			},
+			expectedErr: errInvalidSnapshotStatus,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			m, _ := newTestSnapshotManager(t)
+			chainDB := memdb.New()
+			vmDB := prefixdb.New(VMDBPrefix, chainDB)
+			require.NoError(test.setup(chainDB, vmDB))
+
+			_, err := m.importSnapshot(m.SnapshotImport.Header.ChainID, chainDB, vmDB)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func TestVerifyImportedSnapshot(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustBySummary bool
+		// tamperHeader modifies the header of the snapshot while keeping the
+		// IDs that it claims.
+		tamperHeader func(*snapshot.Header)
+		// tamperState modifies the state of the snapshot.
+		tamperState func(database.Database) error
+		expectedErr error
+	}{
+		{
+			name: "trusted block",
+		},
+		{
+			name:           "trusted summary",
+			trustBySummary: true,
+		},
+		{
+			name: "tampered block",
+			tamperState: func(db database.Database) error {
+				return db.Put(lastAcceptedKey, []byte("tampered"))
+			},
+			expectedErr: errSnapshotMismatch,
+		},
+		{
+			name: "tampered last accepted",
+			tamperState: func(db database.Database) error {
+				return database.PutID(db, lastAcceptedIDKey, ids.GenerateTestID())
+			},
+			expectedErr: errSnapshotMismatch,
+		},
+		{
+			name:           "tampered header summary",
+			trustBySummary: true,
+			tamperHeader: func(h *snapshot.Header) {
+				h.Summary = []byte("tampered")
+			},
+			expectedErr: snapshot.ErrUntrustedSnapshot,
+		},
+		{
+			name:           "tampered state summary",
+			trustBySummary: true,
+			tamperState: func(db database.Database) error {
+				return db.Put(summaryKey, []byte("tampered"))
+			},
+			expectedErr: errSnapshotMismatch,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			header := newTestSnapshotHeader()
+			trustedID := header.BlockID
+			if test.trustBySummary {
+				trustedID = header.SummaryID
+			}
+			if test.tamperHeader != nil {
+				test.tamperHeader(&header)
+			}
+			stateDB := newTestSnapshotState(t)
+			if test.tamperState != nil {
+				require.NoError(test.tamperState(stateDB))
+			}
+
+			m := writeTestSnapshot(t, header, stateDB, trustedID)
+			chainID := header.ChainID
+			chainDB := memdb.New()
+			vmDB := prefixdb.New(VMDBPrefix, chainDB)
+
+			imported, err := m.importSnapshot(chainID, chainDB, vmDB)
+			require.NoError(err)
+			require.True(imported)
+
+			err = m.verifyImportedSnapshot(context.Background(), chainDB, newSnapshotTestVM(t, vmDB))
+			require.ErrorIs(err, test.expectedErr)
+
+			// The import is only complete if the snapshot was verified.
+			imported, err = m.importSnapshot(chainID, chainDB, vmDB)
+			require.NoError(err)
+			require.Equal(test.expectedErr != nil, imported)
+		})
+	}
+}
+
+func requireEqualDB(t *testing.T, expected database.Iteratee, actual database.Iteratee) {
+	require := require.New(t)
+
+	expectedIterator := expected.NewIterator()
+	defer expectedIterator.Release()
+	actualIterator := actual.NewIterator()
+	defer actualIterator.Release()
+
+	for expectedIterator.Next() {
+		require.True(actualIterator.Next())
+		require.Equal(expectedIterator.Key(), actualIterator.Key())
+		require.Equal(expectedIterator.Value(), actualIterator.Value())
+	}
+	require.False(actualIterator.Next())
+	require.NoError(expectedIterator.Error())
+	require.NoError(actualIterator.Error())
+}
@@ -3,7 +3,13 @@
 
 package chains
 
-import "github.com/ava-labs/avalanchego/ids"
+import (
+	"io"
+
+	"github.com/ava-labs/avalanchego/chains/snapshot"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+)
 
 // TestManager implements Manager but does nothing. Always returns nil error.
 // To be used only in tests
@@ -43,6 +49,14 @@ func (testManager) IsBootstrapped(ids.ID) bool {
 	return false
 }
 
+func (testManager) ExportSnapshot(ids.ID, io.Writer) (snapshot.Header, error) {
+	return snapshot.Header{}, nil
+}
+
+func (testManager) StateSyncProgress(ids.ID) (block.StateSyncProgress, bool, error) {
+	return block.StateSyncProgress{}, false, nil
+}
+
 func (testManager) Lookup(s string) (ids.ID, error) {
 	return ids.FromString(s)
 }
@@ -10,12 +10,14 @@ import (
 )
 
 var (
-	ErrUnsupportedType           = errors.New("unsupported type")
-	ErrMaxSliceLenExceeded       = errors.New("max slice length exceeded")
-	ErrDoesNotImplementInterface = errors.New("does not implement interface")
-	ErrUnexportedField           = errors.New("unexported field")
-	ErrMarshalZeroLength         = errors.New("can't marshal zero length value")
-	ErrUnmarshalZeroLength       = errors.New("can't unmarshal zero length value")
+	ErrUnsupportedType            = errors.New("unsupported type")
+	ErrMaxSliceLenExceeded        = errors.New("max slice length exceeded")
+	ErrDoesNotImplementInterface  = errors.New("does not implement interface")
+	ErrUnexportedField            = errors.New("unexported field")
+	ErrRequiredFieldAfterOptional = errors.New("required field after optional field")
+	ErrNestedOptionalField        = errors.New("optional field in nested struct")
+	ErrMarshalZeroLength          = errors.New("can't marshal zero length value")
+	ErrUnmarshalZeroLength        = errors.New("can't unmarshal zero length value")
 )
 
 // Codec marshals and unmarshals
@@ -26,3 +28,23 @@ type Codec interface {
 	// Returns the size, in bytes, of [value] when it's marshaled
 	Size(value interface{}) (int, error)
 }
+
+// Marshaler is implemented by types with marshalling code generated by
+// codecgen. Codecs use these methods, rather than reflection, to marshal and
+// unmarshal the types.
+//
+// [Codec] is used to handle the values that the generated code doesn't, such
+// as interfaces and maps.
+type Marshaler interface {
+	// CodecSize returns the size, in bytes, of the value when it's marshaled
+	CodecSize(Codec) (int, error)
+	CodecMarshalInto(Codec, *wrappers.Packer) error
+	CodecUnmarshalFrom(Codec, *wrappers.Packer) error
+
+	// CodecValue returns the receiver. If a struct without generated code
+	// embeds a struct with generated code, the methods of the embedded struct
+	// are promoted to it but only marshal the embedded struct. Codecs use
+	// CodecValue to detect promoted methods and marshal such structs with
+	// reflection instead.
+	CodecValue() interface{}
+}
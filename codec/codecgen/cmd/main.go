@@ -0,0 +1,50 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/codec/codecgen"
+)
+
+const commandName = "codecgen"
+
+func main() {
+	rootCmd := &cobra.Command{
+		Use:   commandName + " <file.go>",
+		Short: "Generate codec marshalling code for the structs declared in a file",
+		Long: `Generate codec marshalling code for the structs declared in a file.
+
+The code is written to <file>.codec.go. It is typically run with:
+
+	//go:generate go run github.com/ava-labs/avalanchego/codec/codecgen/cmd $GOFILE`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, args []string) error {
+			code, err := codecgen.Generate(args[0])
+			if err != nil {
+				return err
+			}
+
+			outputFile := codecgen.OutputFile(args[0])
+			if code == nil {
+				// Remove previously generated code.
+				err := os.Remove(outputFile)
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+			return os.WriteFile(outputFile, code, 0o600)
+		},
+	}
+
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
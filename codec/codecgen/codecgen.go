@@ -0,0 +1,638 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package codecgen generates implementations of [codec.Marshaler] for the
+// structs declared in a file, so that codecs don't need to use reflection to
+// marshal them.
+//
+// The generated code produces the same bytes, sizes and errors as
+// reflectcodec, using the [reflectcodec.DefaultTagName] tag. Values that the
+// generated code doesn't handle, such as interfaces, maps and structs declared
+// in other files, are handled by the codec. The codec uses the generated code
+// of those structs if it exists, so the generated code of a file doesn't
+// depend on the generated code of other files.
+package codecgen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/codec/reflectcodec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+const (
+	codecPath    = "github.com/ava-labs/avalanchego/codec"
+	wrappersPath = "github.com/ava-labs/avalanchego/utils/wrappers"
+	mathPath     = "math"
+
+	// Matches the initial capacity of slices unmarshaled by reflectcodec.
+	initialSliceLen = 16
+
+	marshalMethod   = "CodecMarshalInto"
+	unmarshalMethod = "CodecUnmarshalFrom"
+	sizeMethod      = "CodecSize"
+	valueMethod     = "CodecValue"
+
+	generatedSuffix = ".codec.go"
+)
+
+var (
+	errUnexpectedPackages = errors.New("unexpected number of packages")
+	errFileNotFound       = errors.New("file not found in package")
+)
+
+// OutputFile returns the file that the code generated for [file] is written
+// to.
+func OutputFile(file string) string {
+	return strings.TrimSuffix(file, ".go") + generatedSuffix
+}
+
+// Generate returns the code implementing [codec.Marshaler] for the structs
+// declared in [file] that have serialized fields.
+func Generate(file string) ([]byte, error) {
+	file, err := filepath.Abs(file)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	parsedFile, err := parser.ParseFile(fset, file, nil, parser.PackageClauseOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ignore the previously generated code of the package, which may be out of
+	// date.
+	generatedFiles, err := filepath.Glob(filepath.Join(filepath.Dir(file), "*"+generatedSuffix))
+	if err != nil {
+		return nil, err
+	}
+	overlay := map[string][]byte{
+		OutputFile(file): []byte("package " + parsedFile.Name.Name + "\n"),
+	}
+	for _, generatedFile := range generatedFiles {
+		overlay[generatedFile] = overlay[OutputFile(file)]
+	}
+
+	pkgs, err := packages.Load(
+		&packages.Config{
+			Mode:    packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+			Dir:     filepath.Dir(file),
+			Overlay: overlay,
+		},
+		".",
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("%w: %d", errUnexpectedPackages, len(pkgs))
+	}
+	pkg := pkgs[0]
+	if len(pkg.Errors) != 0 {
+		return nil, pkg.Errors[0]
+	}
+
+	var syntax *ast.File
+	for i, compiledFile := range pkg.CompiledGoFiles {
+		if compiledFile == file {
+			syntax = pkg.Syntax[i]
+			break
+		}
+	}
+	if syntax == nil {
+		return nil, fmt.Errorf("%w: %s", errFileNotFound, file)
+	}
+
+	g := newGenerator(pkg.Types)
+	for _, decl := range syntax.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			if typeSpec.TypeParams != nil {
+				continue
+			}
+			named, ok := pkg.TypesInfo.Defs[typeSpec.Name].Type().(*types.Named)
+			if !ok {
+				continue
+			}
+			if _, ok := named.Underlying().(*types.Struct); !ok {
+				continue
+			}
+			if err := g.addType(named); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return g.generate(filepath.Base(file))
+}
+
+type field struct {
+	name     string
+	typ      types.Type
+	optional bool
+}
+
+// serializedFields returns the fields of [s] that reflectcodec serializes.
+func serializedFields(s *types.Struct) ([]field, error) {
+	var (
+		fields      []field
+		numRequired int
+	)
+	for i := 0; i < s.NumFields(); i++ {
+		f := s.Field(i)
+		tag := reflect.StructTag(s.Tag(i))
+		if tag.Get(reflectcodec.DefaultTagName) != reflectcodec.TagValue {
+			continue
+		}
+		if !f.Exported() {
+			return nil, fmt.Errorf("can not marshal %w: %s", codec.ErrUnexportedField, f.Name())
+		}
+
+		optional := tag.Get(reflectcodec.OptionalTagName) == reflectcodec.TagValue
+		if !optional {
+			if numRequired != len(fields) {
+				return nil, fmt.Errorf("can not marshal %w: %s", codec.ErrRequiredFieldAfterOptional, f.Name())
+			}
+			numRequired++
+		}
+		fields = append(fields, field{
+			name:     f.Name(),
+			typ:      f.Type(),
+			optional: optional,
+		})
+	}
+	return fields, nil
+}
+
+type namedType struct {
+	named  *types.Named
+	fields []field
+}
+
+type generator struct {
+	pkg *types.Package
+	// Types that code is generated for.
+	types     []namedType
+	generated map[*types.Named]bool
+
+	// Import path -> name the package is imported as.
+	imports map[string]string
+	// Name the package is imported as -> import path.
+	importNames map[string]string
+	usesMath    bool
+
+	buf bytes.Buffer
+	// Used to create unique variable names within a function.
+	numVars int
+	err     error
+}
+
+func newGenerator(pkg *types.Package) *generator {
+	g := &generator{
+		pkg:         pkg,
+		generated:   make(map[*types.Named]bool),
+		imports:     make(map[string]string),
+		importNames: make(map[string]string),
+	}
+	for _, importPath := range []string{codecPath, wrappersPath, mathPath} {
+		name := path.Base(importPath)
+		g.imports[importPath] = name
+		g.importNames[name] = importPath
+	}
+	return g
+}
+
+func (g *generator) addType(named *types.Named) error {
+	fields, err := serializedFields(named.Underlying().(*types.Struct))
+	if err != nil {
+		return fmt.Errorf("%s: %w", named.Obj().Name(), err)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	g.types = append(g.types, namedType{
+		named:  named,
+		fields: fields,
+	})
+	g.generated[named] = true
+	return nil
+}
+
+func (g *generator) generate(source string) ([]byte, error) {
+	if len(g.types) == 0 {
+		return nil, nil
+	}
+
+	g.printf("var (\n")
+	for _, t := range g.types {
+		g.printf("_ codec.Marshaler = (*%s)(nil)\n", t.named.Obj().Name())
+	}
+	g.printf(")\n")
+	for _, t := range g.types {
+		g.generateSize(t)
+		g.generateMarshal(t)
+		g.generateUnmarshal(t)
+		g.generateValue(t)
+	}
+	if g.err != nil {
+		return nil, g.err
+	}
+	body := g.buf.Bytes()
+
+	var file bytes.Buffer
+	fmt.Fprintf(&file, "// Code generated by codecgen. DO NOT EDIT.\n// source: %s\n\n", source)
+	fmt.Fprintf(&file, "package %s\n\n", g.pkg.Name())
+	file.WriteString("import (\n")
+	// Standard library imports are grouped before other imports.
+	var stdPaths, paths []string
+	for importPath := range g.imports {
+		switch {
+		case importPath == mathPath && !g.usesMath:
+		case !strings.Contains(importPath, "."):
+			stdPaths = append(stdPaths, importPath)
+		default:
+			paths = append(paths, importPath)
+		}
+	}
+	slices.Sort(stdPaths)
+	slices.Sort(paths)
+	for i, group := range [][]string{stdPaths, paths} {
+		if i != 0 {
+			file.WriteString("\n")
+		}
+		for _, importPath := range group {
+			if name := g.imports[importPath]; name != path.Base(importPath) {
+				fmt.Fprintf(&file, "%s ", name)
+			}
+			fmt.Fprintf(&file, "%q\n", importPath)
+		}
+	}
+	file.WriteString(")\n\n")
+	file.Write(body)
+	return format.Source(file.Bytes())
+}
+
+func (g *generator) generateSize(t namedType) {
+	g.numVars = 0
+	g.printf("\nfunc (t *%s) %s(c codec.Codec) (int, error) {\n", t.named.Obj().Name(), sizeMethod)
+	g.printf("size := 0\n")
+	for _, f := range t.fields {
+		g.size("t."+f.name, f.typ)
+	}
+	g.printf("return size, nil\n}\n")
+}
+
+func (g *generator) generateMarshal(t namedType) {
+	g.numVars = 0
+	g.printf("\nfunc (t *%s) %s(c codec.Codec, p *wrappers.Packer) error {\n", t.named.Obj().Name(), marshalMethod)
+	for _, f := range t.fields {
+		g.marshal("t."+f.name, f.typ)
+	}
+	g.printf("return p.Err\n}\n")
+}
+
+func (g *generator) generateUnmarshal(t namedType) {
+	g.numVars = 0
+	g.printf("\nfunc (t *%s) %s(c codec.Codec, p *wrappers.Packer) error {\n", t.named.Obj().Name(), unmarshalMethod)
+	for i, f := range t.fields {
+		if f.optional {
+			// The value was marshaled before the remaining optional fields
+			// were added.
+			g.printf("if p.Offset == len(p.Bytes) {\n")
+			g.printf("var zero %s\n", t.named.Obj().Name())
+			for _, remaining := range t.fields[i:] {
+				g.printf("t.%s = zero.%s\n", remaining.name, remaining.name)
+			}
+			g.printf("return nil\n}\n")
+		}
+		g.unmarshal("t."+f.name, f.typ)
+	}
+	g.printf("return nil\n}\n")
+}
+
+func (g *generator) generateValue(t namedType) {
+	g.printf("\nfunc (t *%s) %s() interface{} {\nreturn t\n}\n", t.named.Obj().Name(), valueMethod)
+}
+
+// size writes the code that adds the size of [expr] to [size].
+func (g *generator) size(expr string, typ types.Type) {
+	switch t := types.Unalias(typ).Underlying().(type) {
+	case *types.Basic:
+		if t.Kind() == types.String {
+			g.printf("size += wrappers.StringLen(string(%s))\n", expr)
+			return
+		}
+		constSize, ok := g.constSize(t)
+		if !ok {
+			g.fail(typ)
+			return
+		}
+		g.printf("size += %d\n", constSize)
+	case *types.Array:
+		if constSize, ok := g.constSize(t); ok {
+			g.printf("size += %d\n", constSize)
+			return
+		}
+		i := g.newVar("i")
+		g.printf("for %s := range %s {\n", i, expr)
+		g.size(fmt.Sprintf("%s[%s]", expr, i), t.Elem())
+		g.printf("}\n")
+	case *types.Slice:
+		g.printf("size += wrappers.IntLen\n")
+		constSize, ok := g.constSize(t.Elem())
+		switch {
+		case ok && constSize == 0:
+			g.printf("if len(%s) != 0 {\nreturn 0, codec.ErrMarshalZeroLength\n}\n", expr)
+		case ok && constSize == 1:
+			g.printf("size += len(%s)\n", expr)
+		case ok:
+			g.printf("size += len(%s) * %d\n", expr, constSize)
+		default:
+			i := g.newVar("i")
+			start := g.newVar("start")
+			g.printf("for %s := range %s {\n", i, expr)
+			g.printf("%s := size\n", start)
+			g.size(fmt.Sprintf("%s[%s]", expr, i), t.Elem())
+			g.printf("if %s == 0 && size == %s {\nreturn 0, codec.ErrMarshalZeroLength\n}\n", i, start)
+			g.printf("}\n")
+		}
+	case *types.Pointer:
+		g.printf("if %s == nil {\nreturn 0, codec.ErrMarshalNil\n}\n", expr)
+		g.size(fmt.Sprintf("(*%s)", expr), t.Elem())
+	default:
+		if !g.checkSupported(typ) || !g.checkNested(typ) {
+			return
+		}
+		g.printf("{\n")
+		if g.hasMarshaler(typ) {
+			g.printf("n, err := %s.%s(c)\n", expr, sizeMethod)
+		} else {
+			g.printf("n, err := c.Size(&%s)\n", expr)
+		}
+		g.printf("if err != nil {\nreturn 0, err\n}\nsize += n\n}\n")
+	}
+}
+
+// marshal writes the code that packs [expr] into [p].
+func (g *generator) marshal(expr string, typ types.Type) {
+	switch t := types.Unalias(typ).Underlying().(type) {
+	case *types.Basic:
+		switch t.Kind() {
+		case types.Uint8, types.Int8:
+			g.printf("p.PackByte(byte(%s))\n", expr)
+		case types.Uint16, types.Int16:
+			g.printf("p.PackShort(uint16(%s))\n", expr)
+		case types.Uint32, types.Int32:
+			g.printf("p.PackInt(uint32(%s))\n", expr)
+		case types.Uint64, types.Int64:
+			g.printf("p.PackLong(uint64(%s))\n", expr)
+		case types.Bool:
+			g.printf("p.PackBool(bool(%s))\n", expr)
+		case types.String:
+			g.printf("p.PackStr(string(%s))\n", expr)
+		default:
+			g.fail(typ)
+		}
+	case *types.Array:
+		if isByte(t.Elem()) {
+			g.printf("p.PackFixedBytes(%s[:])\n", expr)
+			return
+		}
+		i := g.newVar("i")
+		g.printf("for %s := range %s {\n", i, expr)
+		g.marshal(fmt.Sprintf("%s[%s]", expr, i), t.Elem())
+		g.printf("}\n")
+	case *types.Slice:
+		g.usesMath = true
+		g.printf("if len(%s) > math.MaxInt32 {\nreturn codec.ErrMaxSliceLenExceeded\n}\n", expr)
+		g.printf("p.PackInt(uint32(len(%s)))\n", expr)
+		if isByte(t.Elem()) {
+			g.printf("p.PackFixedBytes(%s)\n", expr)
+			return
+		}
+		i := g.newVar("i")
+		start := g.newVar("start")
+		g.printf("for %s := range %s {\n", i, expr)
+		g.printf("%s := p.Offset\n", start)
+		g.marshal(fmt.Sprintf("%s[%s]", expr, i), t.Elem())
+		g.printf("if p.Err != nil {\nreturn p.Err\n}\n")
+		g.printf("if p.Offset == %s {\nreturn codec.ErrMarshalZeroLength\n}\n", start)
+		g.printf("}\n")
+	case *types.Pointer:
+		g.printf("if %s == nil {\nreturn codec.ErrMarshalNil\n}\n", expr)
+		g.marshal(fmt.Sprintf("(*%s)", expr), t.Elem())
+	default:
+		if !g.checkSupported(typ) || !g.checkNested(typ) {
+			return
+		}
+		if g.hasMarshaler(typ) {
+			g.printf("if err := %s.%s(c, p); err != nil {\nreturn err\n}\n", expr, marshalMethod)
+		} else {
+			g.printf("if err := c.MarshalInto(&%s, p); err != nil {\nreturn err\n}\n", expr)
+		}
+	}
+}
+
+// unmarshal writes the code that unpacks [expr] from [p].
+func (g *generator) unmarshal(expr string, typ types.Type) {
+	switch t := types.Unalias(typ).Underlying().(type) {
+	case *types.Basic:
+		typeName := g.typeString(typ)
+		switch t.Kind() {
+		case types.Uint8, types.Int8:
+			g.printf("%s = %s(p.UnpackByte())\n", expr, typeName)
+		case types.Uint16, types.Int16:
+			g.printf("%s = %s(p.UnpackShort())\n", expr, typeName)
+		case types.Uint32, types.Int32:
+			g.printf("%s = %s(p.UnpackInt())\n", expr, typeName)
+		case types.Uint64, types.Int64:
+			g.printf("%s = %s(p.UnpackLong())\n", expr, typeName)
+		case types.Bool:
+			g.printf("%s = %s(p.UnpackBool())\n", expr, typeName)
+		case types.String:
+			g.printf("%s = %s(p.UnpackStr())\n", expr, typeName)
+		default:
+			g.fail(typ)
+			return
+		}
+		g.printf("if p.Err != nil {\nreturn p.Err\n}\n")
+	case *types.Array:
+		if isByte(t.Elem()) {
+			g.printf("copy(%s[:], p.UnpackFixedBytes(%d))\n", expr, t.Len())
+			g.printf("if p.Err != nil {\nreturn p.Err\n}\n")
+			return
+		}
+		i := g.newVar("i")
+		g.printf("for %s := range %s {\n", i, expr)
+		g.unmarshal(fmt.Sprintf("%s[%s]", expr, i), t.Elem())
+		g.printf("}\n")
+	case *types.Slice:
+		g.usesMath = true
+		n := g.newVar("n")
+		g.printf("%s := p.UnpackInt()\n", n)
+		g.printf("if p.Err != nil {\nreturn p.Err\n}\n")
+		g.printf("if %s > math.MaxInt32 {\nreturn codec.ErrMaxSliceLenExceeded\n}\n", n)
+		if isByte(t.Elem()) {
+			g.printf("%s = p.UnpackFixedBytes(int(%s))\n", expr, n)
+			g.printf("if p.Err != nil {\nreturn p.Err\n}\n")
+			return
+		}
+		i := g.newVar("i")
+		start := g.newVar("start")
+		elem := g.newVar("elem")
+		g.printf("%s = make(%s, 0, min(int(%s), %d))\n", expr, g.typeString(typ), n, initialSliceLen)
+		g.printf("for %s := 0; %s < int(%s); %s++ {\n", i, i, n, i)
+		g.printf("var %s %s\n", elem, g.typeString(t.Elem()))
+		g.printf("%s = append(%s, %s)\n", expr, expr, elem)
+		g.printf("%s := p.Offset\n", start)
+		g.unmarshal(fmt.Sprintf("%s[%s]", expr, i), t.Elem())
+		g.printf("if p.Offset == %s {\nreturn codec.ErrUnmarshalZeroLength\n}\n", start)
+		g.printf("}\n")
+	case *types.Pointer:
+		g.printf("%s = new(%s)\n", expr, g.typeString(t.Elem()))
+		g.unmarshal(fmt.Sprintf("(*%s)", expr), t.Elem())
+	default:
+		if !g.checkSupported(typ) || !g.checkNested(typ) {
+			return
+		}
+		if g.hasMarshaler(typ) {
+			g.printf("if err := %s.%s(c, p); err != nil {\nreturn err\n}\n", expr, unmarshalMethod)
+		} else {
+			g.printf("if err := c.UnmarshalFrom(p, &%s); err != nil {\nreturn err\n}\n", expr)
+		}
+	}
+}
+
+// constSize returns the size of values of [typ], if all values of [typ] have
+// the same size.
+func (g *generator) constSize(typ types.Type) (int, bool) {
+	switch t := types.Unalias(typ).Underlying().(type) {
+	case *types.Basic:
+		switch t.Kind() {
+		case types.Uint8, types.Int8:
+			return wrappers.ByteLen, true
+		case types.Uint16, types.Int16:
+			return wrappers.ShortLen, true
+		case types.Uint32, types.Int32:
+			return wrappers.IntLen, true
+		case types.Uint64, types.Int64:
+			return wrappers.LongLen, true
+		case types.Bool:
+			return wrappers.BoolLen, true
+		}
+	case *types.Array:
+		if elemSize, ok := g.constSize(t.Elem()); ok {
+			return int(t.Len()) * elemSize, true
+		}
+	}
+	return 0, false
+}
+
+// hasMarshaler returns true if code is being generated for [typ]. Structs
+// declared in other files are handled by the codec, which uses their generated
+// code if it exists.
+func (g *generator) hasMarshaler(typ types.Type) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	return ok && g.generated[named]
+}
+
+// checkSupported returns true if values of [typ] can be handled by the codec.
+func (g *generator) checkSupported(typ types.Type) bool {
+	switch types.Unalias(typ).Underlying().(type) {
+	case *types.Struct, *types.Interface, *types.Map:
+		return true
+	default:
+		g.fail(typ)
+		return false
+	}
+}
+
+// checkNested returns true if [typ], which isn't the top-level value, doesn't
+// have optional fields.
+func (g *generator) checkNested(typ types.Type) bool {
+	s, ok := types.Unalias(typ).Underlying().(*types.Struct)
+	if !ok {
+		return true
+	}
+	fields, err := serializedFields(s)
+	if err != nil {
+		if g.err == nil {
+			g.err = fmt.Errorf("%s: %w", typ, err)
+		}
+		return false
+	}
+	for _, f := range fields {
+		if f.optional {
+			if g.err == nil {
+				g.err = fmt.Errorf("%w: %s", codec.ErrNestedOptionalField, typ)
+			}
+			return false
+		}
+	}
+	return true
+}
+
+func (g *generator) fail(typ types.Type) {
+	if g.err == nil {
+		g.err = fmt.Errorf("%w: %s", codec.ErrUnsupportedType, typ)
+	}
+}
+
+func (g *generator) typeString(typ types.Type) string {
+	return types.TypeString(typ, g.qualifier)
+}
+
+// qualifier returns the name that [pkg] is imported as, adding the import if
+// needed.
+func (g *generator) qualifier(pkg *types.Package) string {
+	if pkg == g.pkg {
+		return ""
+	}
+	if name, ok := g.imports[pkg.Path()]; ok {
+		return name
+	}
+
+	name := pkg.Name()
+	for i := 1; ; i++ {
+		if _, ok := g.importNames[name]; !ok {
+			break
+		}
+		name = fmt.Sprintf("%s%d", pkg.Name(), i)
+	}
+	g.imports[pkg.Path()] = name
+	g.importNames[name] = pkg.Path()
+	return name
+}
+
+func (g *generator) newVar(prefix string) string {
+	name := fmt.Sprintf("%s%d", prefix, g.numVars)
+	g.numVars++
+	return name
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func isByte(typ types.Type) bool {
+	return types.Identical(typ, types.Typ[types.Byte])
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package codecgen
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec"
+)
+
+func TestGenerateUpToDate(t *testing.T) {
+	require := require.New(t)
+
+	const file = "codecgentest/types.go"
+	code, err := Generate(file)
+	require.NoError(err)
+
+	expected, err := os.ReadFile(OutputFile(file))
+	require.NoError(err)
+	require.Equal(string(expected), string(code), "run go generate ./codec/codecgen/...")
+}
+
+func TestGenerateNoTypes(t *testing.T) {
+	require := require.New(t)
+
+	code, err := Generate("testdata/untagged.go")
+	require.NoError(err)
+	require.Nil(code)
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		file        string
+		expectedErr error
+	}{
+		{
+			file:        "testdata/unexported.go",
+			expectedErr: codec.ErrUnexportedField,
+		},
+		{
+			file:        "testdata/optional.go",
+			expectedErr: codec.ErrRequiredFieldAfterOptional,
+		},
+		{
+			file:        "testdata/nested_optional.go",
+			expectedErr: codec.ErrNestedOptionalField,
+		},
+		{
+			file:        "testdata/unsupported.go",
+			expectedErr: codec.ErrUnsupportedType,
+		},
+		{
+			file:        "testdata/missing.go",
+			expectedErr: os.ErrNotExist,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			_, err := Generate(test.file)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package codecgentest
+
+// Reflected doesn't have generated marshalling code, so it is always
+// marshaled with reflection.
+type Reflected struct {
+	Uint16    uint16    `serialize:"true"`
+	Interface Interface `serialize:"true"`
+}
+
+func (*Reflected) isInterface() {}
+
+// EmbedsGenerated doesn't have generated marshalling code, so the generated
+// methods of the embedded struct are promoted to it. It must still be marshaled
+// with reflection so that [EmbedsGenerated.Extra] is marshaled.
+type EmbedsGenerated struct {
+	Primitives `serialize:"true"`
+
+	Extra uint16 `serialize:"true"`
+}
@@ -0,0 +1,845 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: types.go
+
+package codecgentest
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*Primitives)(nil)
+	_ codec.Marshaler = (*Struct)(nil)
+	_ codec.Marshaler = (*Optional)(nil)
+)
+
+func (t *Primitives) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += 1
+	size += 1
+	size += 2
+	size += 2
+	size += 4
+	size += 4
+	size += 8
+	size += 8
+	size += 1
+	size += wrappers.StringLen(string(t.String))
+	size += 1
+	size += wrappers.StringLen(string(t.NamedString))
+	return size, nil
+}
+
+func (t *Primitives) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	p.PackByte(byte(t.Uint8))
+	p.PackByte(byte(t.Int8))
+	p.PackShort(uint16(t.Uint16))
+	p.PackShort(uint16(t.Int16))
+	p.PackInt(uint32(t.Uint32))
+	p.PackInt(uint32(t.Int32))
+	p.PackLong(uint64(t.Uint64))
+	p.PackLong(uint64(t.Int64))
+	p.PackBool(bool(t.Bool))
+	p.PackStr(string(t.String))
+	p.PackByte(byte(t.NamedUint8))
+	p.PackStr(string(t.NamedString))
+	return p.Err
+}
+
+func (t *Primitives) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	t.Uint8 = uint8(p.UnpackByte())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Int8 = int8(p.UnpackByte())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Uint16 = uint16(p.UnpackShort())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Int16 = int16(p.UnpackShort())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Uint32 = uint32(p.UnpackInt())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Int32 = int32(p.UnpackInt())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Uint64 = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Int64 = int64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Bool = bool(p.UnpackBool())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.String = string(p.UnpackStr())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.NamedUint8 = Uint8(p.UnpackByte())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.NamedString = String(p.UnpackStr())
+	if p.Err != nil {
+		return p.Err
+	}
+	return nil
+}
+
+func (t *Primitives) CodecValue() interface{} {
+	return t
+}
+
+func (t *Struct) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := t.Primitives.CodecSize(c)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 32
+	size += 20
+	size += 3
+	for i0 := range t.StringArray {
+		size += wrappers.StringLen(string(t.StringArray[i0]))
+	}
+	size += 8
+	size += wrappers.IntLen
+	size += len(t.Bytes)
+	size += wrappers.IntLen
+	size += len(t.NamedBytes)
+	size += wrappers.IntLen
+	size += len(t.NamedByteSlice)
+	size += wrappers.IntLen
+	for i1 := range t.Strings {
+		start2 := size
+		size += wrappers.StringLen(string(t.Strings[i1]))
+		if i1 == 0 && size == start2 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	size += wrappers.IntLen
+	size += len(t.IDs) * 32
+	size += wrappers.IntLen
+	for i3 := range t.NestedSlice {
+		start4 := size
+		size += wrappers.IntLen
+		size += len(t.NestedSlice[i3]) * 4
+		if i3 == 0 && size == start4 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	size += wrappers.IntLen
+	for i5 := range t.EmptyStructs {
+		start6 := size
+		{
+			n, err := c.Size(&t.EmptyStructs[i5])
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		if i5 == 0 && size == start6 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	if t.Pointer == nil {
+		return 0, codec.ErrMarshalNil
+	}
+	size += 8
+	if t.PrimitivesPtr == nil {
+		return 0, codec.ErrMarshalNil
+	}
+	{
+		n, err := (*t.PrimitivesPtr).CodecSize(c)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += wrappers.IntLen
+	for i7 := range t.PrimitivesSlice {
+		start8 := size
+		{
+			n, err := t.PrimitivesSlice[i7].CodecSize(c)
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		if i7 == 0 && size == start8 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	size += wrappers.IntLen
+	for i9 := range t.PrimitivesPtrs {
+		start10 := size
+		if t.PrimitivesPtrs[i9] == nil {
+			return 0, codec.ErrMarshalNil
+		}
+		{
+			n, err := (*t.PrimitivesPtrs[i9]).CodecSize(c)
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		if i9 == 0 && size == start10 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	{
+		n, err := c.Size(&t.Reflected)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	{
+		n, err := c.Size(&t.EmbedsGenerated)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	{
+		n, err := c.Size(&t.Interface)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += wrappers.IntLen
+	for i11 := range t.Interfaces {
+		start12 := size
+		{
+			n, err := c.Size(&t.Interfaces[i11])
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		if i11 == 0 && size == start12 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	if t.InterfacePointer == nil {
+		return 0, codec.ErrMarshalNil
+	}
+	{
+		n, err := c.Size(&(*t.InterfacePointer))
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	{
+		n, err := c.Size(&t.Map)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	{
+		n, err := c.Size(&t.Anonymous)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *Struct) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := t.Primitives.CodecMarshalInto(c, p); err != nil {
+		return err
+	}
+	p.PackFixedBytes(t.ID[:])
+	p.PackFixedBytes(t.ShortID[:])
+	p.PackFixedBytes(t.ByteArray[:])
+	for i0 := range t.StringArray {
+		p.PackStr(string(t.StringArray[i0]))
+	}
+	for i1 := range t.NestedArray {
+		for i2 := range t.NestedArray[i1] {
+			p.PackShort(uint16(t.NestedArray[i1][i2]))
+		}
+	}
+	if len(t.Bytes) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Bytes)))
+	p.PackFixedBytes(t.Bytes)
+	if len(t.NamedBytes) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.NamedBytes)))
+	p.PackFixedBytes(t.NamedBytes)
+	if len(t.NamedByteSlice) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.NamedByteSlice)))
+	for i3 := range t.NamedByteSlice {
+		start4 := p.Offset
+		p.PackByte(byte(t.NamedByteSlice[i3]))
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start4 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	if len(t.Strings) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Strings)))
+	for i5 := range t.Strings {
+		start6 := p.Offset
+		p.PackStr(string(t.Strings[i5]))
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start6 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	if len(t.IDs) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.IDs)))
+	for i7 := range t.IDs {
+		start8 := p.Offset
+		p.PackFixedBytes(t.IDs[i7][:])
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start8 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	if len(t.NestedSlice) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.NestedSlice)))
+	for i9 := range t.NestedSlice {
+		start10 := p.Offset
+		if len(t.NestedSlice[i9]) > math.MaxInt32 {
+			return codec.ErrMaxSliceLenExceeded
+		}
+		p.PackInt(uint32(len(t.NestedSlice[i9])))
+		for i11 := range t.NestedSlice[i9] {
+			start12 := p.Offset
+			p.PackInt(uint32(t.NestedSlice[i9][i11]))
+			if p.Err != nil {
+				return p.Err
+			}
+			if p.Offset == start12 {
+				return codec.ErrMarshalZeroLength
+			}
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start10 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	if len(t.EmptyStructs) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.EmptyStructs)))
+	for i13 := range t.EmptyStructs {
+		start14 := p.Offset
+		if err := c.MarshalInto(&t.EmptyStructs[i13], p); err != nil {
+			return err
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start14 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	if t.Pointer == nil {
+		return codec.ErrMarshalNil
+	}
+	p.PackLong(uint64((*t.Pointer)))
+	if t.PrimitivesPtr == nil {
+		return codec.ErrMarshalNil
+	}
+	if err := (*t.PrimitivesPtr).CodecMarshalInto(c, p); err != nil {
+		return err
+	}
+	if len(t.PrimitivesSlice) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.PrimitivesSlice)))
+	for i15 := range t.PrimitivesSlice {
+		start16 := p.Offset
+		if err := t.PrimitivesSlice[i15].CodecMarshalInto(c, p); err != nil {
+			return err
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start16 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	if len(t.PrimitivesPtrs) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.PrimitivesPtrs)))
+	for i17 := range t.PrimitivesPtrs {
+		start18 := p.Offset
+		if t.PrimitivesPtrs[i17] == nil {
+			return codec.ErrMarshalNil
+		}
+		if err := (*t.PrimitivesPtrs[i17]).CodecMarshalInto(c, p); err != nil {
+			return err
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start18 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	if err := c.MarshalInto(&t.Reflected, p); err != nil {
+		return err
+	}
+	if err := c.MarshalInto(&t.EmbedsGenerated, p); err != nil {
+		return err
+	}
+	if err := c.MarshalInto(&t.Interface, p); err != nil {
+		return err
+	}
+	if len(t.Interfaces) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Interfaces)))
+	for i19 := range t.Interfaces {
+		start20 := p.Offset
+		if err := c.MarshalInto(&t.Interfaces[i19], p); err != nil {
+			return err
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start20 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	if t.InterfacePointer == nil {
+		return codec.ErrMarshalNil
+	}
+	if err := c.MarshalInto(&(*t.InterfacePointer), p); err != nil {
+		return err
+	}
+	if err := c.MarshalInto(&t.Map, p); err != nil {
+		return err
+	}
+	if err := c.MarshalInto(&t.Anonymous, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *Struct) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := t.Primitives.CodecUnmarshalFrom(c, p); err != nil {
+		return err
+	}
+	copy(t.ID[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	copy(t.ShortID[:], p.UnpackFixedBytes(20))
+	if p.Err != nil {
+		return p.Err
+	}
+	copy(t.ByteArray[:], p.UnpackFixedBytes(3))
+	if p.Err != nil {
+		return p.Err
+	}
+	for i0 := range t.StringArray {
+		t.StringArray[i0] = string(p.UnpackStr())
+		if p.Err != nil {
+			return p.Err
+		}
+	}
+	for i1 := range t.NestedArray {
+		for i2 := range t.NestedArray[i1] {
+			t.NestedArray[i1][i2] = uint16(p.UnpackShort())
+			if p.Err != nil {
+				return p.Err
+			}
+		}
+	}
+	n3 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n3 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Bytes = p.UnpackFixedBytes(int(n3))
+	if p.Err != nil {
+		return p.Err
+	}
+	n4 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n4 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.NamedBytes = p.UnpackFixedBytes(int(n4))
+	if p.Err != nil {
+		return p.Err
+	}
+	n5 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n5 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.NamedByteSlice = make([]Uint8, 0, min(int(n5), 16))
+	for i6 := 0; i6 < int(n5); i6++ {
+		var elem8 Uint8
+		t.NamedByteSlice = append(t.NamedByteSlice, elem8)
+		start7 := p.Offset
+		t.NamedByteSlice[i6] = Uint8(p.UnpackByte())
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start7 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	n9 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n9 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Strings = make([]string, 0, min(int(n9), 16))
+	for i10 := 0; i10 < int(n9); i10++ {
+		var elem12 string
+		t.Strings = append(t.Strings, elem12)
+		start11 := p.Offset
+		t.Strings[i10] = string(p.UnpackStr())
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start11 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	n13 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n13 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.IDs = make([]ids.ID, 0, min(int(n13), 16))
+	for i14 := 0; i14 < int(n13); i14++ {
+		var elem16 ids.ID
+		t.IDs = append(t.IDs, elem16)
+		start15 := p.Offset
+		copy(t.IDs[i14][:], p.UnpackFixedBytes(32))
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start15 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	n17 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n17 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.NestedSlice = make([][]uint32, 0, min(int(n17), 16))
+	for i18 := 0; i18 < int(n17); i18++ {
+		var elem20 []uint32
+		t.NestedSlice = append(t.NestedSlice, elem20)
+		start19 := p.Offset
+		n21 := p.UnpackInt()
+		if p.Err != nil {
+			return p.Err
+		}
+		if n21 > math.MaxInt32 {
+			return codec.ErrMaxSliceLenExceeded
+		}
+		t.NestedSlice[i18] = make([]uint32, 0, min(int(n21), 16))
+		for i22 := 0; i22 < int(n21); i22++ {
+			var elem24 uint32
+			t.NestedSlice[i18] = append(t.NestedSlice[i18], elem24)
+			start23 := p.Offset
+			t.NestedSlice[i18][i22] = uint32(p.UnpackInt())
+			if p.Err != nil {
+				return p.Err
+			}
+			if p.Offset == start23 {
+				return codec.ErrUnmarshalZeroLength
+			}
+		}
+		if p.Offset == start19 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	n25 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n25 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.EmptyStructs = make([]struct{}, 0, min(int(n25), 16))
+	for i26 := 0; i26 < int(n25); i26++ {
+		var elem28 struct{}
+		t.EmptyStructs = append(t.EmptyStructs, elem28)
+		start27 := p.Offset
+		if err := c.UnmarshalFrom(p, &t.EmptyStructs[i26]); err != nil {
+			return err
+		}
+		if p.Offset == start27 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	t.Pointer = new(uint64)
+	(*t.Pointer) = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.PrimitivesPtr = new(Primitives)
+	if err := (*t.PrimitivesPtr).CodecUnmarshalFrom(c, p); err != nil {
+		return err
+	}
+	n29 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n29 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.PrimitivesSlice = make([]Primitives, 0, min(int(n29), 16))
+	for i30 := 0; i30 < int(n29); i30++ {
+		var elem32 Primitives
+		t.PrimitivesSlice = append(t.PrimitivesSlice, elem32)
+		start31 := p.Offset
+		if err := t.PrimitivesSlice[i30].CodecUnmarshalFrom(c, p); err != nil {
+			return err
+		}
+		if p.Offset == start31 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	n33 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n33 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.PrimitivesPtrs = make([]*Primitives, 0, min(int(n33), 16))
+	for i34 := 0; i34 < int(n33); i34++ {
+		var elem36 *Primitives
+		t.PrimitivesPtrs = append(t.PrimitivesPtrs, elem36)
+		start35 := p.Offset
+		t.PrimitivesPtrs[i34] = new(Primitives)
+		if err := (*t.PrimitivesPtrs[i34]).CodecUnmarshalFrom(c, p); err != nil {
+			return err
+		}
+		if p.Offset == start35 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	if err := c.UnmarshalFrom(p, &t.Reflected); err != nil {
+		return err
+	}
+	if err := c.UnmarshalFrom(p, &t.EmbedsGenerated); err != nil {
+		return err
+	}
+	if err := c.UnmarshalFrom(p, &t.Interface); err != nil {
+		return err
+	}
+	n37 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n37 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Interfaces = make([]Interface, 0, min(int(n37), 16))
+	for i38 := 0; i38 < int(n37); i38++ {
+		var elem40 Interface
+		t.Interfaces = append(t.Interfaces, elem40)
+		start39 := p.Offset
+		if err := c.UnmarshalFrom(p, &t.Interfaces[i38]); err != nil {
+			return err
+		}
+		if p.Offset == start39 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	t.InterfacePointer = new(Interface)
+	if err := c.UnmarshalFrom(p, &(*t.InterfacePointer)); err != nil {
+		return err
+	}
+	if err := c.UnmarshalFrom(p, &t.Map); err != nil {
+		return err
+	}
+	if err := c.UnmarshalFrom(p, &t.Anonymous); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *Struct) CodecValue() interface{} {
+	return t
+}
+
+func (t *Optional) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += 4
+	size += 8
+	size += wrappers.IntLen
+	size += len(t.Bytes)
+	size += wrappers.IntLen
+	for i0 := range t.Pointers {
+		start1 := size
+		if t.Pointers[i0] == nil {
+			return 0, codec.ErrMarshalNil
+		}
+		{
+			n, err := (*t.Pointers[i0]).CodecSize(c)
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		if i0 == 0 && size == start1 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	return size, nil
+}
+
+func (t *Optional) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	p.PackInt(uint32(t.Uint32))
+	p.PackLong(uint64(t.Uint64))
+	if len(t.Bytes) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Bytes)))
+	p.PackFixedBytes(t.Bytes)
+	if len(t.Pointers) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Pointers)))
+	for i0 := range t.Pointers {
+		start1 := p.Offset
+		if t.Pointers[i0] == nil {
+			return codec.ErrMarshalNil
+		}
+		if err := (*t.Pointers[i0]).CodecMarshalInto(c, p); err != nil {
+			return err
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start1 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	return p.Err
+}
+
+func (t *Optional) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	t.Uint32 = uint32(p.UnpackInt())
+	if p.Err != nil {
+		return p.Err
+	}
+	if p.Offset == len(p.Bytes) {
+		var zero Optional
+		t.Uint64 = zero.Uint64
+		t.Bytes = zero.Bytes
+		t.Pointers = zero.Pointers
+		return nil
+	}
+	t.Uint64 = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	if p.Offset == len(p.Bytes) {
+		var zero Optional
+		t.Bytes = zero.Bytes
+		t.Pointers = zero.Pointers
+		return nil
+	}
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Bytes = p.UnpackFixedBytes(int(n0))
+	if p.Err != nil {
+		return p.Err
+	}
+	if p.Offset == len(p.Bytes) {
+		var zero Optional
+		t.Pointers = zero.Pointers
+		return nil
+	}
+	n1 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n1 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Pointers = make([]*Primitives, 0, min(int(n1), 16))
+	for i2 := 0; i2 < int(n1); i2++ {
+		var elem4 *Primitives
+		t.Pointers = append(t.Pointers, elem4)
+		start3 := p.Offset
+		t.Pointers[i2] = new(Primitives)
+		if err := (*t.Pointers[i2]).CodecUnmarshalFrom(c, p); err != nil {
+			return err
+		}
+		if p.Offset == start3 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	return nil
+}
+
+func (t *Optional) CodecValue() interface{} {
+	return t
+}
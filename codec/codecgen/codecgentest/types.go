@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package codecgentest contains types with generated marshalling code, which
+// are used to test the generated code against reflection.
+package codecgentest
+
+//go:generate go run github.com/ava-labs/avalanchego/codec/codecgen/cmd $GOFILE
+
+import "github.com/ava-labs/avalanchego/ids"
+
+var (
+	_ Interface = (*Struct)(nil)
+	_ Interface = (*Primitives)(nil)
+	_ Interface = (*Reflected)(nil)
+)
+
+// Interface is implemented by the types that may be marshaled as interfaces.
+type Interface interface {
+	isInterface()
+}
+
+type (
+	Uint8  uint8
+	String string
+	Bytes  []byte
+)
+
+type Primitives struct {
+	Uint8       uint8  `serialize:"true"`
+	Int8        int8   `serialize:"true"`
+	Uint16      uint16 `serialize:"true"`
+	Int16       int16  `serialize:"true"`
+	Uint32      uint32 `serialize:"true"`
+	Int32       int32  `serialize:"true"`
+	Uint64      uint64 `serialize:"true"`
+	Int64       int64  `serialize:"true"`
+	Bool        bool   `serialize:"true"`
+	String      string `serialize:"true"`
+	NamedUint8  Uint8  `serialize:"true"`
+	NamedString String `serialize:"true"`
+	Ignored     uint32 `serialize:"false"`
+	Untagged    uint32
+}
+
+func (*Primitives) isInterface() {}
+
+type Struct struct {
+	Primitives `serialize:"true"`
+
+	ID               ids.ID                `serialize:"true"`
+	ShortID          ids.ShortID           `serialize:"true"`
+	ByteArray        [3]byte               `serialize:"true"`
+	StringArray      [2]string             `serialize:"true"`
+	NestedArray      [2][2]uint16          `serialize:"true"`
+	Bytes            []byte                `serialize:"true"`
+	NamedBytes       Bytes                 `serialize:"true"`
+	NamedByteSlice   []Uint8               `serialize:"true"`
+	Strings          []string              `serialize:"true"`
+	IDs              []ids.ID              `serialize:"true"`
+	NestedSlice      [][]uint32            `serialize:"true"`
+	EmptyStructs     []struct{}            `serialize:"true"`
+	Pointer          *uint64               `serialize:"true"`
+	PrimitivesPtr    *Primitives           `serialize:"true"`
+	PrimitivesSlice  []Primitives          `serialize:"true"`
+	PrimitivesPtrs   []*Primitives         `serialize:"true"`
+	Reflected        Reflected             `serialize:"true"`
+	EmbedsGenerated  EmbedsGenerated       `serialize:"true"`
+	Interface        Interface             `serialize:"true"`
+	Interfaces       []Interface           `serialize:"true"`
+	InterfacePointer *Interface            `serialize:"true"`
+	Map              map[string]Primitives `serialize:"true"`
+	Anonymous        struct {
+		Uint32 uint32 `serialize:"true"`
+	} `serialize:"true"`
+}
+
+func (*Struct) isInterface() {}
+
+// Optional has fields that may be absent from the end of an encoding.
+type Optional struct {
+	Uint32   uint32        `serialize:"true"`
+	Uint64   uint64        `serialize:"true" optional:"true"`
+	Bytes    []byte        `serialize:"true" optional:"true"`
+	Pointers []*Primitives `serialize:"true" optional:"true"`
+}
@@ -0,0 +1,405 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package codecgentest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/codec/reflectcodec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+// A codec with an additional tag name can't use the generated code, which only
+// handles [reflectcodec.DefaultTagName].
+const reflectOnlyTagName = "reflectOnly"
+
+var _ codec.Marshaler = (*Struct)(nil)
+
+// newManagers returns a manager that uses the generated code and a manager
+// that only uses reflection.
+func newManagers(tb testing.TB) (codec.Manager, codec.Manager) {
+	newManager := func(tagNames []string) codec.Manager {
+		c := linearcodec.New(tagNames)
+		require.NoError(tb, c.RegisterType(&Primitives{}))
+		require.NoError(tb, c.RegisterType(&Struct{}))
+		require.NoError(tb, c.RegisterType(&Reflected{}))
+
+		manager := codec.NewDefaultManager()
+		require.NoError(tb, manager.RegisterCodec(0, c))
+		return manager
+	}
+	return newManager([]string{reflectcodec.DefaultTagName}),
+		newManager([]string{reflectcodec.DefaultTagName, reflectOnlyTagName})
+}
+
+func newPrimitives(i int) Primitives {
+	return Primitives{
+		Uint8:       uint8(i),
+		Int8:        -int8(i),
+		Uint16:      uint16(i) << 8,
+		Int16:       -int16(i) << 8,
+		Uint32:      uint32(i) << 24,
+		Int32:       -int32(i) << 24,
+		Uint64:      uint64(i) << 56,
+		Int64:       -int64(i) << 56,
+		Bool:        i%2 == 0,
+		String:      "primitives",
+		NamedUint8:  Uint8(i + 1),
+		NamedString: "named",
+		Ignored:     1,
+		Untagged:    2,
+	}
+}
+
+func newStruct() *Struct {
+	pointer := uint64(7)
+	primitives := newPrimitives(3)
+	var iface Interface = &Primitives{String: "pointer"}
+	s := &Struct{
+		Primitives:      newPrimitives(1),
+		ID:              ids.GenerateTestID(),
+		ShortID:         ids.GenerateTestShortID(),
+		ByteArray:       [3]byte{1, 2, 3},
+		StringArray:     [2]string{"a", "b"},
+		NestedArray:     [2][2]uint16{{1, 2}, {3, 4}},
+		Bytes:           []byte{4, 5},
+		NamedBytes:      Bytes{6},
+		NamedByteSlice:  []Uint8{7, 8},
+		Strings:         []string{"c", ""},
+		IDs:             []ids.ID{ids.GenerateTestID(), ids.GenerateTestID()},
+		NestedSlice:     [][]uint32{{1}, {}, {2, 3}},
+		Pointer:         &pointer,
+		PrimitivesPtr:   &primitives,
+		PrimitivesSlice: []Primitives{newPrimitives(4), newPrimitives(5)},
+		PrimitivesPtrs:  []*Primitives{&primitives},
+		Reflected: Reflected{
+			Uint16:    9,
+			Interface: &Primitives{String: "reflected"},
+		},
+		EmbedsGenerated: EmbedsGenerated{
+			Primitives: newPrimitives(8),
+			Extra:      12,
+		},
+		Interface: &Reflected{
+			Uint16:    10,
+			Interface: &Primitives{String: "nested"},
+		},
+		Interfaces: []Interface{
+			&Primitives{String: "first"},
+			&Reflected{Interface: &Primitives{}},
+		},
+		InterfacePointer: &iface,
+		Map: map[string]Primitives{
+			"x": newPrimitives(6),
+			"y": newPrimitives(7),
+		},
+	}
+	s.Anonymous.Uint32 = 11
+	return s
+}
+
+// requireSameMarshal requires that marshaling [value] with [generated] and
+// [reflected] has the same results, and returns the marshaled bytes.
+func requireSameMarshal(t *testing.T, generated codec.Manager, reflected codec.Manager, value interface{}, expectedErr error) []byte {
+	require := require.New(t)
+
+	generatedBytes, generatedErr := generated.Marshal(0, value)
+	reflectedBytes, reflectedErr := reflected.Marshal(0, value)
+	require.ErrorIs(generatedErr, expectedErr)
+	require.ErrorIs(reflectedErr, expectedErr)
+	require.Equal(reflectedBytes, generatedBytes)
+
+	generatedSize, generatedErr := generated.Size(0, value)
+	reflectedSize, reflectedErr := reflected.Size(0, value)
+	require.ErrorIs(generatedErr, expectedErr)
+	require.ErrorIs(reflectedErr, expectedErr)
+	require.Equal(reflectedSize, generatedSize)
+	if expectedErr == nil {
+		require.Len(generatedBytes, generatedSize)
+	}
+	return generatedBytes
+}
+
+// requireSameUnmarshal requires that unmarshaling [bytes] into a new value of
+// type [typ] with [generated] and [reflected] has the same results, and
+// returns the unmarshaled value.
+func requireSameUnmarshal(t *testing.T, generated codec.Manager, reflected codec.Manager, bytes []byte, typ reflect.Type) (interface{}, error) {
+	require := require.New(t)
+
+	generatedValue := reflect.New(typ).Interface()
+	_, generatedErr := generated.Unmarshal(bytes, generatedValue)
+	reflectedValue := reflect.New(typ).Interface()
+	_, reflectedErr := reflected.Unmarshal(bytes, reflectedValue)
+	if reflectedErr != nil {
+		require.Error(generatedErr) //nolint:forbidigo // The reflection error may be wrapped differently
+		return nil, reflectedErr
+	}
+	require.NoError(generatedErr)
+	require.Equal(reflectedValue, generatedValue)
+	return generatedValue, nil
+}
+
+func TestGeneratedMatchesReflection(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       func() interface{}
+		expectedErr error
+	}{
+		{
+			name: "primitives",
+			value: func() interface{} {
+				p := newPrimitives(1)
+				return &p
+			},
+		},
+		{
+			name: "primitives by value",
+			value: func() interface{} {
+				return newPrimitives(2)
+			},
+		},
+		{
+			name: "struct",
+			value: func() interface{} {
+				return newStruct()
+			},
+		},
+		{
+			name: "embeds generated",
+			value: func() interface{} {
+				return &EmbedsGenerated{
+					Primitives: newPrimitives(3),
+					Extra:      4,
+				}
+			},
+		},
+		{
+			name: "struct as interface",
+			value: func() interface{} {
+				var iface Interface = newStruct()
+				return &iface
+			},
+		},
+		{
+			name: "optional",
+			value: func() interface{} {
+				return &Optional{
+					Uint32: 1,
+					Uint64: 2,
+					Bytes:  []byte{3},
+					Pointers: []*Primitives{
+						{Uint32: 4},
+					},
+				}
+			},
+		},
+		{
+			name: "nil pointer",
+			value: func() interface{} {
+				s := newStruct()
+				s.PrimitivesPtr = nil
+				return s
+			},
+			expectedErr: codec.ErrMarshalNil,
+		},
+		{
+			name: "nil interface",
+			value: func() interface{} {
+				s := newStruct()
+				s.Interfaces[1] = nil
+				return s
+			},
+			expectedErr: codec.ErrMarshalNil,
+		},
+		{
+			name: "zero length slice elements",
+			value: func() interface{} {
+				s := newStruct()
+				s.EmptyStructs = make([]struct{}, 2)
+				return s
+			},
+			expectedErr: codec.ErrMarshalZeroLength,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			generated, reflected := newManagers(t)
+			value := test.value()
+			bytes := requireSameMarshal(t, generated, reflected, value, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			typ := reflect.TypeOf(value)
+			if typ.Kind() == reflect.Ptr {
+				typ = typ.Elem()
+			}
+			unmarshaled, err := requireSameUnmarshal(t, generated, reflected, bytes, typ)
+			require.NoError(err)
+			requireSameMarshal(t, generated, reflected, unmarshaled, nil)
+		})
+	}
+}
+
+func TestGeneratedRecursiveInterface(t *testing.T) {
+	require := require.New(t)
+
+	generated, reflected := newManagers(t)
+
+	// A Struct can't be marshaled as an interface inside of a Struct that is
+	// marshaled as an interface.
+	inner := newStruct()
+	outer := newStruct()
+	outer.Interface = inner
+	var iface Interface = outer
+
+	_, generatedErr := generated.Marshal(0, &iface)
+	require.Error(generatedErr) //nolint:forbidigo // The error is unexported
+	_, reflectedErr := reflected.Marshal(0, &iface)
+	require.Equal(reflectedErr.Error(), generatedErr.Error())
+
+	// The outer Struct isn't marshaled as an interface, so it can be marshaled.
+	bytes := requireSameMarshal(t, generated, reflected, outer, nil)
+	_, err := requireSameUnmarshal(t, generated, reflected, bytes, reflect.TypeOf(Struct{}))
+	require.NoError(err)
+
+	// But it can't be unmarshaled as an interface.
+	ifaceBytes := append([]byte{0, 0, 0, 0, 0, 1}, bytes[2:]...)
+	_, err = requireSameUnmarshal(t, generated, reflected, ifaceBytes, reflect.TypeOf((*Interface)(nil)).Elem())
+	require.Error(err) //nolint:forbidigo // The error is unexported
+}
+
+func TestGeneratedOptionalFields(t *testing.T) {
+	require := require.New(t)
+
+	generated, reflected := newManagers(t)
+
+	bytes := requireSameMarshal(t, generated, reflected, &Optional{
+		Uint32: 1,
+		Uint64: 2,
+		Bytes:  []byte{3},
+	}, nil)
+
+	const (
+		codecVersionLen = wrappers.ShortLen
+		uint32Len       = wrappers.IntLen
+		uint64Len       = wrappers.LongLen
+		bytesLen        = wrappers.IntLen + 1
+	)
+	tests := []struct {
+		name        string
+		bytes       []byte
+		expected    *Optional
+		expectedErr bool
+	}{
+		{
+			name:  "only required fields",
+			bytes: bytes[:codecVersionLen+uint32Len],
+			expected: &Optional{
+				Uint32: 1,
+			},
+		},
+		{
+			name:  "some optional fields",
+			bytes: bytes[:codecVersionLen+uint32Len+uint64Len+bytesLen],
+			expected: &Optional{
+				Uint32: 1,
+				Uint64: 2,
+				Bytes:  []byte{3},
+			},
+		},
+		{
+			name:        "partial optional field",
+			bytes:       bytes[:codecVersionLen+uint32Len+1],
+			expectedErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			unmarshaled, err := requireSameUnmarshal(t, generated, reflected, test.bytes, reflect.TypeOf(Optional{}))
+			if test.expectedErr {
+				require.Error(err) //nolint:forbidigo // The error may be wrapped differently
+				return
+			}
+			require.NoError(err)
+			require.Equal(test.expected, unmarshaled)
+		})
+	}
+}
+
+func fuzzGeneratedMatchesReflection(f *testing.F, seed interface{}) {
+	generated, reflected := newManagers(f)
+	bytes, err := generated.Marshal(0, seed)
+	require.NoError(f, err)
+	f.Add(bytes)
+
+	typ := reflect.TypeOf(seed).Elem()
+	f.Fuzz(func(t *testing.T, bytes []byte) {
+		unmarshaled, err := requireSameUnmarshal(t, generated, reflected, bytes, typ)
+		if err != nil {
+			return
+		}
+
+		// Optional fields make the encoding non-canonical, so the marshaled
+		// bytes may differ from [bytes].
+		requireSameMarshal(t, generated, reflected, unmarshaled, nil)
+	})
+}
+
+func FuzzGeneratedStruct(f *testing.F) {
+	fuzzGeneratedMatchesReflection(f, newStruct())
+}
+
+func FuzzGeneratedOptional(f *testing.F) {
+	fuzzGeneratedMatchesReflection(f, &Optional{
+		Uint32:   1,
+		Uint64:   2,
+		Bytes:    []byte{3},
+		Pointers: []*Primitives{{Uint32: 4}},
+	})
+}
+
+func BenchmarkMarshal(b *testing.B) {
+	generated, reflected := newManagers(b)
+	managers := map[string]codec.Manager{
+		"generated": generated,
+		"reflected": reflected,
+	}
+	value := newStruct()
+	for name, manager := range managers {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, err := manager.Marshal(0, value)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	generated, reflected := newManagers(b)
+	managers := map[string]codec.Manager{
+		"generated": generated,
+		"reflected": reflected,
+	}
+	bytes, err := generated.Marshal(0, newStruct())
+	require.NoError(b, err)
+	for name, manager := range managers {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var value Struct
+				_, err := manager.Unmarshal(bytes, &value)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package testdata
+
+type Optional struct {
+	Required uint32 `serialize:"true"`
+	Optional uint32 `serialize:"true" optional:"true"`
+}
+
+type NestedOptional struct {
+	Optional Optional `serialize:"true"`
+}
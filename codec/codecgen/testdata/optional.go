@@ -0,0 +1,9 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package testdata
+
+type RequiredAfterOptional struct {
+	Optional uint32 `serialize:"true" optional:"true"`
+	Required uint32 `serialize:"true"`
+}
@@ -0,0 +1,8 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package testdata
+
+type Unexported struct {
+	unexported uint32 `serialize:"true"`
+}
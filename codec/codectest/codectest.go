@@ -78,6 +78,13 @@ var (
 		{"Map", TestMap},
 		{"Can Marshal Large Slices", TestCanMarshalLargeSlices},
 		{"Implements UnmarshalFrom", TestImplementsUnmarshalFrom},
+		{"Optional Fields Round Trip", TestOptionalFieldsRoundTrip},
+		{"Optional Fields Old Layout To New Layout", TestOptionalFieldsOldToNew},
+		{"Optional Fields New Layout To Old Layout", TestOptionalFieldsNewToOld},
+		{"Optional Fields Nested", TestOptionalFieldsNested},
+		{"Optional Fields Nested Misparse", TestOptionalFieldsNestedMisparse},
+		{"Optional Fields Truncated", TestOptionalFieldsTruncated},
+		{"Required Field After Optional Field", TestRequiredFieldAfterOptionalField},
 	}
 
 	MultipleTagsTests = []NamedTest{
@@ -1187,3 +1194,196 @@ func TestImplementsUnmarshalFrom(t testing.TB, codec codecpkg.GeneralCodec) {
 		p,
 	)
 }
+
+// OptionalFieldsV0 is the layout of [OptionalFieldsV1] before its optional
+// fields were appended.
+type OptionalFieldsV0 struct {
+	Num uint32 `serialize:"true"`
+	Str string `serialize:"true"`
+}
+
+type OptionalFieldsV1 struct {
+	Num   uint32   `serialize:"true"`
+	Str   string   `serialize:"true"`
+	Long  uint64   `serialize:"true" optional:"true"`
+	Bytes []byte   `serialize:"true" optional:"true"`
+	Strs  []string `serialize:"true" optional:"true"`
+}
+
+// Test that values with optional fields can be marshaled and unmarshaled
+func TestOptionalFieldsRoundTrip(t testing.TB, codec codecpkg.GeneralCodec) {
+	require := require.New(t)
+
+	manager := codecpkg.NewDefaultManager()
+	require.NoError(manager.RegisterCodec(0, codec))
+
+	v1 := OptionalFieldsV1{
+		Num:   1,
+		Str:   "hi",
+		Long:  2,
+		Bytes: []byte{3},
+		Strs:  []string{"4"},
+	}
+	bytes, err := manager.Marshal(0, v1)
+	require.NoError(err)
+
+	bytesLen, err := manager.Size(0, v1)
+	require.NoError(err)
+	require.Len(bytes, bytesLen)
+
+	var unmarshaled OptionalFieldsV1
+	_, err = manager.Unmarshal(bytes, &unmarshaled)
+	require.NoError(err)
+	require.Equal(v1, unmarshaled)
+}
+
+// Test that values marshaled before optional fields were appended can be
+// unmarshaled into the new layout, and that the old encoding is a prefix of the
+// new encoding
+func TestOptionalFieldsOldToNew(t testing.TB, codec codecpkg.GeneralCodec) {
+	require := require.New(t)
+
+	manager := codecpkg.NewDefaultManager()
+	require.NoError(manager.RegisterCodec(0, codec))
+
+	v0 := OptionalFieldsV0{
+		Num: 1,
+		Str: "hi",
+	}
+	v0Bytes, err := manager.Marshal(0, v0)
+	require.NoError(err)
+
+	// Absent fields are reset to their zero values.
+	v1 := OptionalFieldsV1{
+		Long:  2,
+		Bytes: []byte{3},
+		Strs:  []string{"4"},
+	}
+	_, err = manager.Unmarshal(v0Bytes, &v1)
+	require.NoError(err)
+	require.Equal(OptionalFieldsV1{Num: 1, Str: "hi"}, v1)
+
+	v1Bytes, err := manager.Marshal(0, v1)
+	require.NoError(err)
+	require.Equal(v0Bytes, v1Bytes[:len(v0Bytes)])
+	require.Greater(len(v1Bytes), len(v0Bytes))
+}
+
+// Test that values marshaled with optional fields can't be unmarshaled into the
+// old layout
+func TestOptionalFieldsNewToOld(t testing.TB, codec codecpkg.GeneralCodec) {
+	require := require.New(t)
+
+	manager := codecpkg.NewDefaultManager()
+	require.NoError(manager.RegisterCodec(0, codec))
+
+	v1Bytes, err := manager.Marshal(0, OptionalFieldsV1{Num: 1, Str: "hi"})
+	require.NoError(err)
+
+	var v0 OptionalFieldsV0
+	_, err = manager.Unmarshal(v1Bytes, &v0)
+	require.ErrorIs(err, codecpkg.ErrExtraSpace)
+}
+
+// Test that structs with optional fields can't be nested in the marshaled value
+func TestOptionalFieldsNested(t testing.TB, codec codecpkg.GeneralCodec) {
+	require := require.New(t)
+
+	type outer struct {
+		Num   uint16           `serialize:"true"`
+		Inner OptionalFieldsV1 `serialize:"true"`
+	}
+	type outerSlice struct {
+		Inners []OptionalFieldsV1 `serialize:"true"`
+	}
+
+	manager := codecpkg.NewDefaultManager()
+	require.NoError(manager.RegisterCodec(0, codec))
+
+	// A nested struct with optional fields can't be marshaled, even if it is
+	// the last field.
+	_, err := manager.Marshal(0, outer{})
+	require.ErrorIs(err, codecpkg.ErrNestedOptionalField)
+
+	_, err = manager.Size(0, outer{})
+	require.ErrorIs(err, codecpkg.ErrNestedOptionalField)
+
+	_, err = manager.Marshal(0, outerSlice{Inners: []OptionalFieldsV1{{}}})
+	require.ErrorIs(err, codecpkg.ErrNestedOptionalField)
+
+	// A pointer to the top-level value isn't nested.
+	_, err = manager.Marshal(0, &OptionalFieldsV1{})
+	require.NoError(err)
+}
+
+// Test that values marshaled before optional fields were appended to a nested
+// struct aren't misparsed
+func TestOptionalFieldsNestedMisparse(t testing.TB, codec codecpkg.GeneralCodec) {
+	require := require.New(t)
+
+	type outerV0 struct {
+		Inner OptionalFieldsV0 `serialize:"true"`
+		Tail  uint64           `serialize:"true"`
+	}
+	type outerV1 struct {
+		Inner OptionalFieldsV1 `serialize:"true"`
+		Tail  uint64           `serialize:"true"`
+	}
+
+	manager := codecpkg.NewDefaultManager()
+	require.NoError(manager.RegisterCodec(0, codec))
+
+	v0Bytes, err := manager.Marshal(0, outerV0{
+		Inner: OptionalFieldsV0{
+			Num: 1,
+			Str: "hi",
+		},
+		Tail: 2,
+	})
+	require.NoError(err)
+
+	// Without rejecting the nested optional fields, [Tail] would be
+	// unmarshaled as [OptionalFieldsV1.Long].
+	var v1 outerV1
+	_, err = manager.Unmarshal(v0Bytes, &v1)
+	require.ErrorIs(err, codecpkg.ErrNestedOptionalField)
+}
+
+// Test that an optional field can't be partially present
+func TestOptionalFieldsTruncated(t testing.TB, codec codecpkg.GeneralCodec) {
+	require := require.New(t)
+
+	manager := codecpkg.NewDefaultManager()
+	require.NoError(manager.RegisterCodec(0, codec))
+
+	v1Bytes, err := manager.Marshal(0, OptionalFieldsV1{Num: 1, Str: "hi", Long: 2})
+	require.NoError(err)
+
+	// Remove the last byte of [Strs]' length.
+	var v1 OptionalFieldsV1
+	_, err = manager.Unmarshal(v1Bytes[:len(v1Bytes)-1], &v1)
+	require.ErrorIs(err, wrappers.ErrInsufficientLength)
+}
+
+// Ensure that trying to serialize a struct with a required field after an
+// optional field returns an error
+func TestRequiredFieldAfterOptionalField(t testing.TB, codec codecpkg.GeneralCodec) {
+	require := require.New(t)
+
+	type s struct {
+		Optional uint32 `serialize:"true" optional:"true"`
+		Required uint32 `serialize:"true"`
+	}
+
+	manager := codecpkg.NewDefaultManager()
+	require.NoError(manager.RegisterCodec(0, codec))
+
+	_, err := manager.Marshal(0, s{})
+	require.ErrorIs(err, codecpkg.ErrRequiredFieldAfterOptional)
+
+	_, err = manager.Size(0, s{})
+	require.ErrorIs(err, codecpkg.ErrRequiredFieldAfterOptional)
+
+	_, err = manager.Unmarshal([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, &s{})
+	require.ErrorIs(err, codecpkg.ErrRequiredFieldAfterOptional)
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package reflectcodec
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Codec = typeStackCodec{}
+
+	marshalerType = reflect.TypeOf((*codec.Marshaler)(nil)).Elem()
+)
+
+// marshalerTypes caches which struct types implement [codec.Marshaler].
+type marshalerTypes struct {
+	lock       sync.RWMutex
+	implements map[reflect.Type]bool
+}
+
+func (m *marshalerTypes) implementsMarshaler(t reflect.Type) bool {
+	m.lock.RLock()
+	implements, ok := m.implements[t]
+	m.lock.RUnlock()
+	if ok {
+		return implements
+	}
+
+	// The generated methods have pointer receivers. If the methods are
+	// promoted from an embedded struct, CodecValue returns the embedded struct
+	// rather than the receiver.
+	ptrType := reflect.PointerTo(t)
+	implements = ptrType.Implements(marshalerType) &&
+		reflect.TypeOf(reflect.New(t).Interface().(codec.Marshaler).CodecValue()) == ptrType
+
+	m.lock.Lock()
+	m.implements[t] = implements
+	m.lock.Unlock()
+	return implements
+}
+
+// marshaler returns [value] as a [codec.Marshaler] if its type has generated
+// marshalling code that this codec can use.
+func (c *genericCodec) marshaler(value reflect.Value) (codec.Marshaler, bool) {
+	if !c.useMarshalers || !c.marshalers.implementsMarshaler(value.Type()) {
+		return nil, false
+	}
+	if value.CanAddr() {
+		return value.Addr().Interface().(codec.Marshaler), true
+	}
+
+	// Copy the value so that the pointer methods can be called.
+	ptr := reflect.New(value.Type())
+	ptr.Elem().Set(value)
+	return ptr.Interface().(codec.Marshaler), true
+}
+
+// typeStackCodec is passed to generated marshalling code to marshal the values
+// that the generated code doesn't handle. It carries the interface types that
+// are being marshaled so that recursive interface types are reported the same
+// way as when only reflection is used.
+type typeStackCodec struct {
+	codec     *genericCodec
+	typeStack set.Set[reflect.Type]
+}
+
+func (c typeStackCodec) Size(value interface{}) (int, error) {
+	if value == nil {
+		return 0, codec.ErrMarshalNil
+	}
+
+	size, _, err := c.codec.size(reflect.ValueOf(value), false /*=topLevel*/, c.typeStack)
+	return size, err
+}
+
+func (c typeStackCodec) MarshalInto(value interface{}, p *wrappers.Packer) error {
+	if value == nil {
+		return codec.ErrMarshalNil
+	}
+
+	return c.codec.marshal(reflect.ValueOf(value), p, false /*=topLevel*/, c.typeStack)
+}
+
+func (c typeStackCodec) UnmarshalFrom(p *wrappers.Packer, dest interface{}) error {
+	if dest == nil {
+		return codec.ErrUnmarshalNil
+	}
+
+	destPtr := reflect.ValueOf(dest)
+	if destPtr.Kind() != reflect.Ptr {
+		return errNeedPointer
+	}
+	return c.codec.unmarshal(p, destPtr.Elem(), false /*=topLevel*/, c.typeStack)
+}
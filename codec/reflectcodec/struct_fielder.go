@@ -11,8 +11,19 @@ import (
 	"github.com/ava-labs/avalanchego/codec"
 )
 
-// TagValue is the value the tag must have to be serialized.
-const TagValue = "true"
+const (
+	// TagValue is the value the tag must have to be serialized.
+	TagValue = "true"
+
+	// OptionalTagName marks a serialized field as optional when its value is
+	// [TagValue]. Optional fields may be absent from the end of an encoding,
+	// which allows fields to be appended to a struct without registering a
+	// new codec version. Every serialized field after an optional field must
+	// also be optional, and only the top-level struct may have optional
+	// fields. As a value can be encoded with or without its optional fields,
+	// they must not be used in types whose bytes are hashed.
+	OptionalTagName = "optional"
+)
 
 var _ StructFielder = (*structFielder)(nil)
 
@@ -24,16 +35,25 @@ type StructFielder interface {
 	// is un-exported.
 	// GetSerializedField(Foo) --> [1,5,8] means Foo.Field(1), Foo.Field(5),
 	// Foo.Field(8) are to be serialized/deserialized.
-	GetSerializedFields(t reflect.Type) ([]int, error)
+	//
+	// Also returns the number of required fields. The serialized fields after
+	// the required fields are optional.
+	// Returns an error if a required field follows an optional field.
+	GetSerializedFields(t reflect.Type) ([]int, int, error)
 }
 
 func NewStructFielder(tagNames []string) StructFielder {
 	return &structFielder{
 		tags:                   tagNames,
-		serializedFieldIndices: make(map[reflect.Type][]int),
+		serializedFieldIndices: make(map[reflect.Type]serializedFields),
 	}
 }
 
+type serializedFields struct {
+	indices     []int
+	numRequired int
+}
+
 type structFielder struct {
 	lock sync.RWMutex
 
@@ -46,19 +66,22 @@ type structFielder struct {
 	// that is serialized/deserialized e.g. Foo --> [1,5,8] means Foo.Field(1),
 	// etc. are to be serialized/deserialized. We assume this cache is pretty
 	// small (a few hundred keys at most) and doesn't take up much memory.
-	serializedFieldIndices map[reflect.Type][]int
+	serializedFieldIndices map[reflect.Type]serializedFields
 }
 
-func (s *structFielder) GetSerializedFields(t reflect.Type) ([]int, error) {
-	if serializedFields, ok := s.getCachedSerializedFields(t); ok { // use pre-computed result
-		return serializedFields, nil
+func (s *structFielder) GetSerializedFields(t reflect.Type) ([]int, int, error) {
+	if cachedFields, ok := s.getCachedSerializedFields(t); ok { // use pre-computed result
+		return cachedFields.indices, cachedFields.numRequired, nil
 	}
 
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	numFields := t.NumField()
-	serializedFields := make([]int, 0, numFields)
+	var (
+		numFields   = t.NumField()
+		indices     = make([]int, 0, numFields)
+		numRequired int
+	)
 	for i := 0; i < numFields; i++ { // Go through all fields of this struct
 		field := t.Field(i)
 
@@ -76,18 +99,32 @@ func (s *structFielder) GetSerializedFields(t reflect.Type) ([]int, error) {
 			continue
 		}
 		if !field.IsExported() { // Can only marshal exported fields
-			return nil, fmt.Errorf("can not marshal %w: %s",
+			return nil, 0, fmt.Errorf("can not marshal %w: %s",
 				codec.ErrUnexportedField,
 				field.Name,
 			)
 		}
-		serializedFields = append(serializedFields, i)
+		if field.Tag.Get(OptionalTagName) != TagValue {
+			// Optional fields can only be omitted from the end of an encoding,
+			// so they can't be followed by required fields.
+			if numRequired != len(indices) {
+				return nil, 0, fmt.Errorf("can not marshal %w: %s",
+					codec.ErrRequiredFieldAfterOptional,
+					field.Name,
+				)
+			}
+			numRequired++
+		}
+		indices = append(indices, i)
+	}
+	s.serializedFieldIndices[t] = serializedFields{ // cache result
+		indices:     indices,
+		numRequired: numRequired,
 	}
-	s.serializedFieldIndices[t] = serializedFields // cache result
-	return serializedFields, nil
+	return indices, numRequired, nil
 }
 
-func (s *structFielder) getCachedSerializedFields(t reflect.Type) ([]int, bool) {
+func (s *structFielder) getCachedSerializedFields(t reflect.Type) (serializedFields, bool) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 
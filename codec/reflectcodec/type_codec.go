@@ -67,16 +67,35 @@ type TypeCodec interface {
 //     codec.RegisterType([instance of the type that fulfills the interface]).
 //  6. Serialized fields must be exported
 //  7. nil slices are marshaled as empty slices
+//  8. Fields tagged with `optional:"true"` are always marshaled, but may be
+//     absent when unmarshaling if no bytes remain. This allows fields to be
+//     appended to a struct while still unmarshaling values that were
+//     marshaled before the fields were added. Absent fields are set to their
+//     zero values. Because absence is detected by reaching the end of the
+//     bytes, only the top-level struct may have optional fields.
+//     Marshaling a value whose optional fields were absent doesn't reproduce
+//     the original bytes, so optional fields must not be used in types whose
+//     bytes are hashed, such as types that are identified by their hash.
+//  9. Structs that implement [codec.Marshaler] are marshaled with their
+//     generated code, rather than reflection, iff the only tag name is
+//     [DefaultTagName], which is the tag the code is generated from.
 type genericCodec struct {
 	typer   TypeCodec
 	fielder StructFielder
+
+	useMarshalers bool
+	marshalers    marshalerTypes
 }
 
 // New returns a new, concurrency-safe codec
 func New(typer TypeCodec, tagNames []string) codec.Codec {
 	return &genericCodec{
-		typer:   typer,
-		fielder: NewStructFielder(tagNames),
+		typer:         typer,
+		fielder:       NewStructFielder(tagNames),
+		useMarshalers: len(tagNames) == 1 && tagNames[0] == DefaultTagName,
+		marshalers: marshalerTypes{
+			implements: make(map[reflect.Type]bool),
+		},
 	}
 }
 
@@ -85,7 +104,7 @@ func (c *genericCodec) Size(value interface{}) (int, error) {
 		return 0, codec.ErrMarshalNil
 	}
 
-	size, _, err := c.size(reflect.ValueOf(value), nil /*=typeStack*/)
+	size, _, err := c.size(reflect.ValueOf(value), true /*=topLevel*/, nil /*=typeStack*/)
 	return size, err
 }
 
@@ -93,6 +112,7 @@ func (c *genericCodec) Size(value interface{}) (int, error) {
 // sized.
 func (c *genericCodec) size(
 	value reflect.Value,
+	topLevel bool,
 	typeStack set.Set[reflect.Type],
 ) (int, bool, error) {
 	switch valueKind := value.Kind(); valueKind {
@@ -121,7 +141,7 @@ func (c *genericCodec) size(
 			return 0, false, codec.ErrMarshalNil
 		}
 
-		return c.size(value.Elem(), typeStack)
+		return c.size(value.Elem(), topLevel, typeStack)
 
 	case reflect.Interface:
 		if value.IsNil() {
@@ -136,7 +156,7 @@ func (c *genericCodec) size(
 		typeStack.Add(underlyingType)
 
 		prefixSize := c.typer.PrefixSize(underlyingType)
-		valueSize, _, err := c.size(value.Elem(), typeStack)
+		valueSize, _, err := c.size(value.Elem(), topLevel, typeStack)
 
 		typeStack.Remove(underlyingType)
 		return prefixSize + valueSize, false, err
@@ -147,7 +167,7 @@ func (c *genericCodec) size(
 			return wrappers.IntLen, false, nil
 		}
 
-		size, constSize, err := c.size(value.Index(0), typeStack)
+		size, constSize, err := c.size(value.Index(0), false, typeStack)
 		if err != nil {
 			return 0, false, err
 		}
@@ -163,7 +183,7 @@ func (c *genericCodec) size(
 		}
 
 		for i := 1; i < numElts; i++ {
-			innerSize, _, err := c.size(value.Index(i), typeStack)
+			innerSize, _, err := c.size(value.Index(i), false, typeStack)
 			if err != nil {
 				return 0, false, err
 			}
@@ -177,7 +197,7 @@ func (c *genericCodec) size(
 			return 0, true, nil
 		}
 
-		size, constSize, err := c.size(value.Index(0), typeStack)
+		size, constSize, err := c.size(value.Index(0), false, typeStack)
 		if err != nil {
 			return 0, false, err
 		}
@@ -189,7 +209,7 @@ func (c *genericCodec) size(
 		}
 
 		for i := 1; i < numElts; i++ {
-			innerSize, _, err := c.size(value.Index(i), typeStack)
+			innerSize, _, err := c.size(value.Index(i), false, typeStack)
 			if err != nil {
 				return 0, false, err
 			}
@@ -198,17 +218,24 @@ func (c *genericCodec) size(
 		return size, false, nil
 
 	case reflect.Struct:
-		serializedFields, err := c.fielder.GetSerializedFields(value.Type())
+		serializedFields, _, err := c.serializedFields(value.Type(), topLevel)
 		if err != nil {
 			return 0, false, err
 		}
+		if marshaler, ok := c.marshaler(value); ok {
+			size, err := marshaler.CodecSize(typeStackCodec{
+				codec:     c,
+				typeStack: typeStack,
+			})
+			return size, false, err
+		}
 
 		var (
 			size      int
 			constSize = true
 		)
 		for _, fieldIndex := range serializedFields {
-			innerSize, innerConstSize, err := c.size(value.Field(fieldIndex), typeStack)
+			innerSize, innerConstSize, err := c.size(value.Field(fieldIndex), false, typeStack)
 			if err != nil {
 				return 0, false, err
 			}
@@ -223,11 +250,11 @@ func (c *genericCodec) size(
 			return wrappers.IntLen, false, nil
 		}
 
-		keySize, keyConstSize, err := c.size(iter.Key(), typeStack)
+		keySize, keyConstSize, err := c.size(iter.Key(), false, typeStack)
 		if err != nil {
 			return 0, false, err
 		}
-		valueSize, valueConstSize, err := c.size(iter.Value(), typeStack)
+		valueSize, valueConstSize, err := c.size(iter.Value(), false, typeStack)
 		if err != nil {
 			return 0, false, err
 		}
@@ -246,7 +273,7 @@ func (c *genericCodec) size(
 				totalValueSize = valueSize
 			)
 			for iter.Next() {
-				valueSize, _, err := c.size(iter.Value(), typeStack)
+				valueSize, _, err := c.size(iter.Value(), false, typeStack)
 				if err != nil {
 					return 0, false, err
 				}
@@ -260,7 +287,7 @@ func (c *genericCodec) size(
 				totalKeySize = keySize
 			)
 			for iter.Next() {
-				keySize, _, err := c.size(iter.Key(), typeStack)
+				keySize, _, err := c.size(iter.Key(), false, typeStack)
 				if err != nil {
 					return 0, false, err
 				}
@@ -271,11 +298,11 @@ func (c *genericCodec) size(
 		default:
 			totalSize := wrappers.IntLen + keySize + valueSize
 			for iter.Next() {
-				keySize, _, err := c.size(iter.Key(), typeStack)
+				keySize, _, err := c.size(iter.Key(), false, typeStack)
 				if err != nil {
 					return 0, false, err
 				}
-				valueSize, _, err := c.size(iter.Value(), typeStack)
+				valueSize, _, err := c.size(iter.Value(), false, typeStack)
 				if err != nil {
 					return 0, false, err
 				}
@@ -295,7 +322,7 @@ func (c *genericCodec) MarshalInto(value interface{}, p *wrappers.Packer) error
 		return codec.ErrMarshalNil
 	}
 
-	return c.marshal(reflect.ValueOf(value), p, nil /*=typeStack*/)
+	return c.marshal(reflect.ValueOf(value), p, true /*=topLevel*/, nil /*=typeStack*/)
 }
 
 // marshal writes the byte representation of [value] to [p]
@@ -304,6 +331,7 @@ func (c *genericCodec) MarshalInto(value interface{}, p *wrappers.Packer) error
 func (c *genericCodec) marshal(
 	value reflect.Value,
 	p *wrappers.Packer,
+	topLevel bool,
 	typeStack set.Set[reflect.Type],
 ) error {
 	switch valueKind := value.Kind(); valueKind {
@@ -342,7 +370,7 @@ func (c *genericCodec) marshal(
 			return codec.ErrMarshalNil
 		}
 
-		return c.marshal(value.Elem(), p, typeStack)
+		return c.marshal(value.Elem(), p, topLevel, typeStack)
 	case reflect.Interface:
 		if value.IsNil() {
 			return codec.ErrMarshalNil
@@ -357,7 +385,7 @@ func (c *genericCodec) marshal(
 		if err := c.typer.PackPrefix(p, underlyingType); err != nil {
 			return err
 		}
-		if err := c.marshal(value.Elem(), p, typeStack); err != nil {
+		if err := c.marshal(value.Elem(), p, topLevel, typeStack); err != nil {
 			return err
 		}
 		typeStack.Remove(underlyingType)
@@ -389,7 +417,7 @@ func (c *genericCodec) marshal(
 		}
 		for i := 0; i < numElts; i++ { // Process each element in the slice
 			startOffset := p.Offset
-			if err := c.marshal(value.Index(i), p, typeStack); err != nil {
+			if err := c.marshal(value.Index(i), p, false, typeStack); err != nil {
 				return err
 			}
 			if startOffset == p.Offset {
@@ -405,18 +433,28 @@ func (c *genericCodec) marshal(
 		}
 		numElts := value.Len()
 		for i := 0; i < numElts; i++ { // Process each element in the array
-			if err := c.marshal(value.Index(i), p, typeStack); err != nil {
+			if err := c.marshal(value.Index(i), p, false, typeStack); err != nil {
 				return err
 			}
 		}
 		return nil
 	case reflect.Struct:
-		serializedFields, err := c.fielder.GetSerializedFields(value.Type())
+		serializedFields, _, err := c.serializedFields(value.Type(), topLevel)
 		if err != nil {
 			return err
 		}
+		if marshaler, ok := c.marshaler(value); ok {
+			return marshaler.CodecMarshalInto(
+				typeStackCodec{
+					codec:     c,
+					typeStack: typeStack,
+				},
+				p,
+			)
+		}
+
 		for _, fieldIndex := range serializedFields { // Go through all fields of this struct that are serialized
-			if err := c.marshal(value.Field(fieldIndex), p, typeStack); err != nil { // Serialize the field and write to byte array
+			if err := c.marshal(value.Field(fieldIndex), p, false, typeStack); err != nil { // Serialize the field and write to byte array
 				return err
 			}
 		}
@@ -447,7 +485,7 @@ func (c *genericCodec) marshal(
 		startOffset := p.Offset
 		endOffset := p.Offset
 		for i, key := range keys {
-			if err := c.marshal(key, p, typeStack); err != nil {
+			if err := c.marshal(key, p, false, typeStack); err != nil {
 				return err
 			}
 			if p.Err != nil {
@@ -482,7 +520,7 @@ func (c *genericCodec) marshal(
 			}
 
 			// serialize and pack value
-			if err := c.marshal(value.MapIndex(key.key), p, typeStack); err != nil {
+			if err := c.marshal(value.MapIndex(key.key), p, false, typeStack); err != nil {
 				return err
 			}
 			if keyStartOffset == p.Offset {
@@ -507,7 +545,7 @@ func (c *genericCodec) UnmarshalFrom(p *wrappers.Packer, dest interface{}) error
 	if destPtr.Kind() != reflect.Ptr {
 		return errNeedPointer
 	}
-	return c.unmarshal(p, destPtr.Elem(), nil /*=typeStack*/)
+	return c.unmarshal(p, destPtr.Elem(), true /*=topLevel*/, nil /*=typeStack*/)
 }
 
 // Unmarshal from p.Bytes into [value]. [value] must be addressable.
@@ -516,6 +554,7 @@ func (c *genericCodec) UnmarshalFrom(p *wrappers.Packer, dest interface{}) error
 func (c *genericCodec) unmarshal(
 	p *wrappers.Packer,
 	value reflect.Value,
+	topLevel bool,
 	typeStack set.Set[reflect.Type],
 ) error {
 	switch value.Kind() {
@@ -603,7 +642,7 @@ func (c *genericCodec) unmarshal(
 			value.Set(reflect.Append(value, zeroValue))
 
 			startOffset := p.Offset
-			if err := c.unmarshal(p, value.Index(i), typeStack); err != nil {
+			if err := c.unmarshal(p, value.Index(i), false, typeStack); err != nil {
 				return err
 			}
 			if startOffset == p.Offset {
@@ -624,7 +663,7 @@ func (c *genericCodec) unmarshal(
 			return nil
 		}
 		for i := 0; i < numElts; i++ {
-			if err := c.unmarshal(p, value.Index(i), typeStack); err != nil {
+			if err := c.unmarshal(p, value.Index(i), false, typeStack); err != nil {
 				return err
 			}
 		}
@@ -647,7 +686,7 @@ func (c *genericCodec) unmarshal(
 		typeStack.Add(intfImplementorType)
 
 		// Unmarshal into the struct
-		if err := c.unmarshal(p, intfImplementor, typeStack); err != nil {
+		if err := c.unmarshal(p, intfImplementor, topLevel, typeStack); err != nil {
 			return err
 		}
 
@@ -656,13 +695,31 @@ func (c *genericCodec) unmarshal(
 		return nil
 	case reflect.Struct:
 		// Get indices of fields that will be unmarshaled into
-		serializedFieldIndices, err := c.fielder.GetSerializedFields(value.Type())
+		serializedFieldIndices, numRequired, err := c.serializedFields(value.Type(), topLevel)
 		if err != nil {
 			return fmt.Errorf("couldn't unmarshal struct: %w", err)
 		}
+		if marshaler, ok := c.marshaler(value); ok {
+			return marshaler.CodecUnmarshalFrom(
+				typeStackCodec{
+					codec:     c,
+					typeStack: typeStack,
+				},
+				p,
+			)
+		}
+
 		// Go through the fields and unmarshal into them
-		for _, fieldIndex := range serializedFieldIndices {
-			if err := c.unmarshal(p, value.Field(fieldIndex), typeStack); err != nil {
+		for i, fieldIndex := range serializedFieldIndices {
+			if i >= numRequired && p.Offset == len(p.Bytes) {
+				// The value was marshaled before the remaining optional fields
+				// were added.
+				for _, fieldIndex := range serializedFieldIndices[i:] {
+					value.Field(fieldIndex).SetZero()
+				}
+				return nil
+			}
+			if err := c.unmarshal(p, value.Field(fieldIndex), false, typeStack); err != nil {
 				return err
 			}
 		}
@@ -673,7 +730,7 @@ func (c *genericCodec) unmarshal(
 		// Create a new pointer to a new value of the underlying type
 		v := reflect.New(t)
 		// Fill the value
-		if err := c.unmarshal(p, v.Elem(), typeStack); err != nil {
+		if err := c.unmarshal(p, v.Elem(), topLevel, typeStack); err != nil {
 			return err
 		}
 		// Assign to the top-level struct's member
@@ -708,7 +765,7 @@ func (c *genericCodec) unmarshal(
 
 			keyStartOffset := p.Offset
 
-			if err := c.unmarshal(p, mapKey, typeStack); err != nil {
+			if err := c.unmarshal(p, mapKey, false, typeStack); err != nil {
 				return err
 			}
 
@@ -726,7 +783,7 @@ func (c *genericCodec) unmarshal(
 
 			// Get the value
 			mapValue := reflect.New(mapValueType).Elem()
-			if err := c.unmarshal(p, mapValue, typeStack); err != nil {
+			if err := c.unmarshal(p, mapValue, false, typeStack); err != nil {
 				return err
 			}
 			if keyStartOffset == p.Offset {
@@ -742,3 +799,17 @@ func (c *genericCodec) unmarshal(
 		return fmt.Errorf("can't unmarshal unknown type %s", value.Kind().String())
 	}
 }
+
+// serializedFields returns the serialized fields of [t] and the number of
+// them that are required. Returns an error if [t] has optional fields but
+// isn't the top-level value.
+func (c *genericCodec) serializedFields(t reflect.Type, topLevel bool) ([]int, int, error) {
+	serializedFields, numRequired, err := c.fielder.GetSerializedFields(t)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !topLevel && numRequired != len(serializedFields) {
+		return nil, 0, fmt.Errorf("%w: %s", codec.ErrNestedOptionalField, t)
+	}
+	return serializedFields, numRequired, nil
+}
@@ -27,6 +27,8 @@ import (
 	"github.com/ava-labs/avalanchego/network/dialer"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/chitlog"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/decisiontrace"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
@@ -74,6 +76,7 @@ var (
 	errMinStakeDurationAboveMax               = errors.New("max stake duration can't be less than min stake duration")
 	errStakeMaxConsumptionTooLarge            = fmt.Errorf("max stake consumption must be less than or equal to %d", reward.PercentDenominator)
 	errStakeMaxConsumptionBelowMin            = errors.New("stake max consumption can't be less than min stake consumption")
+	errMissingSnapshotTrustedID               = errors.New("missing snapshot trusted ID")
 	errStakeMintingPeriodBelowMin             = errors.New("stake minting period can't be less than max stake duration")
 	errCannotTrackPrimaryNetwork              = errors.New("cannot track primary network")
 	errStakingKeyContentUnset                 = fmt.Errorf("%s key not set but %s set", StakingTLSKeyContentKey, StakingCertContentKey)
@@ -473,6 +476,19 @@ func getStateSyncConfig(v *viper.Viper) (node.StateSyncConfig, error) {
 		return node.StateSyncConfig{}, fmt.Errorf("expected the number of stateSyncIPs (%d) to match the number of stateSyncIDs (%d)", lenIPs, lenIDs)
 	}
 
+	config.SnapshotImportFile = getExpandedArg(v, SnapshotImportFileKey)
+	if config.SnapshotImportFile != "" {
+		trustedIDStr := v.GetString(SnapshotImportTrustedIDKey)
+		if trustedIDStr == "" {
+			return node.StateSyncConfig{}, fmt.Errorf("%w: %s is required when %s is set", errMissingSnapshotTrustedID, SnapshotImportTrustedIDKey, SnapshotImportFileKey)
+		}
+		trustedID, err := ids.FromString(trustedIDStr)
+		if err != nil {
+			return node.StateSyncConfig{}, fmt.Errorf("couldn't parse %s: %w", SnapshotImportTrustedIDKey, err)
+		}
+		config.SnapshotImportTrustedID = trustedID
+	}
+
 	return config, nil
 }
 
@@ -557,6 +573,36 @@ func getIPConfig(v *viper.Viper) (node.IPConfig, error) {
 	return ipConfig, nil
 }
 
+func getDecisionTraceConfig(v *viper.Viper) (decisiontrace.Config, error) {
+	config := decisiontrace.Config{
+		Enabled:   v.GetBool(SnowDecisionTraceEnabledKey),
+		Directory: getExpandedArg(v, SnowDecisionTraceDirKey),
+		MaxSize:   v.GetInt(SnowDecisionTraceMaxSizeKey),
+		MaxFiles:  v.GetInt(SnowDecisionTraceMaxFilesKey),
+		MaxBlocks: v.GetInt(SnowDecisionTraceMaxBlocksKey),
+	}
+	switch {
+	case config.MaxSize <= 0:
+		return decisiontrace.Config{}, fmt.Errorf("%s must be > 0", SnowDecisionTraceMaxSizeKey)
+	case config.MaxFiles < 0:
+		return decisiontrace.Config{}, fmt.Errorf("%s must be >= 0", SnowDecisionTraceMaxFilesKey)
+	case config.MaxBlocks <= 0:
+		return decisiontrace.Config{}, fmt.Errorf("%s must be > 0", SnowDecisionTraceMaxBlocksKey)
+	}
+	return config, nil
+}
+
+func getChitLogConfig(v *viper.Viper) (chitlog.Config, error) {
+	config := chitlog.Config{
+		Enabled:    v.GetBool(SnowChitLogEnabledKey),
+		MaxEntries: v.GetUint64(SnowChitLogMaxEntriesKey),
+	}
+	if config.MaxEntries == 0 {
+		return chitlog.Config{}, fmt.Errorf("%s must be > 0", SnowChitLogMaxEntriesKey)
+	}
+	return config, nil
+}
+
 func getProfilerConfig(v *viper.Viper) (profiler.Config, error) {
 	config := profiler.Config{
 		Dir:         getExpandedArg(v, ProfileDirKey),
@@ -885,6 +931,11 @@ func getDatabaseConfig(v *viper.Viper, networkID uint32) (node.DatabaseConfig, e
 		}
 	}
 
+	cacheBudgetSize := v.GetInt(DBCacheBudgetSizeKey)
+	if cacheBudgetSize < 0 {
+		return node.DatabaseConfig{}, fmt.Errorf("%s must be >= 0", DBCacheBudgetSizeKey)
+	}
+
 	return node.DatabaseConfig{
 		Name:     v.GetString(DBTypeKey),
 		ReadOnly: v.GetBool(DBReadOnlyKey),
@@ -892,7 +943,8 @@ func getDatabaseConfig(v *viper.Viper, networkID uint32) (node.DatabaseConfig, e
 			getExpandedArg(v, DBPathKey),
 			constants.NetworkName(networkID),
 		),
-		Config: configBytes,
+		Config:          configBytes,
+		CacheBudgetSize: cacheBudgetSize,
 	}, nil
 }
 
@@ -1423,6 +1475,18 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 		return node.Config{}, err
 	}
 
+	// Decision traces
+	nodeConfig.DecisionTraceConfig, err = getDecisionTraceConfig(v)
+	if err != nil {
+		return node.Config{}, err
+	}
+
+	// Chit logs
+	nodeConfig.ChitLogConfig, err = getChitLogConfig(v)
+	if err != nil {
+		return node.Config{}, err
+	}
+
 	// VM Aliases
 	nodeConfig.VMAliases, err = getVMAliases(v)
 	if err != nil {
@@ -44,6 +44,7 @@ var (
 	defaultDataDir              = filepath.Join("$HOME", ".avalanchego")
 	defaultDBDir                = filepath.Join(defaultUnexpandedDataDir, "db")
 	defaultLogDir               = filepath.Join(defaultUnexpandedDataDir, "logs")
+	defaultDecisionTraceDir     = filepath.Join(defaultLogDir, "decisions")
 	defaultProfileDir           = filepath.Join(defaultUnexpandedDataDir, "profiles")
 	defaultStakingPath          = filepath.Join(defaultUnexpandedDataDir, "staking")
 	defaultStakingTLSKeyPath    = filepath.Join(defaultStakingPath, "staker.key")
@@ -131,6 +132,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.String(DBPathKey, defaultDBDir, "Path to database directory")
 	fs.String(DBConfigFileKey, "", fmt.Sprintf("Path to database config file. Ignored if %s is specified", DBConfigContentKey))
 	fs.String(DBConfigContentKey, "", "Specifies base64 encoded database config content")
+	fs.Int(DBCacheBudgetSizeKey, 0, "Maximum number of bytes used by the database caches that are registered with the node's cache budget. Caches must opt in to the budget. If 0, no budget is created and the caches are sized individually")
 
 	// Logging
 	fs.String(LogsDirKey, defaultLogDir, "Logging directory for Avalanche")
@@ -291,6 +293,8 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	// State syncing
 	fs.String(StateSyncIPsKey, "", "Comma separated list of state sync peer ips to connect to. Example: 127.0.0.1:9630,127.0.0.1:9631")
 	fs.String(StateSyncIDsKey, "", "Comma separated list of state sync peer ids to connect to. Example: NodeID-JR4dVmy6ffUGAKCBDkyCbeZbyHQBeDsET,NodeID-8CrVPQZ4VSqgL8zTdvL14G8HqAfrBr4z")
+	fs.String(SnapshotImportFileKey, "", "Path of a chain snapshot to import before the chain is started. The chain's database must be empty")
+	fs.String(SnapshotImportTrustedIDKey, "", fmt.Sprintf("Block ID or state summary ID that the snapshot given by %s must match", SnapshotImportFileKey))
 
 	// Bootstrapping
 	// TODO: combine "BootstrapIPsKey" and "BootstrapIDsKey" into one flag
@@ -313,6 +317,13 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Int(SnowOptimalProcessingKey, snowball.DefaultParameters.OptimalProcessing, "Optimal number of processing containers in consensus")
 	fs.Int(SnowMaxProcessingKey, snowball.DefaultParameters.MaxOutstandingItems, "Maximum number of processing items to be considered healthy")
 	fs.Duration(SnowMaxTimeProcessingKey, snowball.DefaultParameters.MaxItemProcessingTime, "Maximum amount of time an item should be processing and still be healthy")
+	fs.Bool(SnowDecisionTraceEnabledKey, false, "If true, the polls and decisions of Snowman chains are traced to rotating files")
+	fs.String(SnowDecisionTraceDirKey, defaultDecisionTraceDir, "Directory that Snowman decision traces are written to")
+	fs.Int(SnowDecisionTraceMaxSizeKey, 8, "Maximum size, in megabytes, of a decision trace file before it is rotated")
+	fs.Int(SnowDecisionTraceMaxFilesKey, 5, "Maximum number of rotated decision trace files to keep per chain")
+	fs.Int(SnowDecisionTraceMaxBlocksKey, 1024, "Number of recently traced blocks per chain whose traces are kept in memory to be queried")
+	fs.Bool(SnowChitLogEnabledKey, false, "If true, the chits sent by Snowman chains are persisted to the database")
+	fs.Uint64(SnowChitLogMaxEntriesKey, 100_000, "Maximum number of sent chits persisted per chain before the oldest are deleted")
 
 	// ProposerVM
 	fs.Bool(ProposerVMUseCurrentHeightKey, false, "Have the ProposerVM always report the last accepted P-chain block height")
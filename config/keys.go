@@ -52,6 +52,7 @@ const (
 	DBPathKey                                = "db-dir"
 	DBConfigFileKey                          = "db-config-file"
 	DBConfigContentKey                       = "db-config-file-content"
+	DBCacheBudgetSizeKey                     = "db-cache-budget-size"
 	PublicIPKey                              = "public-ip"
 	PublicIPResolutionFreqKey                = "public-ip-resolution-frequency"
 	PublicIPResolutionServiceKey             = "public-ip-resolution-service"
@@ -73,6 +74,8 @@ const (
 	HTTPIdleTimeoutKey                                 = "http-idle-timeout"
 	StateSyncIPsKey                                    = "state-sync-ips"
 	StateSyncIDsKey                                    = "state-sync-ids"
+	SnapshotImportFileKey                              = "snapshot-import-file"
+	SnapshotImportTrustedIDKey                         = "snapshot-import-trusted-id"
 	BootstrapIPsKey                                    = "bootstrap-ips"
 	BootstrapIDsKey                                    = "bootstrap-ids"
 	StakingHostKey                                     = "staking-host"
@@ -142,6 +145,13 @@ const (
 	SnowOptimalProcessingKey                           = "snow-optimal-processing"
 	SnowMaxProcessingKey                               = "snow-max-processing"
 	SnowMaxTimeProcessingKey                           = "snow-max-time-processing"
+	SnowDecisionTraceEnabledKey                        = "snow-decision-trace-enabled"
+	SnowDecisionTraceDirKey                            = "snow-decision-trace-dir"
+	SnowDecisionTraceMaxSizeKey                        = "snow-decision-trace-max-size"
+	SnowDecisionTraceMaxFilesKey                       = "snow-decision-trace-max-files"
+	SnowDecisionTraceMaxBlocksKey                      = "snow-decision-trace-max-blocks"
+	SnowChitLogEnabledKey                              = "snow-chit-log-enabled"
+	SnowChitLogMaxEntriesKey                           = "snow-chit-log-max-entries"
 	PartialSyncPrimaryNetworkKey                       = "partial-sync-primary-network"
 	TrackSubnetsKey                                    = "track-subnets"
 	AdminAPIEnabledKey                                 = "api-admin-enabled"
@@ -13,6 +13,8 @@ import (
 	"github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/chitlog"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/decisiontrace"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
@@ -87,6 +89,13 @@ type StakingConfig struct {
 type StateSyncConfig struct {
 	StateSyncIDs []ids.NodeID     `json:"stateSyncIDs"`
 	StateSyncIPs []netip.AddrPort `json:"stateSyncIPs"`
+
+	// SnapshotImportFile is the path of a chain snapshot to import before the
+	// chain is started. If empty, no snapshot is imported.
+	SnapshotImportFile string `json:"snapshotImportFile"`
+	// SnapshotImportTrustedID is the block ID or state summary ID that the
+	// imported snapshot must match.
+	SnapshotImportTrustedID ids.ID `json:"snapshotImportTrustedID"`
 }
 
 type BootstrapConfig struct {
@@ -119,6 +128,11 @@ type DatabaseConfig struct {
 
 	// Path to config file
 	Config []byte `json:"-"`
+
+	// Maximum number of bytes used by the database caches that are registered
+	// with the node's cache budget. Caches must opt in to the budget. If 0, no
+	// cache budget is created.
+	CacheBudgetSize int `json:"cacheBudgetSize"`
 }
 
 // Config contains all of the configurations of an Avalanche node.
@@ -152,6 +166,10 @@ type Config struct {
 
 	ProfilerConfig profiler.Config `json:"profilerConfig"`
 
+	DecisionTraceConfig decisiontrace.Config `json:"decisionTraceConfig"`
+
+	ChitLogConfig chitlog.Config `json:"chitLogConfig"`
+
 	LoggingConfig logging.Config `json:"loggingConfig"`
 
 	PluginDir string `json:"pluginDir"`
@@ -48,13 +48,27 @@ func (c *Client) AppRequestAny(
 	appRequestBytes []byte,
 	onResponse AppResponseCallback,
 ) error {
-	sampled := c.options.nodeSampler.Sample(ctx, 1)
-	if len(sampled) != 1 {
-		return ErrNoPeers
-	}
+	return c.AppRequestAnyExcept(ctx, nil, appRequestBytes, onResponse)
+}
 
-	nodeIDs := set.Of(sampled...)
-	return c.AppRequest(ctx, nodeIDs, appRequestBytes, onResponse)
+// AppRequestAnyExcept issues an AppRequest to an arbitrary node decided by
+// Client that isn't in [excluded].
+// See AppRequest for more docs.
+func (c *Client) AppRequestAnyExcept(
+	ctx context.Context,
+	excluded set.Set[ids.NodeID],
+	appRequestBytes []byte,
+	onResponse AppResponseCallback,
+) error {
+	// Sampling one more node than are excluded guarantees that a node that
+	// isn't excluded is sampled if one is available.
+	sampled := c.options.nodeSampler.Sample(ctx, excluded.Len()+1)
+	for _, nodeID := range sampled {
+		if !excluded.Contains(nodeID) {
+			return c.AppRequest(ctx, set.Of(nodeID), appRequestBytes, onResponse)
+		}
+	}
+	return ErrNoPeers
 }
 
 // AppRequest issues an arbitrary request to a node.
@@ -566,6 +566,63 @@ func TestAppRequestAnyNodeSelection(t *testing.T) {
 	}
 }
 
+func TestAppRequestAnyExceptNodeSelection(t *testing.T) {
+	nodeID0 := ids.GenerateTestNodeID()
+	nodeID1 := ids.GenerateTestNodeID()
+
+	tests := []struct {
+		name         string
+		peers        []ids.NodeID
+		excluded     set.Set[ids.NodeID]
+		expectedSent set.Set[ids.NodeID]
+		expected     error
+	}{
+		{
+			name:     "no peers",
+			excluded: set.Of(nodeID0),
+			expected: ErrNoPeers,
+		},
+		{
+			name:     "all peers excluded",
+			peers:    []ids.NodeID{nodeID0, nodeID1},
+			excluded: set.Of(nodeID0, nodeID1),
+			expected: ErrNoPeers,
+		},
+		{
+			name:         "peer not excluded",
+			peers:        []ids.NodeID{nodeID0, nodeID1},
+			excluded:     set.Of(nodeID0),
+			expectedSent: set.Of(nodeID1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			var sent set.Set[ids.NodeID]
+			sender := &enginetest.Sender{
+				SendAppRequestF: func(_ context.Context, nodeIDs set.Set[ids.NodeID], _ uint32, _ []byte) error {
+					sent = nodeIDs
+					return nil
+				},
+			}
+
+			n, err := NewNetwork(logging.NoLog{}, sender, prometheus.NewRegistry(), "")
+			require.NoError(err)
+			for _, peer := range tt.peers {
+				require.NoError(n.Connected(context.Background(), peer, &version.Application{}))
+			}
+
+			client := n.NewClient(1)
+
+			err = client.AppRequestAnyExcept(context.Background(), tt.excluded, []byte("foobar"), nil)
+			require.ErrorIs(err, tt.expected)
+			require.Equal(tt.expectedSent, sent)
+		})
+	}
+}
+
 func TestNodeSamplerClientOption(t *testing.T) {
 	nodeID0 := ids.GenerateTestNodeID()
 	nodeID1 := ids.GenerateTestNodeID()
@@ -121,6 +121,7 @@ func NewClientWithPeers(
 	for nodeID := range peers {
 		require.NoError(t, peerNetworks[nodeID].Connected(ctx, clientNodeID, nil))
 		require.NoError(t, peerNetworks[nodeID].Connected(ctx, nodeID, nil))
+		require.NoError(t, peerNetworks[clientNodeID].Connected(ctx, nodeID, nil))
 		require.NoError(t, peerNetworks[nodeID].AddHandler(0, peers[nodeID]))
 	}
 
@@ -30,6 +30,8 @@ import (
 	"github.com/ava-labs/avalanchego/api/info"
 	"github.com/ava-labs/avalanchego/api/metrics"
 	"github.com/ava-labs/avalanchego/api/server"
+	"github.com/ava-labs/avalanchego/cache"
+	"github.com/ava-labs/avalanchego/cache/metercacher"
 	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/chains/atomic"
 	"github.com/ava-labs/avalanchego/config/node"
@@ -50,6 +52,8 @@ import (
 	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/chitlog"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/decisiontrace"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/timeout"
@@ -93,6 +97,7 @@ const (
 
 	apiNamespace             = constants.PlatformName + metric.NamespaceSeparator + "api"
 	benchlistNamespace       = constants.PlatformName + metric.NamespaceSeparator + "benchlist"
+	cacheBudgetNamespace     = constants.PlatformName + metric.NamespaceSeparator + "cache_budget"
 	dbNamespace              = constants.PlatformName + metric.NamespaceSeparator + "db"
 	healthNamespace          = constants.PlatformName + metric.NamespaceSeparator + "health"
 	meterDBNamespace         = constants.PlatformName + metric.NamespaceSeparator + "meterdb"
@@ -110,6 +115,7 @@ var (
 	ungracefulShutdown = []byte("ungracefulShutdown")
 
 	indexerDBPrefix = []byte{0x00}
+	chitLogDBPrefix = []byte("chit log")
 
 	errInvalidTLSKey = errors.New("invalid TLS key")
 	errShuttingDown  = errors.New("server shutting down")
@@ -195,6 +201,10 @@ func New(
 		return nil, fmt.Errorf("problem initializing database: %w", err)
 	}
 
+	if err := n.initCacheBudget(); err != nil { // Set up the database cache budget
+		return nil, fmt.Errorf("problem initializing cache budget: %w", err)
+	}
+
 	n.initSharedMemory() // Initialize shared memory
 
 	// message.Creator is shared between networking, chainManager and the engine.
@@ -302,6 +312,16 @@ type Node struct {
 	// Profiles the process. Nil if continuous profiling is disabled.
 	profiler profiler.ContinuousProfiler
 
+	// Records the decisions made by the Snowman engine of each chain
+	decisionTraces *decisiontrace.Manager
+
+	// Records the chits sent by the Snowman engine of each chain
+	chitLogs *chitlog.Manager
+
+	// Bounds the total size of the database caches of the chains. Nil if the
+	// cache budget is disabled.
+	cacheBudget *cache.Budget
+
 	// Indexes blocks, transactions and blocks
 	indexer indexer.Indexer
 
@@ -755,6 +775,28 @@ func (n *Node) Dispatch() error {
  ******************************************************************************
  */
 
+// initCacheBudget creates the budget shared by the database caches of the
+// chains, if it is enabled, and exports its metrics.
+func (n *Node) initCacheBudget() error {
+	if n.Config.DatabaseConfig.CacheBudgetSize == 0 {
+		return nil
+	}
+
+	cacheBudgetRegisterer, err := metrics.MakeAndRegister(
+		n.MetricsGatherer,
+		cacheBudgetNamespace,
+	)
+	if err != nil {
+		return err
+	}
+
+	n.cacheBudget = cache.NewBudget(
+		n.Config.DatabaseConfig.CacheBudgetSize,
+		cache.DefaultRebalanceInterval,
+	)
+	return metercacher.RegisterBudget("", cacheBudgetRegisterer, n.cacheBudget)
+}
+
 func (n *Node) initDatabase() error {
 	dbRegisterer, err := metrics.MakeAndRegister(
 		n.MetricsGatherer,
@@ -1130,6 +1172,26 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 		return fmt.Errorf("failed to initialize subnets: %w", err)
 	}
 
+	var snapshotImport *chains.SnapshotImport
+	if n.Config.SnapshotImportFile != "" {
+		snapshotImport, err = chains.ReadSnapshotImport(n.Config.SnapshotImportFile, n.Config.SnapshotImportTrustedID)
+		if err != nil {
+			return fmt.Errorf("couldn't read snapshot to import: %w", err)
+		}
+		n.Log.Info("importing chain snapshot",
+			zap.String("path", snapshotImport.Path),
+			zap.Stringer("chainID", snapshotImport.Header.ChainID),
+			zap.Stringer("blockID", snapshotImport.Header.BlockID),
+			zap.Uint64("height", snapshotImport.Header.Height),
+		)
+	}
+
+	n.decisionTraces = decisiontrace.NewManager(n.Config.DecisionTraceConfig)
+	n.chitLogs = chitlog.NewManager(
+		n.Config.ChitLogConfig,
+		n.Log,
+		prefixdb.New(chitLogDBPrefix, n.DB),
+	)
 	n.chainManager, err = chains.New(
 		&chains.ManagerConfig{
 			SybilProtectionEnabled:                  n.Config.SybilProtectionEnabled,
@@ -1175,6 +1237,10 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 			TracingEnabled:                          n.Config.TraceConfig.Enabled,
 			Tracer:                                  n.tracer,
 			ChainDataDir:                            n.Config.ChainDataDir,
+			DecisionTraces:                          n.decisionTraces,
+			ChitLogs:                                n.chitLogs,
+			CacheBudget:                             n.cacheBudget,
+			SnapshotImport:                          snapshotImport,
 			Subnets:                                 subnets,
 		},
 	)
@@ -1326,15 +1392,17 @@ func (n *Node) initAdminAPI() error {
 	n.Log.Info("initializing admin API")
 	service, err := admin.NewService(
 		admin.Config{
-			Log:          n.Log,
-			DB:           n.DB,
-			ChainManager: n.chainManager,
-			HTTPServer:   n.APIServer,
-			ProfileDir:   n.Config.ProfilerConfig.Dir,
-			LogFactory:   n.LogFactory,
-			NodeConfig:   n.Config,
-			VMManager:    n.VMManager,
-			VMRegistry:   n.VMRegistry,
+			Log:            n.Log,
+			DB:             n.DB,
+			ChainManager:   n.chainManager,
+			HTTPServer:     n.APIServer,
+			ProfileDir:     n.Config.ProfilerConfig.Dir,
+			LogFactory:     n.LogFactory,
+			NodeConfig:     n.Config,
+			VMManager:      n.VMManager,
+			VMRegistry:     n.VMRegistry,
+			DecisionTraces: n.decisionTraces,
+			ChitLogs:       n.chitLogs,
 		},
 	)
 	if err != nil {
@@ -1710,6 +1778,20 @@ func (n *Node) shutdown() {
 			zap.Error(err),
 		)
 	}
+	if n.decisionTraces != nil {
+		if err := n.decisionTraces.Close(); err != nil {
+			n.Log.Debug("error closing decision traces",
+				zap.Error(err),
+			)
+		}
+	}
+	if n.chitLogs != nil {
+		if err := n.chitLogs.Close(); err != nil {
+			n.Log.Debug("error closing chit logs",
+				zap.Error(err),
+			)
+		}
+	}
 
 	// Ensure all runtimes are shutdown
 	n.Log.Info("cleaning up plugin runtimes")
@@ -45,6 +45,8 @@ const (
 	VM_GetLastStateSummary_FullMethodName        = "/vm.VM/GetLastStateSummary"
 	VM_ParseStateSummary_FullMethodName          = "/vm.VM/ParseStateSummary"
 	VM_GetStateSummary_FullMethodName            = "/vm.VM/GetStateSummary"
+	VM_StateSyncProgress_FullMethodName          = "/vm.VM/StateSyncProgress"
+	VM_BlockPreVerify_FullMethodName             = "/vm.VM/BlockPreVerify"
 	VM_BlockVerify_FullMethodName                = "/vm.VM/BlockVerify"
 	VM_BlockAccept_FullMethodName                = "/vm.VM/BlockAccept"
 	VM_BlockReject_FullMethodName                = "/vm.VM/BlockReject"
@@ -109,6 +111,15 @@ type VMClient interface {
 	// GetStateSummary retrieves the state summary that was generated at height
 	// [summaryHeight].
 	GetStateSummary(ctx context.Context, in *GetStateSummaryRequest, opts ...grpc.CallOption) (*GetStateSummaryResponse, error)
+	// StateSyncProgressVM
+	//
+	// StateSyncProgress returns the progress of the ongoing state sync.
+	StateSyncProgress(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StateSyncProgressResponse, error)
+	// PreVerifierChainVM
+	//
+	// BlockPreVerify performs the verification of a block that doesn't depend on
+	// the state of its ancestors.
+	BlockPreVerify(ctx context.Context, in *BlockPreVerifyRequest, opts ...grpc.CallOption) (*BlockPreVerifyResponse, error)
 	// Block
 	BlockVerify(ctx context.Context, in *BlockVerifyRequest, opts ...grpc.CallOption) (*BlockVerifyResponse, error)
 	BlockAccept(ctx context.Context, in *BlockAcceptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -350,6 +361,24 @@ func (c *vMClient) GetStateSummary(ctx context.Context, in *GetStateSummaryReque
 	return out, nil
 }
 
+func (c *vMClient) StateSyncProgress(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StateSyncProgressResponse, error) {
+	out := new(StateSyncProgressResponse)
+	err := c.cc.Invoke(ctx, VM_StateSyncProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMClient) BlockPreVerify(ctx context.Context, in *BlockPreVerifyRequest, opts ...grpc.CallOption) (*BlockPreVerifyResponse, error) {
+	out := new(BlockPreVerifyResponse)
+	err := c.cc.Invoke(ctx, VM_BlockPreVerify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vMClient) BlockVerify(ctx context.Context, in *BlockVerifyRequest, opts ...grpc.CallOption) (*BlockVerifyResponse, error) {
 	out := new(BlockVerifyResponse)
 	err := c.cc.Invoke(ctx, VM_BlockVerify_FullMethodName, in, out, opts...)
@@ -444,6 +473,15 @@ type VMServer interface {
 	// GetStateSummary retrieves the state summary that was generated at height
 	// [summaryHeight].
 	GetStateSummary(context.Context, *GetStateSummaryRequest) (*GetStateSummaryResponse, error)
+	// StateSyncProgressVM
+	//
+	// StateSyncProgress returns the progress of the ongoing state sync.
+	StateSyncProgress(context.Context, *emptypb.Empty) (*StateSyncProgressResponse, error)
+	// PreVerifierChainVM
+	//
+	// BlockPreVerify performs the verification of a block that doesn't depend on
+	// the state of its ancestors.
+	BlockPreVerify(context.Context, *BlockPreVerifyRequest) (*BlockPreVerifyResponse, error)
 	// Block
 	BlockVerify(context.Context, *BlockVerifyRequest) (*BlockVerifyResponse, error)
 	BlockAccept(context.Context, *BlockAcceptRequest) (*emptypb.Empty, error)
@@ -532,6 +570,12 @@ func (UnimplementedVMServer) ParseStateSummary(context.Context, *ParseStateSumma
 func (UnimplementedVMServer) GetStateSummary(context.Context, *GetStateSummaryRequest) (*GetStateSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStateSummary not implemented")
 }
+func (UnimplementedVMServer) StateSyncProgress(context.Context, *emptypb.Empty) (*StateSyncProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateSyncProgress not implemented")
+}
+func (UnimplementedVMServer) BlockPreVerify(context.Context, *BlockPreVerifyRequest) (*BlockPreVerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockPreVerify not implemented")
+}
 func (UnimplementedVMServer) BlockVerify(context.Context, *BlockVerifyRequest) (*BlockVerifyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockVerify not implemented")
 }
@@ -1007,6 +1051,42 @@ func _VM_GetStateSummary_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VM_StateSyncProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServer).StateSyncProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VM_StateSyncProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServer).StateSyncProgress(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VM_BlockPreVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockPreVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServer).BlockPreVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VM_BlockPreVerify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServer).BlockPreVerify(ctx, req.(*BlockPreVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VM_BlockVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlockVerifyRequest)
 	if err := dec(in); err != nil {
@@ -1186,6 +1266,14 @@ var VM_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStateSummary",
 			Handler:    _VM_GetStateSummary_Handler,
 		},
+		{
+			MethodName: "StateSyncProgress",
+			Handler:    _VM_StateSyncProgress_Handler,
+		},
+		{
+			MethodName: "BlockPreVerify",
+			Handler:    _VM_BlockPreVerify_Handler,
+		},
 		{
 			MethodName: "BlockVerify",
 			Handler:    _VM_BlockVerify_Handler,
@@ -66,3 +66,14 @@ type Consensus interface {
 	// Returns (Empty, false) if no such parent block is known.
 	GetParent(id ids.ID) (ids.ID, bool)
 }
+
+// Listener is notified of the changes made to a Consensus instance while
+// recording polls.
+type Listener interface {
+	// PreferenceChanged is called when a poll changes the preferred block.
+	PreferenceChanged(blkID ids.ID, height uint64)
+	// Accepted is called after a block has been accepted.
+	Accepted(blkID ids.ID, height uint64)
+	// Rejected is called after a block has been rejected.
+	Rejected(blkID ids.ID, height uint64)
+}
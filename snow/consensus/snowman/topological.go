@@ -42,6 +42,8 @@ func (tf TopologicalFactory) New() Consensus {
 // vote on more than just the next block.
 type Topological struct {
 	Factory snowball.Factory
+	// Listener, if non-nil, is notified of preference changes and decisions.
+	Listener Listener
 
 	metrics *metrics
 
@@ -241,6 +243,7 @@ func (ts *Topological) PreferenceAtHeight(height uint64) (ids.ID, bool) {
 func (ts *Topological) RecordPoll(ctx context.Context, voteBag bag.Bag[ids.ID]) error {
 	// Register a new poll call
 	ts.pollNumber++
+	oldPreference := ts.preference
 
 	var voteStack []votes
 	if voteBag.Len() >= ts.params.AlphaPreference {
@@ -304,6 +307,14 @@ func (ts *Topological) RecordPoll(ctx context.Context, voteBag bag.Bag[ids.ID])
 		// block.blk is non-nil here.
 		ts.preferredHeights[block.blk.Height()] = ts.preference
 	}
+
+	if ts.Listener != nil && ts.preference != oldPreference {
+		height := ts.lastAcceptedHeight
+		if block := ts.blocks[ts.preference]; block.blk != nil {
+			height = block.blk.Height()
+		}
+		ts.Listener.PreferenceChanged(ts.preference, height)
+	}
 	return nil
 }
 
@@ -619,6 +630,9 @@ func (ts *Topological) acceptPreferredChild(ctx context.Context, n *snowmanBlock
 		ts.pollNumber,
 		len(bytes),
 	)
+	if ts.Listener != nil {
+		ts.Listener.Accepted(pref, height)
+	}
 
 	// Because ts.blocks contains the last accepted block, we don't delete the
 	// block from the blocks map here.
@@ -640,6 +654,9 @@ func (ts *Topological) acceptPreferredChild(ctx context.Context, n *snowmanBlock
 			return err
 		}
 		ts.metrics.Rejected(childID, ts.pollNumber, len(child.Bytes()))
+		if ts.Listener != nil {
+			ts.Listener.Rejected(childID, child.Height())
+		}
 
 		// Track which blocks have been directly rejected
 		rejects = append(rejects, childID)
@@ -674,6 +691,9 @@ func (ts *Topological) rejectTransitively(ctx context.Context, rejected []ids.ID
 				return err
 			}
 			ts.metrics.Rejected(childID, ts.pollNumber, len(child.Bytes()))
+			if ts.Listener != nil {
+				ts.Listener.Rejected(childID, child.Height())
+			}
 
 			// add the newly rejected block to the end of the stack
 			rejected = append(rejected, childID)
@@ -4,11 +4,91 @@
 package snowman
 
 import (
+	"context"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils/bag"
 )
 
 func TestTopological(t *testing.T) {
 	runConsensusTests(t, TopologicalFactory{factory: snowball.SnowflakeFactory})
 }
+
+type listenerEvent struct {
+	event  string
+	blkID  ids.ID
+	height uint64
+}
+
+type testListener struct {
+	events []listenerEvent
+}
+
+func (l *testListener) PreferenceChanged(blkID ids.ID, height uint64) {
+	l.events = append(l.events, listenerEvent{"preferenceChanged", blkID, height})
+}
+
+func (l *testListener) Accepted(blkID ids.ID, height uint64) {
+	l.events = append(l.events, listenerEvent{"accepted", blkID, height})
+}
+
+func (l *testListener) Rejected(blkID ids.ID, height uint64) {
+	l.events = append(l.events, listenerEvent{"rejected", blkID, height})
+}
+
+func TestTopologicalListener(t *testing.T) {
+	require := require.New(t)
+
+	listener := &testListener{}
+	sm := &Topological{
+		Factory:  snowball.SnowflakeFactory,
+		Listener: listener,
+	}
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	params := snowball.Parameters{
+		K:                     1,
+		AlphaPreference:       1,
+		AlphaConfidence:       1,
+		Beta:                  1,
+		ConcurrentRepolls:     1,
+		OptimalProcessing:     1,
+		MaxOutstandingItems:   1,
+		MaxItemProcessingTime: 1,
+	}
+	require.NoError(sm.Initialize(
+		ctx,
+		params,
+		snowmantest.GenesisID,
+		snowmantest.GenesisHeight,
+		snowmantest.GenesisTimestamp,
+	))
+
+	block0 := snowmantest.BuildChild(snowmantest.Genesis)
+	block1 := snowmantest.BuildChild(snowmantest.Genesis)
+	block2 := snowmantest.BuildChild(block0)
+	require.NoError(sm.Add(block0))
+	require.NoError(sm.Add(block1))
+	require.NoError(sm.Add(block2))
+
+	// Adding blocks doesn't notify the listener.
+	require.Empty(listener.events)
+
+	require.NoError(sm.RecordPoll(context.Background(), bag.Of(block1.ID())))
+	require.Equal(
+		[]listenerEvent{
+			{"accepted", block1.ID(), block1.Height()},
+			{"rejected", block0.ID(), block0.Height()},
+			{"rejected", block2.ID(), block2.Height()},
+			{"preferenceChanged", block1.ID(), block1.Height()},
+		},
+		listener.events,
+	)
+}
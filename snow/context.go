@@ -9,6 +9,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/ava-labs/avalanchego/api/metrics"
+	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/chains/atomic"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/validators"
@@ -59,6 +60,14 @@ type Context struct {
 	ValidatorState validators.State // interface for P-Chain validators
 	// Chain-specific directory where arbitrary data can be written
 	ChainDataDir string
+
+	// CacheBudget, if non-nil, bounds the total size of the database caches
+	// that are registered with it. Registration is opt-in: VMs may pass it to
+	// their databases, such as with merkledb.Config.CacheBudget, or register
+	// their caches under a name that is unique to the chain. It is nil if the
+	// node's cache budget is disabled, which is the default, and for VMs
+	// running over rpcchainvm.
+	CacheBudget *cache.Budget
 }
 
 // Expose gatherer interface for unit testing.
@@ -0,0 +1,29 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+)
+
+var ErrPreVerifyNotImplemented = errors.New("vm does not implement PreVerifierChainVM interface")
+
+// PreVerifierChainVM defines the interface a ChainVM can optionally implement
+// to allow the bootstrapper to verify blocks concurrently, ahead of their
+// sequential execution.
+type PreVerifierChainVM interface {
+	// PreVerify performs the verification of [blk] that doesn't depend on the
+	// state of its ancestors, such as verifying its structure and signatures.
+	//
+	// PreVerify may be called concurrently for multiple blocks and before the
+	// parent of [blk] has been verified. Blocks that pass PreVerify will still
+	// be verified with [Verify] prior to being accepted.
+	//
+	// If PreVerifierChainVM is not implemented, as it may happen with a
+	// wrapper VM, ErrPreVerifyNotImplemented should be returned.
+	PreVerify(ctx context.Context, blk snowman.Block) error
+}
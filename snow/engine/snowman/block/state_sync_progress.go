@@ -0,0 +1,38 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrStateSyncProgressNotImplemented = errors.New("vm does not implement StateSyncProgressVM interface")
+
+// StateSyncProgress is the progress of an ongoing state sync.
+type StateSyncProgress struct {
+	// RangesCompleted is the number of key ranges that were fully fetched.
+	RangesCompleted uint64 `json:"rangesCompleted"`
+	// KeysFetched is the number of keys received from peers.
+	KeysFetched uint64 `json:"keysFetched"`
+	// BytesFetched is the number of bytes received from peers.
+	BytesFetched uint64 `json:"bytesFetched"`
+	// FractionCompleted is the estimated fraction, in [0, 1], of the state
+	// that has been synced.
+	FractionCompleted float64 `json:"fractionCompleted"`
+	// EstimatedRemainingKeys is the estimated number of keys that still need
+	// to be fetched.
+	EstimatedRemainingKeys uint64 `json:"estimatedRemainingKeys"`
+}
+
+// StateSyncProgressVM defines the interface a StateSyncableVM can optionally
+// implement to report the progress of an ongoing state sync.
+type StateSyncProgressVM interface {
+	// StateSyncProgress returns the progress of the ongoing state sync. The
+	// progress should account for the work performed before a restart.
+	//
+	// If StateSyncProgressVM is not implemented, as it may happen with a
+	// wrapper VM, ErrStateSyncProgressNotImplemented should be returned.
+	StateSyncProgress(context.Context) (StateSyncProgress, error)
+}
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -636,6 +638,39 @@ func (b *Bootstrapper) process(
 	return b.fetch(ctx, missingBlockID)
 }
 
+// preVerifier returns the pre-verifier of the blocks to execute, or nil if the
+// VM doesn't support pre-verification.
+func (b *Bootstrapper) preVerifier() *preVerifier {
+	vm, ok := b.VM.(block.PreVerifierChainVM)
+	if !ok {
+		return nil
+	}
+	// unsupported is set if the VM is wrapped around a VM that doesn't support
+	// pre-verification.
+	var unsupported atomic.Bool
+	return &preVerifier{
+		verify: func(ctx context.Context, blk snowman.Block) error {
+			if unsupported.Load() {
+				return nil
+			}
+
+			// The VM must be provided with its own block, rather than the
+			// wrapper used to track acceptance.
+			if acceptor, ok := blk.(*blockAcceptor); ok {
+				blk = acceptor.Block
+			}
+			err := vm.PreVerify(ctx, blk)
+			if errors.Is(err, block.ErrPreVerifyNotImplemented) {
+				unsupported.Store(true)
+				return nil
+			}
+			return err
+		},
+		numWorkers: runtime.NumCPU(),
+		metrics:    b.metrics,
+	}
+}
+
 // tryStartExecuting executes all pending blocks if there are no more blocks
 // being fetched. After executing all pending blocks it will either restart
 // bootstrapping, or transition into normal operations.
@@ -669,6 +704,7 @@ func (b *Bootstrapper) tryStartExecuting(ctx context.Context) error {
 			ctx:         b.Ctx,
 			numAccepted: b.numAccepted,
 		},
+		b.preVerifier(),
 		b.tree,
 		lastAccepted.Height(),
 	)
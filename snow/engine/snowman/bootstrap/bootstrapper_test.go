@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -28,8 +29,14 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/getter"
 	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/trace"
+	"github.com/ava-labs/avalanchego/upgrade"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/version"
+	"github.com/ava-labs/avalanchego/vms/metervm"
+	"github.com/ava-labs/avalanchego/vms/proposervm"
+	"github.com/ava-labs/avalanchego/vms/tracedvm"
 
 	p2ppb "github.com/ava-labs/avalanchego/proto/pb/p2p"
 )
@@ -788,6 +795,109 @@ func TestBootstrapperRollbackOnSetState(t *testing.T) {
 	require.Equal(blks[0].HeightV, bs.startingHeight)
 }
 
+type preVerifierVM struct {
+	*blocktest.VM
+
+	lock        sync.Mutex
+	preVerified []snowman.Block
+}
+
+func (vm *preVerifierVM) PreVerify(_ context.Context, blk snowman.Block) error {
+	vm.lock.Lock()
+	defer vm.lock.Unlock()
+
+	vm.preVerified = append(vm.preVerified, blk)
+	return nil
+}
+
+// Blocks should be pre-verified by the innermost VM when the VM is wrapped the
+// same way as it is by the chain manager.
+func TestBootstrapperPreVerifyWrappedVM(t *testing.T) {
+	require := require.New(t)
+
+	config, peerID, sender, vm, _ := newConfig(t)
+
+	blks := snowmantest.BuildChain(4)
+	initializeVMWithBlockchain(vm, blks)
+	vm.InitializeF = func(context.Context, *snow.Context, database.Database, []byte, []byte, []byte, chan<- common.Message, []*common.Fx, common.AppSender) error {
+		return nil
+	}
+
+	innerVM := &preVerifierVM{
+		VM: vm,
+	}
+	proVM := proposervm.New(
+		tracedvm.NewBlockVM(
+			metervm.NewBlockVM(innerVM, prometheus.NewRegistry()),
+			"inner",
+			trace.Noop,
+		),
+		proposervm.Config{
+			Upgrades: upgrade.Config{
+				ApricotPhase4Time: mockable.MaxTime,
+				DurangoTime:       mockable.MaxTime,
+			},
+			MinBlkDelay:         proposervm.DefaultMinBlockDelay,
+			NumHistoricalBlocks: proposervm.DefaultNumHistoricalBlocks,
+			Registerer:          prometheus.NewRegistry(),
+		},
+	)
+	wrappedVM := tracedvm.NewBlockVM(
+		metervm.NewBlockVM(proVM, prometheus.NewRegistry()),
+		"proposervm",
+		trace.Noop,
+	)
+	require.NoError(wrappedVM.Initialize(
+		context.Background(),
+		config.Ctx.Context,
+		memdb.New(),
+		nil,
+		nil,
+		nil,
+		make(chan common.Message, 1),
+		nil,
+		nil,
+	))
+	defer func() {
+		vm.CantShutdown = false
+		require.NoError(wrappedVM.Shutdown(context.Background()))
+	}()
+
+	config.VM = wrappedVM
+	config.NonVerifyingParse = proVM.ParseLocalBlock
+
+	bs, err := New(
+		config,
+		func(context.Context, uint32) error {
+			config.Ctx.State.Set(snow.EngineState{
+				Type:  p2ppb.EngineType_ENGINE_TYPE_SNOWMAN,
+				State: snow.NormalOp,
+			})
+			return nil
+		},
+	)
+	require.NoError(err)
+	bs.TimeoutRegistrar = &enginetest.Timer{}
+
+	require.NoError(bs.Start(context.Background(), 0))
+
+	var requestID uint32
+	sender.SendGetAncestorsF = func(_ context.Context, nodeID ids.NodeID, reqID uint32, blkID ids.ID) {
+		require.Equal(peerID, nodeID)
+		require.Equal(blks[3].ID(), blkID)
+		requestID = reqID
+	}
+
+	require.NoError(bs.startSyncing(context.Background(), blocksToIDs(blks[3:4])))
+	require.NoError(bs.Ancestors(context.Background(), peerID, requestID, blocksToBytes(blks)))
+	snowmantest.RequireStatusIs(require, snowtest.Accepted, blks...)
+
+	require.ElementsMatch(
+		[]snowman.Block{blks[1], blks[2], blks[3]},
+		innerVM.preVerified,
+	)
+}
+
 func initializeVMWithBlockchain(vm *blocktest.VM, blocks []*snowmantest.Block) {
 	vm.CantSetState = false
 	vm.LastAcceptedF = snowmantest.MakeLastAcceptedBlockF(
@@ -11,6 +11,10 @@ import (
 
 type metrics struct {
 	numFetched, numAccepted prometheus.Counter
+
+	numPreVerified    prometheus.Counter
+	preVerifyTime     prometheus.Counter
+	preVerifyWaitTime prometheus.Counter
 }
 
 func newMetrics(registerer prometheus.Registerer) (*metrics, error) {
@@ -23,11 +27,26 @@ func newMetrics(registerer prometheus.Registerer) (*metrics, error) {
 			Name: "bs_accepted",
 			Help: "Number of blocks accepted during bootstrapping",
 		}),
+		numPreVerified: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "bs_pre_verified",
+			Help: "Number of blocks pre-verified during bootstrapping",
+		}),
+		preVerifyTime: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "bs_pre_verify_time",
+			Help: "Cumulative time spent (ns) by all workers pre-verifying blocks during bootstrapping",
+		}),
+		preVerifyWaitTime: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "bs_pre_verify_wait_time",
+			Help: "Time spent (ns) waiting for blocks to be pre-verified before executing them during bootstrapping",
+		}),
 	}
 
 	err := errors.Join(
 		registerer.Register(m.numFetched),
 		registerer.Register(m.numAccepted),
+		registerer.Register(m.numPreVerified),
+		registerer.Register(m.preVerifyTime),
+		registerer.Register(m.preVerifyWaitTime),
 	)
 	return m, err
 }
@@ -0,0 +1,122 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bootstrap
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+)
+
+// maxPreVerifyAhead is the maximum number of blocks that are read and
+// pre-verified ahead of the block currently being executed.
+const maxPreVerifyAhead = 1024
+
+// preVerifier runs the stateless verification of blocks on a pool of workers
+// ahead of their sequential execution.
+type preVerifier struct {
+	verify     func(context.Context, snowman.Block) error
+	numWorkers int
+	metrics    *metrics
+}
+
+type preVerifyJob struct {
+	blk  snowman.Block
+	err  error
+	done chan struct{}
+}
+
+// preVerifyPool is a running instance of a [preVerifier].
+type preVerifyPool struct {
+	verifier *preVerifier
+	jobs     chan *preVerifyJob
+	// closed once the pool is stopped to skip the remaining jobs.
+	stopping chan struct{}
+	wg       sync.WaitGroup
+}
+
+// start the workers of the pool. If [v] is nil, blocks are not pre-verified.
+func (v *preVerifier) start(ctx context.Context) *preVerifyPool {
+	p := &preVerifyPool{
+		verifier: v,
+	}
+	if v == nil {
+		return p
+	}
+
+	p.jobs = make(chan *preVerifyJob, maxPreVerifyAhead)
+	p.stopping = make(chan struct{})
+	p.wg.Add(v.numWorkers)
+	for i := 0; i < v.numWorkers; i++ {
+		go func() {
+			defer p.wg.Done()
+			for job := range p.jobs {
+				select {
+				case <-p.stopping:
+					close(job.done)
+					continue
+				default:
+				}
+
+				startTime := time.Now()
+				job.err = v.verify(ctx, job.blk)
+				v.metrics.preVerifyTime.Add(float64(time.Since(startTime)))
+				v.metrics.numPreVerified.Inc()
+				close(job.done)
+			}
+		}()
+	}
+	return p
+}
+
+// maxAhead returns the number of blocks that should be read ahead of
+// execution.
+func (p *preVerifyPool) maxAhead() int {
+	if p.verifier == nil {
+		return 1
+	}
+	return maxPreVerifyAhead
+}
+
+// add [blk] to be pre-verified. If [verify] is false, the returned job is
+// immediately completed.
+//
+// At most [maxAhead] jobs may be outstanding.
+func (p *preVerifyPool) add(blk snowman.Block, verify bool) *preVerifyJob {
+	job := &preVerifyJob{
+		blk:  blk,
+		done: make(chan struct{}),
+	}
+	if p.verifier == nil || !verify {
+		close(job.done)
+		return job
+	}
+	p.jobs <- job
+	return job
+}
+
+// wait for [job] to be pre-verified and return its result.
+func (p *preVerifyPool) wait(job *preVerifyJob) error {
+	select {
+	case <-job.done:
+	default:
+		startTime := time.Now()
+		<-job.done
+		p.verifier.metrics.preVerifyWaitTime.Add(float64(time.Since(startTime)))
+	}
+	return job.err
+}
+
+// stop the workers. Outstanding jobs that haven't been started are completed
+// without being verified.
+func (p *preVerifyPool) stop() {
+	if p.jobs == nil {
+		return
+	}
+	close(p.stopping)
+	close(p.jobs)
+	p.wg.Wait()
+}
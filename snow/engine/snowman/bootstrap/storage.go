@@ -15,6 +15,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/bootstrap/interval"
+	"github.com/ava-labs/avalanchego/utils/buffer"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer"
@@ -123,6 +124,9 @@ func process(
 //
 // execute assumes that getMissingBlockIDs would return an empty set.
 //
+// If [preVerifier] is non-nil, blocks are pre-verified concurrently ahead of
+// their execution.
+//
 // TODO: Replace usage of haltable with context cancellation.
 func execute(
 	ctx context.Context,
@@ -130,6 +134,7 @@ func execute(
 	log logging.Func,
 	db database.Database,
 	nonVerifyingParser block.Parser,
+	preVerifier *preVerifier,
 	tree *interval.Tree,
 	lastAcceptedHeight uint64,
 ) error {
@@ -162,11 +167,18 @@ func execute(
 
 		iterator                      = interval.GetBlockIterator(db)
 		processedSinceIteratorRelease uint
+		lastReadHeight                uint64
+
+		// Blocks are read from the database ahead of their execution so that
+		// they can be pre-verified concurrently.
+		preVerifyPool = preVerifier.start(ctx)
+		pending       = buffer.NewUnboundedDeque[*preVerifyJob](preVerifyPool.maxAhead())
 
 		startTime     = time.Now()
 		timeOfNextLog = startTime.Add(logPeriod)
 	)
 	defer func() {
+		preVerifyPool.stop()
 		iterator.Release()
 
 		var (
@@ -195,13 +207,24 @@ func execute(
 		zap.Uint64("numToExecute", totalNumberToProcess),
 	)
 
-	for !shouldHalt() && iterator.Next() {
-		blkBytes := iterator.Value()
-		blk, err := nonVerifyingParser.ParseBlock(ctx, blkBytes)
-		if err != nil {
-			return err
+	for !shouldHalt() {
+		for pending.Len() < preVerifyPool.maxAhead() && iterator.Next() {
+			blkBytes := iterator.Value()
+			blk, err := nonVerifyingParser.ParseBlock(ctx, blkBytes)
+			if err != nil {
+				return err
+			}
+
+			lastReadHeight = blk.Height()
+			pending.PushRight(preVerifyPool.add(blk, lastReadHeight > lastAcceptedHeight))
 		}
 
+		job, ok := pending.PopLeft()
+		if !ok {
+			break
+		}
+
+		blk := job.blk
 		height := blk.Height()
 		if err := interval.Remove(batch, tree, height); err != nil {
 			return err
@@ -232,8 +255,9 @@ func execute(
 			iterator.Release()
 			// We specify the starting key of the iterator so that the
 			// underlying database doesn't need to scan over the, potentially
-			// not yet compacted, blocks we just deleted.
-			iterator = interval.GetBlockIteratorWithStart(db, height+1)
+			// not yet compacted, blocks we just deleted. Blocks that were
+			// already read ahead of execution are skipped.
+			iterator = interval.GetBlockIteratorWithStart(db, lastReadHeight+1)
 		}
 
 		if now := time.Now(); now.After(timeOfNextLog) {
@@ -253,6 +277,14 @@ func execute(
 			continue
 		}
 
+		if err := preVerifyPool.wait(job); err != nil {
+			return fmt.Errorf("failed to pre-verify block %s (height=%d, parentID=%s) in bootstrapping: %w",
+				blk.ID(),
+				height,
+				blk.Parent(),
+				err,
+			)
+		}
 		if err := blk.Verify(ctx); err != nil {
 			return fmt.Errorf("failed to verify block %s (height=%d, parentID=%s) in bootstrapping: %w",
 				blk.ID(),
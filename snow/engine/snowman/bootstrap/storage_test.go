@@ -6,8 +6,12 @@ package bootstrap
 import (
 	"bytes"
 	"context"
+	"errors"
+	"sync"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/database"
@@ -273,6 +277,7 @@ func TestExecute(t *testing.T) {
 				logging.NoLog{}.Info,
 				db,
 				parser,
+				nil,
 				tree,
 				test.lastAcceptedHeight,
 			))
@@ -294,6 +299,130 @@ func TestExecute(t *testing.T) {
 	}
 }
 
+func TestExecutePreVerify(t *testing.T) {
+	errTest := errors.New("non-nil error")
+
+	tests := []struct {
+		name                 string
+		numBlocks            int
+		lastAcceptedHeight   uint64
+		failHeight           uint64 // 0 if pre-verification never fails
+		expectedErr          error
+		expectedPreVerified  int
+		expectedAcceptedUpTo uint64
+	}{
+		{
+			name:                 "pre-verify everything",
+			numBlocks:            7,
+			expectedPreVerified:  6,
+			expectedAcceptedUpTo: 6,
+		},
+		{
+			name:                 "do not pre-verify blocks accepted by height",
+			numBlocks:            7,
+			lastAcceptedHeight:   3,
+			expectedPreVerified:  3,
+			expectedAcceptedUpTo: 6,
+		},
+		{
+			name:                 "pre-verify more blocks than read ahead",
+			numBlocks:            2*maxPreVerifyAhead + iteratorReleasePeriod/2,
+			expectedPreVerified:  2*maxPreVerifyAhead + iteratorReleasePeriod/2 - 1,
+			expectedAcceptedUpTo: 2*maxPreVerifyAhead + iteratorReleasePeriod/2 - 1,
+		},
+		{
+			name:                 "pre-verification failure",
+			numBlocks:            7,
+			failHeight:           4,
+			expectedErr:          errTest,
+			expectedAcceptedUpTo: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			db := memdb.New()
+			tree, err := interval.NewTree(db)
+			require.NoError(err)
+
+			blocks := snowmantest.BuildChain(test.numBlocks)
+			blocksByID := make(map[ids.ID]*snowmantest.Block, len(blocks))
+			for _, blk := range blocks {
+				blocksByID[blk.ID()] = blk
+				_, err := interval.Add(db, tree, 0, blk.Height(), blk.Bytes())
+				require.NoError(err)
+			}
+			parser := testParser(func(_ context.Context, b []byte) (snowman.Block, error) {
+				blkID, err := ids.ToID(b)
+				if err != nil {
+					return nil, err
+				}
+				blk, ok := blocksByID[blkID]
+				if !ok {
+					return nil, database.ErrNotFound
+				}
+				return blk, nil
+			})
+
+			metrics, err := newMetrics(prometheus.NewRegistry())
+			require.NoError(err)
+
+			var (
+				lock        sync.Mutex
+				preVerified = set.Set[uint64]{}
+			)
+			verifier := &preVerifier{
+				verify: func(_ context.Context, blk snowman.Block) error {
+					height := blk.Height()
+
+					lock.Lock()
+					defer lock.Unlock()
+
+					preVerified.Add(height)
+					if height == test.failHeight {
+						return errTest
+					}
+					return nil
+				},
+				numWorkers: 4,
+				metrics:    metrics,
+			}
+
+			err = execute(
+				context.Background(),
+				(&common.Halter{}).Halted,
+				logging.NoLog{}.Info,
+				db,
+				parser,
+				verifier,
+				tree,
+				test.lastAcceptedHeight,
+			)
+			require.ErrorIs(err, test.expectedErr)
+			for _, blk := range blocks {
+				height := blk.Height()
+				switch {
+				case height > test.expectedAcceptedUpTo:
+					require.Equal(snowtest.Undecided, blk.Status)
+				case height > test.lastAcceptedHeight:
+					require.Equal(snowtest.Accepted, blk.Status)
+				}
+			}
+			if test.expectedErr != nil {
+				return
+			}
+
+			require.Len(preVerified, test.expectedPreVerified)
+			require.Equal(float64(test.expectedPreVerified), testutil.ToFloat64(metrics.numPreVerified))
+
+			size, err := database.Count(db)
+			require.NoError(err)
+			require.Zero(size)
+		})
+	}
+}
+
 type testParser func(context.Context, []byte) (snowman.Block, error)
 
 func (f testParser) ParseBlock(ctx context.Context, bytes []byte) (snowman.Block, error) {
@@ -0,0 +1,444 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package chitlog records the chits that the Snowman engine sends, so that the
+// votes of a node can be audited after the fact.
+package chitlog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+const (
+	// MaxLimit is the maximum number of chits that can be returned by a
+	// single query.
+	MaxLimit = 1024
+
+	// flushFrequency is how often the chits sent since the last flush are
+	// written to the database.
+	flushFrequency = time.Second
+	// maxPendingChits is the number of chits that triggers a flush before
+	// [flushFrequency] elapses.
+	maxPendingChits = 256
+
+	chitLen = 8 + 4 + ids.NodeIDLen + 8 + 3*ids.IDLen + 8
+)
+
+var (
+	_ Log = (*log)(nil)
+	_ Log = Noop{}
+
+	// Chits are stored under [entryPrefix] followed by their sequence number.
+	entryPrefix = []byte{0x00}
+	nextKey     = []byte{0x01}
+	oldestKey   = []byte{0x02}
+	// The sequence numbers of chits are indexed under [heightPrefix] followed
+	// by the requested height, mapping to the node ID of the chit, and under
+	// [nodePrefix] followed by the node ID.
+	heightPrefix = []byte{0x03}
+	nodePrefix   = []byte{0x04}
+
+	errInvalidChit = errors.New("invalid chit")
+)
+
+// Chit is a single chit that was sent by this node.
+type Chit struct {
+	Time time.Time `json:"time"`
+	// RequestID is the ID of the query that the chit responded to.
+	RequestID uint32 `json:"requestID"`
+	// NodeID is the node that requested the chit.
+	NodeID ids.NodeID `json:"nodeID"`
+	// RequestedHeight is the height of the block that was queried.
+	RequestedHeight uint64 `json:"requestedHeight"`
+	// PreferredID is the preferred tip of this node.
+	PreferredID ids.ID `json:"preferredID"`
+	// PreferredIDAtHeight is the block this node preferred at
+	// [RequestedHeight].
+	PreferredIDAtHeight ids.ID `json:"preferredIDAtHeight"`
+	// AcceptedID is the last accepted block of this node.
+	AcceptedID ids.ID `json:"acceptedID"`
+	// AcceptedHeight is the height of [AcceptedID].
+	AcceptedHeight uint64 `json:"acceptedHeight"`
+}
+
+// Filter restricts the chits returned by a query.
+type Filter struct {
+	// NodeID, if non-nil, only returns chits sent to this node.
+	NodeID *ids.NodeID
+	// RequestedHeight, if non-nil, only returns chits that responded to
+	// queries for this height.
+	RequestedHeight *uint64
+	// Limit is the maximum number of chits to return. The most recent chits
+	// are returned.
+	Limit int
+}
+
+// Log records the chits sent by the Snowman engine. Logs must be safe to query
+// concurrently with the engine recording chits.
+type Log interface {
+	// Sent is called after the engine sends [chit]. The time of [chit] is set
+	// by the Log.
+	Sent(chit Chit)
+
+	// Chits returns the most recently sent chits that match [filter], ordered
+	// from oldest to newest.
+	Chits(filter Filter) ([]Chit, error)
+
+	// Close persists the recorded chits. Chits recorded after Close are not
+	// persisted.
+	Close() error
+}
+
+// Noop is a Log that doesn't record anything.
+type Noop struct{}
+
+func (Noop) Sent(Chit) {}
+
+func (Noop) Chits(Filter) ([]Chit, error) {
+	return nil, nil
+}
+
+func (Noop) Close() error {
+	return nil
+}
+
+// log persists the last [maxEntries] chits to a database.
+//
+// Chits are keyed by their sequence number, so iterating over the database
+// returns them from oldest to newest. Sent chits are buffered and written to
+// the database periodically, so that recording a chit doesn't block the
+// engine on a database write.
+type log struct {
+	logger     logging.Logger
+	clock      mockable.Clock
+	maxEntries uint64
+
+	// flush is signaled when [maxPendingChits] chits are pending.
+	flush chan struct{}
+	// closed is closed when the log is closed.
+	closed chan struct{}
+	// done is closed once the chits are no longer flushed periodically.
+	done chan struct{}
+
+	lock sync.Mutex
+	db   database.Database
+	// pending are the chits that haven't been written to [db] yet.
+	pending []Chit
+	// next is the sequence number of the next chit to be written.
+	next uint64
+	// oldest is the sequence number of the oldest written chit.
+	oldest uint64
+}
+
+// New returns a Log that persists the last [maxEntries] chits to [db].
+func New(logger logging.Logger, db database.Database, maxEntries uint64) (Log, error) {
+	l := &log{
+		logger:     logger,
+		maxEntries: maxEntries,
+		flush:      make(chan struct{}, 1),
+		closed:     make(chan struct{}),
+		done:       make(chan struct{}),
+		db:         db,
+	}
+
+	var err error
+	l.next, err = database.WithDefault(database.GetUInt64, db, nextKey, 0)
+	if err != nil {
+		return nil, err
+	}
+	l.oldest, err = database.WithDefault(database.GetUInt64, db, oldestKey, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// [maxEntries] may have been reduced since the log was last written to.
+	batch := db.NewBatch()
+	if err := l.prune(batch); err != nil {
+		return nil, err
+	}
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+
+	go l.flushPeriodically()
+	return l, nil
+}
+
+func (l *log) Sent(chit Chit) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	chit.Time = l.clock.Time()
+	l.pending = append(l.pending, chit)
+	if len(l.pending) < maxPendingChits {
+		return
+	}
+
+	select {
+	case l.flush <- struct{}{}:
+	default:
+	}
+}
+
+func (l *log) flushPeriodically() {
+	defer close(l.done)
+
+	ticker := time.NewTicker(flushFrequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-l.flush:
+		case <-l.closed:
+			return
+		}
+
+		l.lock.Lock()
+		err := l.write()
+		l.lock.Unlock()
+		if err != nil {
+			l.logger.Warn("failed to record chits",
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// write the pending chits to the database.
+//
+// Assumes [l.lock] is held.
+func (l *log) write() error {
+	if len(l.pending) == 0 {
+		return nil
+	}
+
+	// Chits that would be pruned immediately aren't written.
+	var (
+		numPending = uint64(len(l.pending))
+		next       = l.next + numPending
+		skip       = uint64(0)
+	)
+	if numPending > l.maxEntries {
+		skip = numPending - l.maxEntries
+	}
+
+	batch := l.db.NewBatch()
+	for i := skip; i < numPending; i++ {
+		if err := putChit(batch, l.next+i, &l.pending[i]); err != nil {
+			return err
+		}
+	}
+	l.next = next
+	l.pending = l.pending[:0]
+
+	if err := l.prune(batch); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// prune deletes the oldest chits until at most [maxEntries] are stored and
+// persists the sequence numbers.
+//
+// Chits that were skipped by [write] aren't stored, so they are only removed
+// from the sequence.
+func (l *log) prune(batch database.KeyValueWriterDeleter) error {
+	for l.next-l.oldest > l.maxEntries {
+		if err := l.deleteChit(batch, l.oldest); err != nil {
+			return err
+		}
+		l.oldest++
+	}
+	if err := database.PutUInt64(batch, nextKey, l.next); err != nil {
+		return err
+	}
+	return database.PutUInt64(batch, oldestKey, l.oldest)
+}
+
+func (l *log) deleteChit(batch database.KeyValueDeleter, sequenceNumber uint64) error {
+	chitBytes, err := l.db.Get(entryKey(sequenceNumber))
+	if errors.Is(err, database.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	chit, err := unmarshalChit(chitBytes)
+	if err != nil {
+		return err
+	}
+	return errors.Join(
+		batch.Delete(entryKey(sequenceNumber)),
+		batch.Delete(heightKey(chit.RequestedHeight, sequenceNumber)),
+		batch.Delete(nodeKey(chit.NodeID, sequenceNumber)),
+	)
+}
+
+func putChit(batch database.KeyValueWriter, sequenceNumber uint64, chit *Chit) error {
+	return errors.Join(
+		batch.Put(entryKey(sequenceNumber), marshalChit(chit)),
+		batch.Put(heightKey(chit.RequestedHeight, sequenceNumber), chit.NodeID[:]),
+		batch.Put(nodeKey(chit.NodeID, sequenceNumber), nil),
+	)
+}
+
+// Chits writes the pending chits to the database and then looks up the chits
+// that match [filter] using the height and node indices, so that only the
+// matching chits are read.
+func (l *log) Chits(filter Filter) ([]Chit, error) {
+	limit := filter.Limit
+	if limit <= 0 || limit > MaxLimit {
+		limit = MaxLimit
+	}
+
+	l.lock.Lock()
+	err := l.write()
+	next := l.next
+	oldest := l.oldest
+	l.lock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	var sequenceNumbers []uint64
+	switch {
+	case filter.RequestedHeight != nil:
+		sequenceNumbers, err = l.indexed(heightKey(*filter.RequestedHeight), func(nodeID []byte) bool {
+			return filter.NodeID == nil || bytes.Equal(filter.NodeID[:], nodeID)
+		})
+	case filter.NodeID != nil:
+		sequenceNumbers, err = l.indexed(nodeKey(*filter.NodeID), func([]byte) bool {
+			return true
+		})
+	default:
+		first := oldest
+		if next-oldest > uint64(limit) {
+			first = next - uint64(limit)
+		}
+		for sequenceNumber := first; sequenceNumber < next; sequenceNumber++ {
+			sequenceNumbers = append(sequenceNumbers, sequenceNumber)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sequenceNumbers) > limit {
+		sequenceNumbers = sequenceNumbers[len(sequenceNumbers)-limit:]
+	}
+	chits := make([]Chit, 0, len(sequenceNumbers))
+	for _, sequenceNumber := range sequenceNumbers {
+		chitBytes, err := l.db.Get(entryKey(sequenceNumber))
+		if errors.Is(err, database.ErrNotFound) {
+			// The chit was pruned concurrently.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		chit, err := unmarshalChit(chitBytes)
+		if err != nil {
+			return nil, err
+		}
+		chits = append(chits, chit)
+	}
+	return chits, nil
+}
+
+// indexed returns, in order, the sequence numbers indexed under [prefix] whose
+// indexed value matches.
+func (l *log) indexed(prefix []byte, matches func(value []byte) bool) ([]uint64, error) {
+	iterator := l.db.NewIteratorWithPrefix(prefix)
+	defer iterator.Release()
+
+	var sequenceNumbers []uint64
+	for iterator.Next() {
+		if !matches(iterator.Value()) {
+			continue
+		}
+		key := iterator.Key()
+		sequenceNumbers = append(sequenceNumbers, binary.BigEndian.Uint64(key[len(key)-8:]))
+	}
+	return sequenceNumbers, iterator.Error()
+}
+
+func (l *log) Close() error {
+	close(l.closed)
+	<-l.done
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.write()
+}
+
+func entryKey(sequenceNumber uint64) []byte {
+	return binary.BigEndian.AppendUint64(entryPrefix[:len(entryPrefix):len(entryPrefix)], sequenceNumber)
+}
+
+// heightKey returns the key of the chit with [sequenceNumber] in the height
+// index. If no sequence number is provided, the prefix of the chits that
+// responded to queries for [height] is returned.
+func heightKey(height uint64, sequenceNumber ...uint64) []byte {
+	key := binary.BigEndian.AppendUint64(heightPrefix[:len(heightPrefix):len(heightPrefix)], height)
+	for _, n := range sequenceNumber {
+		key = binary.BigEndian.AppendUint64(key, n)
+	}
+	return key
+}
+
+// nodeKey returns the key of the chit with [sequenceNumber] in the node index.
+// If no sequence number is provided, the prefix of the chits that were sent to
+// [nodeID] is returned.
+func nodeKey(nodeID ids.NodeID, sequenceNumber ...uint64) []byte {
+	key := append(nodePrefix[:len(nodePrefix):len(nodePrefix)], nodeID[:]...)
+	for _, n := range sequenceNumber {
+		key = binary.BigEndian.AppendUint64(key, n)
+	}
+	return key
+}
+
+func marshalChit(chit *Chit) []byte {
+	b := make([]byte, 0, chitLen)
+	b = binary.BigEndian.AppendUint64(b, uint64(chit.Time.UnixNano()))
+	b = binary.BigEndian.AppendUint32(b, chit.RequestID)
+	b = append(b, chit.NodeID[:]...)
+	b = binary.BigEndian.AppendUint64(b, chit.RequestedHeight)
+	b = append(b, chit.PreferredID[:]...)
+	b = append(b, chit.PreferredIDAtHeight[:]...)
+	b = append(b, chit.AcceptedID[:]...)
+	return binary.BigEndian.AppendUint64(b, chit.AcceptedHeight)
+}
+
+func unmarshalChit(b []byte) (Chit, error) {
+	if len(b) != chitLen {
+		return Chit{}, fmt.Errorf("%w: expected %d bytes but got %d", errInvalidChit, chitLen, len(b))
+	}
+
+	var chit Chit
+	chit.Time = time.Unix(0, int64(binary.BigEndian.Uint64(b))).UTC()
+	b = b[8:]
+	chit.RequestID = binary.BigEndian.Uint32(b)
+	b = b[4:]
+	b = b[copy(chit.NodeID[:], b):]
+	chit.RequestedHeight = binary.BigEndian.Uint64(b)
+	b = b[8:]
+	b = b[copy(chit.PreferredID[:], b):]
+	b = b[copy(chit.PreferredIDAtHeight[:], b):]
+	b = b[copy(chit.AcceptedID[:], b):]
+	chit.AcceptedHeight = binary.BigEndian.Uint64(b)
+	return chit, nil
+}
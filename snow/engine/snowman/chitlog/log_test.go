@@ -0,0 +1,249 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chitlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+var (
+	nodeID0 = ids.GenerateTestNodeID()
+	nodeID1 = ids.GenerateTestNodeID()
+)
+
+// newTestChits returns [n] chits sent alternately to [nodeID0] and [nodeID1].
+func newTestChits(n int) []Chit {
+	chits := make([]Chit, n)
+	for i := range chits {
+		nodeID := nodeID0
+		if i%2 == 1 {
+			nodeID = nodeID1
+		}
+		chits[i] = Chit{
+			Time:                time.Unix(int64(i), 0).UTC(),
+			RequestID:           uint32(i),
+			NodeID:              nodeID,
+			RequestedHeight:     uint64(i / 2),
+			PreferredID:         ids.GenerateTestID(),
+			PreferredIDAtHeight: ids.GenerateTestID(),
+			AcceptedID:          ids.GenerateTestID(),
+			AcceptedHeight:      uint64(i),
+		}
+	}
+	return chits
+}
+
+func record(t *testing.T, l Log, chits []Chit) {
+	log, ok := l.(*log)
+	require.True(t, ok)
+	for _, chit := range chits {
+		log.clock.Set(chit.Time)
+		log.Sent(chit)
+	}
+}
+
+func TestChits(t *testing.T) {
+	chits := newTestChits(10)
+	height := uint64(2)
+
+	tests := []struct {
+		name     string
+		filter   Filter
+		expected []Chit
+	}{
+		{
+			name:     "all",
+			expected: chits[4:],
+		},
+		{
+			name: "limit",
+			filter: Filter{
+				Limit: 2,
+			},
+			expected: chits[8:],
+		},
+		{
+			name: "node ID",
+			filter: Filter{
+				NodeID: &nodeID1,
+			},
+			expected: []Chit{chits[5], chits[7], chits[9]},
+		},
+		{
+			name: "node ID with limit",
+			filter: Filter{
+				NodeID: &nodeID0,
+				Limit:  2,
+			},
+			expected: []Chit{chits[6], chits[8]},
+		},
+		{
+			name: "requested height",
+			filter: Filter{
+				RequestedHeight: &height,
+			},
+			expected: []Chit{chits[4], chits[5]},
+		},
+		{
+			name: "requested height and node ID",
+			filter: Filter{
+				NodeID:          &nodeID1,
+				RequestedHeight: &height,
+			},
+			expected: []Chit{chits[5]},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			l, err := New(logging.NoLog{}, memdb.New(), 6)
+			require.NoError(err)
+			record(t, l, chits)
+
+			actual, err := l.Chits(test.filter)
+			require.NoError(err)
+			require.Equal(test.expected, actual)
+			require.NoError(l.Close())
+		})
+	}
+}
+
+func TestPersistence(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		db    = memdb.New()
+		chits = newTestChits(10)
+	)
+	l, err := New(logging.NoLog{}, db, 8)
+	require.NoError(err)
+	record(t, l, chits[:5])
+	require.NoError(l.Close())
+
+	// Chits are restored after a restart.
+	l, err = New(logging.NoLog{}, db, 8)
+	require.NoError(err)
+	record(t, l, chits[5:])
+	require.NoError(l.Close())
+
+	l, err = New(logging.NoLog{}, db, 8)
+	require.NoError(err)
+
+	actual, err := l.Chits(Filter{})
+	require.NoError(err)
+	require.Equal(chits[2:], actual)
+	require.NoError(l.Close())
+
+	// Reducing the maximum number of entries deletes the oldest chits and
+	// their indices.
+	l, err = New(logging.NoLog{}, db, 3)
+	require.NoError(err)
+
+	actual, err = l.Chits(Filter{})
+	require.NoError(err)
+	require.Equal(chits[7:], actual)
+	require.NoError(l.Close())
+
+	require.Equal(3, countKeys(t, db, entryPrefix))
+	require.Equal(3, countKeys(t, db, heightPrefix))
+	require.Equal(3, countKeys(t, db, nodePrefix))
+}
+
+func TestBufferedWrites(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		db    = memdb.New()
+		chits = newTestChits(maxPendingChits)
+	)
+	l, err := New(logging.NoLog{}, db, maxPendingChits)
+	require.NoError(err)
+
+	// Chits aren't written to the database when they are sent.
+	record(t, l, chits[:maxPendingChits-1])
+	require.Zero(countKeys(t, db, entryPrefix))
+
+	// Once enough chits are pending, they are written asynchronously.
+	record(t, l, chits[maxPendingChits-1:])
+	require.Eventually(func() bool {
+		return countKeys(t, db, entryPrefix) == maxPendingChits
+	}, time.Minute, time.Millisecond)
+
+	// Closing the log writes the remaining chits.
+	last := newTestChits(1)
+	record(t, l, last)
+	require.NoError(l.Close())
+	require.Equal(maxPendingChits, countKeys(t, db, entryPrefix))
+
+	l, err = New(logging.NoLog{}, db, maxPendingChits)
+	require.NoError(err)
+
+	actual, err := l.Chits(Filter{Limit: 1})
+	require.NoError(err)
+	require.Equal(last, actual)
+	require.NoError(l.Close())
+}
+
+func countKeys(t *testing.T, db database.Iteratee, prefix []byte) int {
+	iterator := db.NewIteratorWithPrefix(prefix)
+	defer iterator.Release()
+
+	var numKeys int
+	for iterator.Next() {
+		numKeys++
+	}
+	require.NoError(t, iterator.Error())
+	return numKeys
+}
+
+func TestManagerDisabled(t *testing.T) {
+	require := require.New(t)
+
+	m := NewManager(Config{}, logging.NoLog{}, memdb.New())
+	l, err := m.New(ids.GenerateTestID())
+	require.NoError(err)
+	require.Equal(Noop{}, l)
+
+	_, err = m.Chits(ids.GenerateTestID(), Filter{})
+	require.ErrorIs(err, ErrDisabled)
+}
+
+func TestManager(t *testing.T) {
+	require := require.New(t)
+
+	m := NewManager(
+		Config{
+			Enabled:    true,
+			MaxEntries: 10,
+		},
+		logging.NoLog{},
+		memdb.New(),
+	)
+	chainID := ids.GenerateTestID()
+	l, err := m.New(chainID)
+	require.NoError(err)
+
+	chits := newTestChits(3)
+	record(t, l, chits)
+
+	actual, err := m.Chits(chainID, Filter{})
+	require.NoError(err)
+	require.Equal(chits, actual)
+
+	_, err = m.Chits(ids.GenerateTestID(), Filter{})
+	require.ErrorIs(err, ErrUnknownChain)
+
+	require.NoError(m.Close())
+	_, err = m.Chits(chainID, Filter{})
+	require.ErrorIs(err, ErrUnknownChain)
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chitlog
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	ErrDisabled     = errors.New("chit logging is disabled")
+	ErrUnknownChain = errors.New("unknown chain")
+)
+
+type Config struct {
+	// Enabled specifies whether sent chits should be recorded.
+	Enabled bool `json:"enabled"`
+	// MaxEntries is the maximum number of chits kept per chain. Once
+	// exceeded, the oldest chits are deleted.
+	MaxEntries uint64 `json:"maxEntries"`
+}
+
+// Manager creates the chit logs of each chain and allows them to be queried.
+type Manager struct {
+	config Config
+	log    logging.Logger
+	db     database.Database
+
+	lock sync.RWMutex
+	logs map[ids.ID]Log
+}
+
+// NewManager returns a manager that persists the chit logs of all chains to
+// [db].
+func NewManager(config Config, log logging.Logger, db database.Database) *Manager {
+	return &Manager{
+		config: config,
+		log:    log,
+		db:     db,
+		logs:   make(map[ids.ID]Log),
+	}
+}
+
+// New returns the chit log that should be used by [chainID]. If chit logging
+// is disabled, a no-op log is returned.
+func (m *Manager) New(chainID ids.ID) (Log, error) {
+	if !m.config.Enabled {
+		return Noop{}, nil
+	}
+
+	log, err := New(m.log, prefixdb.New(chainID[:], m.db), m.config.MaxEntries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize chit log of %s: %w", chainID, err)
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.logs[chainID] = log
+	return log, nil
+}
+
+// Chits returns the recorded chits of [chainID] that match [filter].
+func (m *Manager) Chits(chainID ids.ID, filter Filter) ([]Chit, error) {
+	if !m.config.Enabled {
+		return nil, ErrDisabled
+	}
+
+	m.lock.RLock()
+	log, ok := m.logs[chainID]
+	m.lock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownChain, chainID)
+	}
+	return log.Chits(filter)
+}
+
+// Close persists the chits recorded by all chains.
+func (m *Manager) Close() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	errs := wrappers.Errs{}
+	for _, log := range m.logs {
+		errs.Add(log.Close())
+	}
+	m.logs = make(map[ids.ID]Log)
+	return errs.Err
+}
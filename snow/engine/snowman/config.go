@@ -10,6 +10,8 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/common/tracker"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/chitlog"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/decisiontrace"
 	"github.com/ava-labs/avalanchego/snow/validators"
 )
 
@@ -25,4 +27,9 @@ type Config struct {
 	Params              snowball.Parameters
 	Consensus           snowman.Consensus
 	PartialSync         bool
+	// DecisionTracer, if non-nil, records every poll and decision. It should
+	// also be registered as the Listener of [Consensus].
+	DecisionTracer decisiontrace.Tracer
+	// ChitLog, if non-nil, records every chit sent by the engine.
+	ChitLog chitlog.Log
 }
@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package decisiontrace
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sync"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	ErrDisabled     = errors.New("decision tracing is disabled")
+	ErrUnknownChain = errors.New("unknown chain")
+)
+
+type Config struct {
+	// Enabled specifies whether decisions should be traced.
+	Enabled bool `json:"enabled"`
+	// Directory the rotating trace files are written to. Each chain is traced
+	// into its own file.
+	Directory string `json:"directory"`
+	// MaxSize is the maximum size, in megabytes, of a trace file before it is
+	// rotated.
+	MaxSize int `json:"maxSize"`
+	// MaxFiles is the maximum number of rotated trace files to keep per chain.
+	MaxFiles int `json:"maxFiles"`
+	// MaxBlocks is the number of recently referenced blocks, per chain, whose
+	// traces are kept in memory to be queried.
+	MaxBlocks int `json:"maxBlocks"`
+}
+
+// Manager creates the decision tracers of each chain and allows them to be
+// queried.
+type Manager struct {
+	config Config
+
+	lock    sync.RWMutex
+	tracers map[ids.ID]Tracer
+	writers []io.Closer
+}
+
+func NewManager(config Config) *Manager {
+	return &Manager{
+		config:  config,
+		tracers: make(map[ids.ID]Tracer),
+	}
+}
+
+// New returns the tracer that should be used by [chainID]. If tracing is
+// disabled, a no-op tracer is returned.
+func (m *Manager) New(chainID ids.ID) Tracer {
+	if !m.config.Enabled {
+		return Noop{}
+	}
+
+	writer := &lumberjack.Logger{
+		Filename:   filepath.Join(m.config.Directory, chainID.String()+".log"),
+		MaxSize:    m.config.MaxSize,  // megabytes
+		MaxBackups: m.config.MaxFiles, // files
+	}
+	tracer := New(chainID, writer, m.config.MaxBlocks)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.tracers[chainID] = tracer
+	m.writers = append(m.writers, writer)
+	return tracer
+}
+
+// Trace returns the recorded events of [chainID] that reference [blkID].
+func (m *Manager) Trace(chainID ids.ID, blkID ids.ID) ([]Event, error) {
+	if !m.config.Enabled {
+		return nil, ErrDisabled
+	}
+
+	m.lock.RLock()
+	tracer, ok := m.tracers[chainID]
+	m.lock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownChain, chainID)
+	}
+	return tracer.Trace(blkID), nil
+}
+
+// Close closes all of the trace files.
+func (m *Manager) Close() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	errs := wrappers.Errs{}
+	for _, writer := range m.writers {
+		errs.Add(writer.Close())
+	}
+	m.writers = nil
+	return errs.Err
+}
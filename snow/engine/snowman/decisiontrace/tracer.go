@@ -0,0 +1,294 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package decisiontrace
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/cache"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/utils/bag"
+	"github.com/ava-labs/avalanchego/utils/linked"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+const (
+	PollStarted       EventType = "pollStarted"
+	ChitReceived      EventType = "chitReceived"
+	ChitDropped       EventType = "chitDropped"
+	PollFinished      EventType = "pollFinished"
+	PreferenceChanged EventType = "preferenceChanged"
+	BlockAccepted     EventType = "blockAccepted"
+	BlockRejected     EventType = "blockRejected"
+)
+
+var (
+	_ Tracer = (*tracer)(nil)
+	_ Tracer = Noop{}
+)
+
+type EventType string
+
+// Event is a single step of the Snowman engine's decision process.
+type Event struct {
+	Time    time.Time `json:"time"`
+	ChainID ids.ID    `json:"chainID"`
+	Type    EventType `json:"type"`
+	// RequestID is the ID of the poll the event relates to, if any.
+	RequestID uint32 `json:"requestID,omitempty"`
+	// BlockID is the block that was queried for poll events, and the block
+	// that was decided or preferred otherwise.
+	BlockID ids.ID `json:"blockID"`
+	Height  uint64 `json:"height,omitempty"`
+	// Validators that were sampled by a poll.
+	Validators []ids.NodeID `json:"validators,omitempty"`
+	// NodeID is the validator that responded, or failed to respond, to a
+	// poll.
+	NodeID *ids.NodeID `json:"nodeID,omitempty"`
+	// Vote is the block that the chit was applied to.
+	Vote *ids.ID `json:"vote,omitempty"`
+	// Votes is the number of votes each block received in a finished poll.
+	Votes map[ids.ID]int `json:"votes,omitempty"`
+	// Responses is the number of validators that responded to a finished
+	// poll.
+	Responses int `json:"responses,omitempty"`
+	// EarlyTerminated is true if a poll finished before all of the sampled
+	// validators responded.
+	EarlyTerminated bool `json:"earlyTerminated,omitempty"`
+}
+
+// Tracer records the decisions made by the Snowman engine. Tracers must be
+// safe to query concurrently with the engine recording events.
+type Tracer interface {
+	snowman.Listener
+
+	// PollStarted is called when a poll for [blkID] is sent to [vdrs].
+	PollStarted(requestID uint32, blkID ids.ID, vdrs []ids.NodeID)
+	// ChitReceived is called when [nodeID]'s chit is applied to the poll.
+	ChitReceived(requestID uint32, nodeID ids.NodeID, vote ids.ID)
+	// ChitDropped is called when [nodeID] failed to provide a usable chit.
+	ChitDropped(requestID uint32, nodeID ids.NodeID)
+	// PollsFinished is called with the results of the polls that finished,
+	// ordered from oldest to newest.
+	PollsFinished(results []bag.Bag[ids.ID])
+
+	// Trace returns the recorded events that reference [blkID], ordered from
+	// oldest to newest.
+	Trace(blkID ids.ID) []Event
+}
+
+// Noop is a Tracer that doesn't record anything.
+type Noop struct{}
+
+func (Noop) PreferenceChanged(ids.ID, uint64) {}
+
+func (Noop) Accepted(ids.ID, uint64) {}
+
+func (Noop) Rejected(ids.ID, uint64) {}
+
+func (Noop) PollStarted(uint32, ids.ID, []ids.NodeID) {}
+
+func (Noop) ChitReceived(uint32, ids.NodeID, ids.ID) {}
+
+func (Noop) ChitDropped(uint32, ids.NodeID) {}
+
+func (Noop) PollsFinished([]bag.Bag[ids.ID]) {}
+
+func (Noop) Trace(ids.ID) []Event {
+	return nil
+}
+
+type poll struct {
+	blkID         ids.ID
+	numValidators int
+	numResponses  int
+}
+
+type tracer struct {
+	chainID ids.ID
+	clock   mockable.Clock
+
+	lock sync.Mutex
+	// writer is where every event is written to as a JSON line.
+	writer io.Writer
+	// polls that haven't finished yet, ordered from oldest to newest.
+	polls *linked.Hashmap[uint32, *poll]
+	// events that reference each recently traced block.
+	events cache.LRU[ids.ID, []Event]
+}
+
+// New returns a tracer for [chainID] that writes events to [writer] and keeps
+// the events of the last [numBlocks] referenced blocks in memory.
+func New(chainID ids.ID, writer io.Writer, numBlocks int) Tracer {
+	return &tracer{
+		chainID: chainID,
+		writer:  writer,
+		polls:   linked.NewHashmap[uint32, *poll](),
+		events:  cache.LRU[ids.ID, []Event]{Size: numBlocks},
+	}
+}
+
+func (t *tracer) PreferenceChanged(blkID ids.ID, height uint64) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.record(Event{
+		Type:    PreferenceChanged,
+		BlockID: blkID,
+		Height:  height,
+	})
+}
+
+func (t *tracer) Accepted(blkID ids.ID, height uint64) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.record(Event{
+		Type:    BlockAccepted,
+		BlockID: blkID,
+		Height:  height,
+	})
+}
+
+func (t *tracer) Rejected(blkID ids.ID, height uint64) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.record(Event{
+		Type:    BlockRejected,
+		BlockID: blkID,
+		Height:  height,
+	})
+}
+
+func (t *tracer) PollStarted(requestID uint32, blkID ids.ID, vdrs []ids.NodeID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.polls.Put(requestID, &poll{
+		blkID:         blkID,
+		numValidators: len(vdrs),
+	})
+	t.record(Event{
+		Type:       PollStarted,
+		RequestID:  requestID,
+		BlockID:    blkID,
+		Validators: vdrs,
+	})
+}
+
+func (t *tracer) ChitReceived(requestID uint32, nodeID ids.NodeID, vote ids.ID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	p, ok := t.polls.Get(requestID)
+	if !ok {
+		return
+	}
+
+	p.numResponses++
+	t.record(
+		Event{
+			Type:      ChitReceived,
+			RequestID: requestID,
+			BlockID:   p.blkID,
+			NodeID:    &nodeID,
+			Vote:      &vote,
+		},
+		vote,
+	)
+}
+
+func (t *tracer) ChitDropped(requestID uint32, nodeID ids.NodeID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	p, ok := t.polls.Get(requestID)
+	if !ok {
+		return
+	}
+
+	p.numResponses++
+	t.record(Event{
+		Type:      ChitDropped,
+		RequestID: requestID,
+		BlockID:   p.blkID,
+		NodeID:    &nodeID,
+	})
+}
+
+func (t *tracer) PollsFinished(results []bag.Bag[ids.ID]) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	// Polls are always finished in the order they were started, so the oldest
+	// outstanding polls are the ones that produced [results].
+	for _, result := range results {
+		requestID, p, ok := t.polls.Oldest()
+		if !ok {
+			return
+		}
+		t.polls.Delete(requestID)
+
+		votes := make(map[ids.ID]int)
+		for _, blkID := range result.List() {
+			votes[blkID] = result.Count(blkID)
+		}
+		t.record(
+			Event{
+				Type:            PollFinished,
+				RequestID:       requestID,
+				BlockID:         p.blkID,
+				Votes:           votes,
+				Responses:       p.numResponses,
+				EarlyTerminated: p.numResponses < p.numValidators,
+			},
+			result.List()...,
+		)
+	}
+}
+
+func (t *tracer) Trace(blkID ids.ID) []Event {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	events, _ := t.events.Get(blkID)
+	// Copy the events so that they can't be modified by future calls to
+	// record.
+	return append([]Event(nil), events...)
+}
+
+// record writes [event] and indexes it by its block ID along with any
+// additional [blkIDs].
+//
+// Assumes [t.lock] is held.
+func (t *tracer) record(event Event, blkIDs ...ids.ID) {
+	event.Time = t.clock.Time()
+	event.ChainID = t.chainID
+
+	t.index(event.BlockID, event)
+	for _, blkID := range blkIDs {
+		if blkID != event.BlockID {
+			t.index(blkID, event)
+		}
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	// Failing to write the trace should never impact consensus, so write
+	// errors are ignored.
+	_, _ = t.writer.Write(append(eventBytes, '\n'))
+}
+
+// Assumes [t.lock] is held.
+func (t *tracer) index(blkID ids.ID, event Event) {
+	events, _ := t.events.Get(blkID)
+	t.events.Put(blkID, append(events, event))
+}
@@ -0,0 +1,166 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package decisiontrace
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/bag"
+)
+
+func TestTracerPoll(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		chainID = ids.GenerateTestID()
+		blkID   = ids.GenerateTestID()
+		vote    = ids.GenerateTestID()
+		vdr0    = ids.GenerateTestNodeID()
+		vdr1    = ids.GenerateTestNodeID()
+		vdr2    = ids.GenerateTestNodeID()
+		vdrs    = []ids.NodeID{vdr0, vdr1, vdr2}
+		writer  = &bytes.Buffer{}
+		tracer  = New(chainID, writer, 10)
+	)
+
+	tracer.PollStarted(1, blkID, vdrs)
+	tracer.ChitReceived(1, vdr0, blkID)
+	tracer.ChitReceived(1, vdr1, vote)
+	tracer.PollsFinished([]bag.Bag[ids.ID]{
+		bag.Of(blkID, vote),
+	})
+	// Chits for finished polls should be ignored.
+	tracer.ChitDropped(1, vdr2)
+	tracer.PreferenceChanged(blkID, 1)
+	tracer.Accepted(blkID, 1)
+
+	expectedTypes := []EventType{
+		PollStarted,
+		ChitReceived,
+		ChitReceived,
+		PollFinished,
+		PreferenceChanged,
+		BlockAccepted,
+	}
+	events := tracer.Trace(blkID)
+	require.Len(events, len(expectedTypes))
+	for i, event := range events {
+		require.Equal(expectedTypes[i], event.Type)
+		require.Equal(chainID, event.ChainID)
+		require.Equal(blkID, event.BlockID)
+	}
+	require.Equal(vdrs, events[0].Validators)
+	require.Equal(vdr1, *events[2].NodeID)
+	require.Equal(vote, *events[2].Vote)
+
+	finished := events[3]
+	require.Equal(uint32(1), finished.RequestID)
+	require.Equal(map[ids.ID]int{blkID: 1, vote: 1}, finished.Votes)
+	require.Equal(2, finished.Responses)
+	require.True(finished.EarlyTerminated)
+
+	// Events are also indexed by the blocks that were voted for.
+	voteEvents := tracer.Trace(vote)
+	require.Len(voteEvents, 2)
+	require.Equal(ChitReceived, voteEvents[0].Type)
+	require.Equal(PollFinished, voteEvents[1].Type)
+
+	// Every event should have been written as a JSON line.
+	scanner := bufio.NewScanner(writer)
+	var written []Event
+	for scanner.Scan() {
+		var event Event
+		require.NoError(json.Unmarshal(scanner.Bytes(), &event))
+		written = append(written, event)
+	}
+	require.NoError(scanner.Err())
+	require.Len(written, len(expectedTypes))
+	for i, event := range written {
+		require.Equal(expectedTypes[i], event.Type)
+	}
+}
+
+func TestTracerPollsFinishedInOrder(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		blkID0 = ids.GenerateTestID()
+		blkID1 = ids.GenerateTestID()
+		vdr    = ids.GenerateTestNodeID()
+		tracer = New(ids.GenerateTestID(), &bytes.Buffer{}, 10)
+	)
+
+	tracer.PollStarted(1, blkID0, []ids.NodeID{vdr})
+	tracer.PollStarted(2, blkID1, []ids.NodeID{vdr})
+	tracer.ChitDropped(2, vdr)
+	tracer.ChitReceived(1, vdr, blkID0)
+	tracer.PollsFinished([]bag.Bag[ids.ID]{
+		bag.Of(blkID0),
+		{},
+	})
+
+	events0 := tracer.Trace(blkID0)
+	require.Len(events0, 3)
+	require.Equal(PollFinished, events0[2].Type)
+	require.Equal(uint32(1), events0[2].RequestID)
+	require.False(events0[2].EarlyTerminated)
+
+	events1 := tracer.Trace(blkID1)
+	require.Len(events1, 3)
+	require.Equal(PollFinished, events1[2].Type)
+	require.Equal(uint32(2), events1[2].RequestID)
+	require.Empty(events1[2].Votes)
+}
+
+func TestTracerEvictsOldBlocks(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		blkID0 = ids.GenerateTestID()
+		blkID1 = ids.GenerateTestID()
+		tracer = New(ids.GenerateTestID(), &bytes.Buffer{}, 1)
+	)
+
+	tracer.Accepted(blkID0, 1)
+	tracer.Accepted(blkID1, 2)
+
+	require.Empty(tracer.Trace(blkID0))
+	require.Len(tracer.Trace(blkID1), 1)
+}
+
+func TestManager(t *testing.T) {
+	require := require.New(t)
+
+	chainID := ids.GenerateTestID()
+	disabled := NewManager(Config{})
+	require.IsType(Noop{}, disabled.New(chainID))
+	_, err := disabled.Trace(chainID, ids.GenerateTestID())
+	require.ErrorIs(err, ErrDisabled)
+
+	enabled := NewManager(Config{
+		Enabled:   true,
+		Directory: t.TempDir(),
+		MaxSize:   1,
+		MaxBlocks: 10,
+	})
+	tracer := enabled.New(chainID)
+
+	blkID := ids.GenerateTestID()
+	tracer.Accepted(blkID, 1)
+
+	events, err := enabled.Trace(chainID, blkID)
+	require.NoError(err)
+	require.Len(events, 1)
+
+	_, err = enabled.Trace(ids.GenerateTestID(), blkID)
+	require.ErrorIs(err, ErrUnknownChain)
+
+	require.NoError(enabled.Close())
+}
@@ -20,6 +20,8 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/common/tracker"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/ancestor"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/chitlog"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/decisiontrace"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/job"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/bag"
@@ -95,6 +97,13 @@ type Engine struct {
 func New(config Config) (*Engine, error) {
 	config.Ctx.Log.Info("initializing consensus engine")
 
+	if config.DecisionTracer == nil {
+		config.DecisionTracer = decisiontrace.Noop{}
+	}
+	if config.ChitLog == nil {
+		config.ChitLog = chitlog.Noop{}
+	}
+
 	nonVerifiedCache, err := metercacher.New[ids.ID, snowman.Block](
 		"non_verified_cache",
 		config.Ctx.Registerer,
@@ -595,7 +604,7 @@ func (e *Engine) sendChits(ctx context.Context, nodeID ids.NodeID, requestID uin
 			)
 			acceptedAtHeight = lastAcceptedID
 		}
-		e.Sender.SendChits(ctx, nodeID, requestID, lastAcceptedID, acceptedAtHeight, lastAcceptedID, lastAcceptedHeight)
+		e.sendChit(ctx, nodeID, requestID, requestedHeight, lastAcceptedID, acceptedAtHeight, lastAcceptedID, lastAcceptedHeight)
 		return
 	}
 
@@ -640,7 +649,30 @@ func (e *Engine) sendChits(ctx context.Context, nodeID ids.NodeID, requestID uin
 			preferenceAtHeight = preference
 		}
 	}
-	e.Sender.SendChits(ctx, nodeID, requestID, preference, preferenceAtHeight, lastAcceptedID, lastAcceptedHeight)
+	e.sendChit(ctx, nodeID, requestID, requestedHeight, preference, preferenceAtHeight, lastAcceptedID, lastAcceptedHeight)
+}
+
+// sendChit sends the chit to [nodeID] and records it in the chit log.
+func (e *Engine) sendChit(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	requestID uint32,
+	requestedHeight uint64,
+	preferredID ids.ID,
+	preferredIDAtHeight ids.ID,
+	acceptedID ids.ID,
+	acceptedHeight uint64,
+) {
+	e.Sender.SendChits(ctx, nodeID, requestID, preferredID, preferredIDAtHeight, acceptedID, acceptedHeight)
+	e.ChitLog.Sent(chitlog.Chit{
+		RequestID:           requestID,
+		NodeID:              nodeID,
+		RequestedHeight:     requestedHeight,
+		PreferredID:         preferredID,
+		PreferredIDAtHeight: preferredIDAtHeight,
+		AcceptedID:          acceptedID,
+		AcceptedHeight:      acceptedHeight,
+	})
 }
 
 // Build blocks if they have been requested and the number of processing blocks
@@ -910,6 +942,7 @@ func (e *Engine) sendQuery(
 		)
 		return
 	}
+	e.DecisionTracer.PollStarted(e.requestID, blkID, vdrIDs)
 
 	vdrSet := set.Of(vdrIDs...)
 	if push {
@@ -17,6 +17,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
@@ -27,6 +28,8 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/enginetest"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/ancestor"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blocktest"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/chitlog"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/decisiontrace"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/getter"
 	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/snow/validators"
@@ -630,6 +633,72 @@ func TestEngineRepoll(t *testing.T) {
 	require.True(*queried)
 }
 
+func TestEngineDecisionTrace(t *testing.T) {
+	require := require.New(t)
+
+	engCfg := DefaultConfig(t)
+	tracer := decisiontrace.New(engCfg.Ctx.ChainID, &bytes.Buffer{}, 10)
+	engCfg.DecisionTracer = tracer
+	vdr, _, sender, vm, te := setup(t, engCfg)
+
+	sender.Default(true)
+	vm.CantSetPreference = false
+
+	var requestID uint32
+	sender.SendPullQueryF = func(_ context.Context, _ set.Set[ids.NodeID], reqID uint32, _ ids.ID, _ uint64) {
+		requestID = reqID
+	}
+	te.repoll(context.Background())
+
+	require.NoError(te.QueryFailed(context.Background(), vdr, requestID))
+
+	events := tracer.Trace(snowmantest.GenesisID)
+	require.Len(events, 3)
+	require.Equal(decisiontrace.PollStarted, events[0].Type)
+	require.Equal([]ids.NodeID{vdr}, events[0].Validators)
+	require.Equal(decisiontrace.ChitDropped, events[1].Type)
+	require.Equal(vdr, *events[1].NodeID)
+	require.Equal(decisiontrace.PollFinished, events[2].Type)
+	require.Equal(requestID, events[2].RequestID)
+	require.Equal(1, events[2].Responses)
+	require.False(events[2].EarlyTerminated)
+}
+
+func TestEngineChitLog(t *testing.T) {
+	require := require.New(t)
+
+	engCfg := DefaultConfig(t)
+	chitLog, err := chitlog.New(logging.NoLog{}, memdb.New(), 10)
+	require.NoError(err)
+	defer func() {
+		require.NoError(chitLog.Close())
+	}()
+	engCfg.ChitLog = chitLog
+	vdr, _, sender, vm, te := setup(t, engCfg)
+
+	sender.Default(true)
+	sender.CantSendChits = false
+	vm.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		require.Equal(snowmantest.GenesisID, blkID)
+		return snowmantest.Genesis, nil
+	}
+
+	require.NoError(te.PullQuery(context.Background(), vdr, 7, snowmantest.GenesisID, 0))
+
+	chits, err := chitLog.Chits(chitlog.Filter{})
+	require.NoError(err)
+	require.Len(chits, 1)
+
+	chit := chits[0]
+	require.Equal(uint32(7), chit.RequestID)
+	require.Equal(vdr, chit.NodeID)
+	require.Zero(chit.RequestedHeight)
+	require.Equal(snowmantest.GenesisID, chit.PreferredID)
+	require.Equal(snowmantest.GenesisID, chit.PreferredIDAtHeight)
+	require.Equal(snowmantest.GenesisID, chit.AcceptedID)
+	require.Zero(chit.AcceptedHeight)
+}
+
 func TestVoteCanceling(t *testing.T) {
 	require := require.New(t)
 
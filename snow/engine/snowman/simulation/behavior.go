@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import "github.com/ava-labs/avalanchego/ids"
+
+var (
+	// Mute nodes never respond to queries.
+	Mute Behavior = mute{}
+	// Contrarian nodes vote for the most recently proposed block that
+	// conflicts with their preference, if one exists.
+	Contrarian Behavior = contrarian{}
+)
+
+// Chits are the votes sent in response to a query.
+type Chits struct {
+	PreferredID         ids.ID
+	PreferredIDAtHeight ids.ID
+	AcceptedID          ids.ID
+	AcceptedHeight      uint64
+}
+
+// Behavior defines how a byzantine node deviates from the protocol.
+type Behavior interface {
+	// Chits returns the chits to send in place of the [honest] chits. If false
+	// is returned, the query is left unanswered.
+	Chits(blocks *Blocks, honest Chits) (Chits, bool)
+}
+
+type mute struct{}
+
+func (mute) Chits(*Blocks, Chits) (Chits, bool) {
+	return Chits{}, false
+}
+
+type contrarian struct{}
+
+func (contrarian) Chits(blocks *Blocks, honest Chits) (Chits, bool) {
+	height, ok := blocks.Height(honest.PreferredID)
+	if !ok {
+		return honest, true
+	}
+
+	conflicts := blocks.AtHeight(height)
+	for i := len(conflicts) - 1; i >= 0; i-- {
+		if conflict := conflicts[i]; conflict != honest.PreferredID {
+			honest.PreferredID = conflict
+			honest.PreferredIDAtHeight = conflict
+			return honest, true
+		}
+	}
+	return honest, true
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+)
+
+var errUnknownBlock = errors.New("unknown block")
+
+type blockInfo struct {
+	id         ids.ID
+	parentID   ids.ID
+	height     uint64
+	timestamp  time.Time
+	proposer   ids.NodeID
+	proposedAt time.Duration
+}
+
+// Blocks is the registry of every block that was proposed during a
+// simulation. Each node is given its own copy of a block so that nodes can
+// decide blocks independently.
+type Blocks struct {
+	blocks   map[ids.ID]*blockInfo
+	byHeight map[uint64][]ids.ID
+}
+
+func newBlocks() *Blocks {
+	return &Blocks{
+		blocks: map[ids.ID]*blockInfo{
+			snowmantest.GenesisID: {
+				id:        snowmantest.GenesisID,
+				height:    snowmantest.GenesisHeight,
+				timestamp: snowmantest.GenesisTimestamp,
+			},
+		},
+		byHeight: map[uint64][]ids.ID{
+			snowmantest.GenesisHeight: {snowmantest.GenesisID},
+		},
+	}
+}
+
+// Len returns the number of proposed blocks, excluding genesis.
+func (b *Blocks) Len() int {
+	return len(b.blocks) - 1
+}
+
+// AtHeight returns the IDs of the blocks proposed at [height], in the order
+// they were proposed.
+func (b *Blocks) AtHeight(height uint64) []ids.ID {
+	return b.byHeight[height]
+}
+
+// Height returns the height of [blkID].
+func (b *Blocks) Height(blkID ids.ID) (uint64, bool) {
+	info, ok := b.blocks[blkID]
+	if !ok {
+		return 0, false
+	}
+	return info.height, true
+}
+
+func (b *Blocks) propose(
+	parentID ids.ID,
+	proposer ids.NodeID,
+	now time.Duration,
+) (*blockInfo, error) {
+	parent, ok := b.blocks[parentID]
+	if !ok {
+		return nil, errUnknownBlock
+	}
+
+	info := &blockInfo{
+		// Blocks are assigned deterministic IDs so that simulations with the
+		// same seed produce the same blocks. They are derived from the genesis ID
+		// to avoid colliding with IDs generated by tests.
+		id:         snowmantest.GenesisID.Prefix(uint64(len(b.blocks))),
+		parentID:   parentID,
+		height:     parent.height + 1,
+		timestamp:  snowmantest.GenesisTimestamp.Add(now),
+		proposer:   proposer,
+		proposedAt: now,
+	}
+	b.blocks[info.id] = info
+	b.byHeight[info.height] = append(b.byHeight[info.height], info.id)
+	return info, nil
+}
+
+// parse returns a new copy of the block represented by [blkBytes].
+func (b *Blocks) parse(blkBytes []byte) (*snowmantest.Block, error) {
+	blkID, err := ids.ToID(blkBytes)
+	if err != nil {
+		return nil, err
+	}
+	return b.get(blkID)
+}
+
+// get returns a new copy of [blkID].
+func (b *Blocks) get(blkID ids.ID) (*snowmantest.Block, error) {
+	info, ok := b.blocks[blkID]
+	if !ok {
+		return nil, errUnknownBlock
+	}
+
+	status := snowtest.Undecided
+	if blkID == snowmantest.GenesisID {
+		status = snowtest.Accepted
+	}
+	return &snowmantest.Block{
+		Decidable: snowtest.Decidable{
+			IDV:    info.id,
+			Status: status,
+		},
+		ParentV:    info.parentID,
+		HeightV:    info.height,
+		TimestampV: info.timestamp,
+		BytesV:     info.id[:],
+	}, nil
+}
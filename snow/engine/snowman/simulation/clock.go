@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/heap"
+)
+
+type event struct {
+	time time.Duration
+	// seq breaks ties between events scheduled at the same time so that
+	// events are executed in the order they were scheduled.
+	seq uint64
+	f   func() error
+}
+
+func (e *event) less(o *event) bool {
+	if e.time != o.time {
+		return e.time < o.time
+	}
+	return e.seq < o.seq
+}
+
+// clock is a virtual clock that executes scheduled events in order. Time only
+// advances when an event is executed, so simulations run as fast as the
+// events can be processed.
+type clock struct {
+	now    time.Duration
+	seq    uint64
+	events heap.Queue[*event]
+}
+
+func newClock() *clock {
+	return &clock{
+		events: heap.NewQueue[*event]((*event).less),
+	}
+}
+
+// schedule [f] to be executed [delay] after the current time.
+func (c *clock) schedule(delay time.Duration, f func() error) {
+	c.scheduleAt(c.now+delay, f)
+}
+
+// scheduleAt schedules [f] to be executed at [t]. If [t] is in the past, [f]
+// is executed after all the events that are scheduled at the current time.
+func (c *clock) scheduleAt(t time.Duration, f func() error) {
+	c.seq++
+	c.events.Push(&event{
+		time: max(t, c.now),
+		seq:  c.seq,
+		f:    f,
+	})
+}
+
+// run executes events until there are no more events scheduled before [end].
+func (c *clock) run(end time.Duration) error {
+	for {
+		next, ok := c.events.Peek()
+		if !ok || next.time > end {
+			c.now = end
+			return nil
+		}
+
+		_, _ = c.events.Pop()
+		c.now = next.time
+		if err := next.f(); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/common/tracker"
+	"github.com/ava-labs/avalanchego/snow/engine/enginetest"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blocktest"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/getter"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/version"
+
+	smeng "github.com/ava-labs/avalanchego/snow/engine/snowman"
+)
+
+const (
+	maxTimeGetAncestors       = time.Second
+	maxContainersGetAncestors = 2000
+)
+
+var _ snowman.Listener = (*node)(nil)
+
+// node is a single participant of the simulation running a real Snowman
+// engine on top of an in-memory VM.
+type node struct {
+	sim      *Simulation
+	id       ids.NodeID
+	behavior Behavior // nil if the node is honest
+
+	engine *smeng.Engine
+	vm     *blocktest.VM
+
+	blocks       map[ids.ID]*snowmantest.Block
+	preference   ids.ID
+	lastAccepted ids.ID
+	acceptedIDs  map[uint64]ids.ID // height -> blkID
+}
+
+func newNode(
+	tb testing.TB,
+	sim *Simulation,
+	nodeID ids.NodeID,
+	behavior Behavior,
+	vdrs validators.Manager,
+	params snowball.Parameters,
+) (*node, error) {
+	n := &node{
+		sim:          sim,
+		id:           nodeID,
+		behavior:     behavior,
+		blocks:       make(map[ids.ID]*snowmantest.Block),
+		preference:   snowmantest.GenesisID,
+		lastAccepted: snowmantest.GenesisID,
+		acceptedIDs: map[uint64]ids.ID{
+			snowmantest.GenesisHeight: snowmantest.GenesisID,
+		},
+	}
+	genesis, err := sim.blocks.get(snowmantest.GenesisID)
+	if err != nil {
+		return nil, err
+	}
+	n.blocks[snowmantest.GenesisID] = genesis
+
+	snowCtx := snowtest.Context(tb, snowtest.CChainID)
+	snowCtx.NodeID = nodeID
+	ctx := snowtest.ConsensusContext(snowCtx)
+
+	connectedValidators := tracker.NewPeers()
+	vdrs.RegisterSetCallbackListener(ctx.SubnetID, connectedValidators)
+	for _, vdrID := range vdrs.GetValidatorIDs(ctx.SubnetID) {
+		// Partitions are modeled by dropping messages, so every node is
+		// always considered connected.
+		if err := connectedValidators.Connected(context.Background(), vdrID, version.CurrentApp); err != nil {
+			return nil, err
+		}
+	}
+
+	// Unused messages and VM calls are ignored.
+	sender := &enginetest.Sender{}
+	sender.Default(false)
+	sender.SendPullQueryF = n.sendPullQuery
+	sender.SendPushQueryF = n.sendPushQuery
+	sender.SendChitsF = n.sendChits
+	sender.SendGetF = n.sendGet
+	sender.SendPutF = n.sendPut
+
+	n.vm = &blocktest.VM{}
+	n.vm.Default(false)
+	n.vm.LastAcceptedF = n.lastAcceptedF
+	n.vm.GetBlockF = n.getBlock
+	n.vm.ParseBlockF = n.parseBlock
+	n.vm.BuildBlockF = n.buildBlock
+	n.vm.SetPreferenceF = n.setPreference
+	n.vm.GetBlockIDAtHeightF = n.getBlockIDAtHeight
+
+	getHandler, err := getter.New(
+		n.vm,
+		sender,
+		ctx.Log,
+		maxTimeGetAncestors,
+		maxContainersGetAncestors,
+		ctx.Registerer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	n.engine, err = smeng.New(smeng.Config{
+		AllGetsServer:       getHandler,
+		Ctx:                 ctx,
+		VM:                  n.vm,
+		Sender:              sender,
+		Validators:          vdrs,
+		ConnectedValidators: connectedValidators,
+		Params:              params,
+		Consensus: &snowman.Topological{
+			Factory:  snowball.SnowflakeFactory,
+			Listener: n,
+		},
+	})
+	return n, err
+}
+
+func (n *node) honest() bool {
+	return n.behavior == nil
+}
+
+func (*node) PreferenceChanged(ids.ID, uint64) {}
+
+func (n *node) Accepted(blkID ids.ID, height uint64) {
+	n.lastAccepted = blkID
+	n.acceptedIDs[height] = blkID
+	n.sim.accepted(n, blkID, height)
+}
+
+func (*node) Rejected(ids.ID, uint64) {}
+
+func (n *node) lastAcceptedF(context.Context) (ids.ID, error) {
+	return n.lastAccepted, nil
+}
+
+func (n *node) getBlock(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+	if blk, ok := n.blocks[blkID]; ok {
+		return blk, nil
+	}
+	if n.honest() {
+		return nil, database.ErrNotFound
+	}
+
+	// Byzantine nodes are assumed to know about every block, so that they can
+	// serve the conflicting blocks they vote for.
+	blk, err := n.sim.blocks.get(blkID)
+	if err != nil {
+		return nil, database.ErrNotFound
+	}
+	n.blocks[blkID] = blk
+	return blk, nil
+}
+
+func (n *node) parseBlock(_ context.Context, blkBytes []byte) (snowman.Block, error) {
+	blk, err := n.sim.blocks.parse(blkBytes)
+	if err != nil {
+		return nil, err
+	}
+	// Return the existing copy, if there is one, so that the status of the
+	// block is tracked consistently.
+	if existing, ok := n.blocks[blk.ID()]; ok {
+		return existing, nil
+	}
+	n.blocks[blk.ID()] = blk
+	return blk, nil
+}
+
+func (n *node) buildBlock(context.Context) (snowman.Block, error) {
+	info, err := n.sim.blocks.propose(n.preference, n.id, n.sim.clock.now)
+	if err != nil {
+		return nil, err
+	}
+	n.sim.results.NumProposed++
+
+	blk, err := n.sim.blocks.get(info.id)
+	if err != nil {
+		return nil, err
+	}
+	n.blocks[info.id] = blk
+	return blk, nil
+}
+
+func (n *node) setPreference(_ context.Context, blkID ids.ID) error {
+	n.preference = blkID
+	return nil
+}
+
+func (n *node) getBlockIDAtHeight(_ context.Context, height uint64) (ids.ID, error) {
+	blkID, ok := n.acceptedIDs[height]
+	if !ok {
+		return ids.Empty, database.ErrNotFound
+	}
+	return blkID, nil
+}
+
+func (n *node) sendPullQuery(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, blkID ids.ID, requestedHeight uint64) {
+	for nodeID := range nodeIDs {
+		n.sim.sendRequest(n.id, nodeID, requestID, queryRequest, func(to *node) error {
+			return to.engine.PullQuery(ctx, n.id, requestID, blkID, requestedHeight)
+		})
+	}
+}
+
+func (n *node) sendPushQuery(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, blkBytes []byte, requestedHeight uint64) {
+	for nodeID := range nodeIDs {
+		n.sim.sendRequest(n.id, nodeID, requestID, queryRequest, func(to *node) error {
+			return to.engine.PushQuery(ctx, n.id, requestID, blkBytes, requestedHeight)
+		})
+	}
+}
+
+func (n *node) sendChits(ctx context.Context, nodeID ids.NodeID, requestID uint32, preferredID ids.ID, preferredIDAtHeight ids.ID, acceptedID ids.ID, acceptedHeight uint64) {
+	chits := Chits{
+		PreferredID:         preferredID,
+		PreferredIDAtHeight: preferredIDAtHeight,
+		AcceptedID:          acceptedID,
+		AcceptedHeight:      acceptedHeight,
+	}
+	if !n.honest() {
+		var ok bool
+		chits, ok = n.behavior.Chits(n.sim.blocks, chits)
+		if !ok {
+			return
+		}
+	}
+
+	n.sim.sendResponse(n.id, nodeID, requestID, queryRequest, func(to *node) error {
+		return to.engine.Chits(
+			ctx,
+			n.id,
+			requestID,
+			chits.PreferredID,
+			chits.PreferredIDAtHeight,
+			chits.AcceptedID,
+			chits.AcceptedHeight,
+		)
+	})
+}
+
+func (n *node) sendGet(ctx context.Context, nodeID ids.NodeID, requestID uint32, blkID ids.ID) {
+	n.sim.sendRequest(n.id, nodeID, requestID, getRequest, func(to *node) error {
+		return to.engine.Get(ctx, n.id, requestID, blkID)
+	})
+}
+
+func (n *node) sendPut(ctx context.Context, nodeID ids.NodeID, requestID uint32, blkBytes []byte) {
+	n.sim.sendResponse(n.id, nodeID, requestID, getRequest, func(to *node) error {
+		return to.engine.Put(ctx, n.id, requestID, blkBytes)
+	})
+}
+
+// requestFailed notifies the engine that [nodeID] didn't respond to the
+// request in time.
+func (n *node) requestFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32, op requestOp) error {
+	if op == getRequest {
+		return n.engine.GetFailed(ctx, nodeID, requestID)
+	}
+	return n.engine.QueryFailed(ctx, nodeID, requestID)
+}
+
+// notify the engine that the VM is ready to build a block.
+func (n *node) notify(ctx context.Context) error {
+	return n.engine.Notify(ctx, common.PendingTxs)
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"slices"
+	"time"
+)
+
+// Results summarizes the outcome of a simulation. Only the behavior of honest
+// nodes is reported.
+type Results struct {
+	// Duration of virtual time that was simulated.
+	Duration time.Duration
+	// NumProposed is the number of blocks that were built.
+	NumProposed int
+	// NumFinalized is the number of blocks that were accepted by every honest
+	// node.
+	NumFinalized int
+	// TimeToFinality contains, for every block accepted by an honest node,
+	// the duration between the block being built and being accepted by that
+	// node. The durations are sorted in increasing order.
+	TimeToFinality []time.Duration
+	// SafetyViolations is the number of times an honest node accepted a
+	// block that conflicts with a block accepted by another honest node.
+	SafetyViolations int
+	// NumMessages is the number of messages that were sent.
+	NumMessages int
+	// NumDropped is the number of messages that were dropped due to network
+	// partitions.
+	NumDropped int
+	// NumTimeouts is the number of requests that weren't answered in time.
+	NumTimeouts int
+}
+
+// FinalityPercentile returns the time to finality of the [p]th percentile,
+// where 0 <= p <= 1, or 0 if no blocks were accepted.
+func (r *Results) FinalityPercentile(p float64) time.Duration {
+	if len(r.TimeToFinality) == 0 {
+		return 0
+	}
+	p = min(max(p, 0), 1)
+	index := int(p * float64(len(r.TimeToFinality)-1))
+	return r.TimeToFinality[index]
+}
+
+// MeanTimeToFinality returns the average time to finality, or 0 if no blocks
+// were accepted.
+func (r *Results) MeanTimeToFinality() time.Duration {
+	if len(r.TimeToFinality) == 0 {
+		return 0
+	}
+	var sum time.Duration
+	for _, d := range r.TimeToFinality {
+		sum += d
+	}
+	return sum / time.Duration(len(r.TimeToFinality))
+}
+
+func (r *Results) sort() {
+	slices.Sort(r.TimeToFinality)
+}
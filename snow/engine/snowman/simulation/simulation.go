@@ -0,0 +1,364 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package simulation runs networks of real Snowman engines that communicate
+// through an in-memory router driven by a virtual clock. It can be used to
+// measure the time to finality and safety of consensus parameters under
+// configurable latency, partitions and byzantine behavior.
+package simulation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+const (
+	DefaultLatency        = 50 * time.Millisecond
+	DefaultRequestTimeout = 2 * time.Second
+	DefaultBlockInterval  = time.Second
+)
+
+var (
+	errNoNodes             = errors.New("simulation requires at least one node")
+	errNoHonestNodes       = errors.New("simulation requires at least one honest node")
+	errInvalidByzantine    = errors.New("invalid byzantine node index")
+	errInvalidNumProposers = errors.New("invalid number of proposers")
+)
+
+// Latency returns the one-way delay of a message sent from [from] to [to].
+type Latency func(r *rand.Rand, from, to ids.NodeID) time.Duration
+
+// ConstantLatency delays every message by [d].
+func ConstantLatency(d time.Duration) Latency {
+	return func(*rand.Rand, ids.NodeID, ids.NodeID) time.Duration {
+		return d
+	}
+}
+
+// UniformLatency delays every message by a duration sampled uniformly from
+// [minLatency, maxLatency].
+func UniformLatency(minLatency, maxLatency time.Duration) Latency {
+	return func(r *rand.Rand, _, _ ids.NodeID) time.Duration {
+		return minLatency + time.Duration(r.Int63n(int64(maxLatency-minLatency)+1))
+	}
+}
+
+type Config struct {
+	// Params are the consensus parameters used by every node.
+	Params snowball.Parameters
+	// NumNodes is the number of equally weighted validators.
+	NumNodes int
+	// Byzantine maps the indices of byzantine nodes to their behavior. Nodes
+	// that aren't included are honest.
+	Byzantine map[int]Behavior
+	// Latency of messages. Defaults to [DefaultLatency].
+	Latency Latency
+	// RequestTimeout is the time after which unanswered requests fail.
+	// Defaults to [DefaultRequestTimeout].
+	RequestTimeout time.Duration
+	// BlockInterval is the interval at which honest nodes are asked to build
+	// blocks. Defaults to [DefaultBlockInterval].
+	BlockInterval time.Duration
+	// NumProposers is the number of distinct honest nodes that are asked to
+	// build a block every [BlockInterval]. Proposers building concurrently
+	// produce conflicting blocks. Defaults to 1.
+	NumProposers int
+	// Seed of the randomness used for latencies and proposer selection.
+	Seed int64
+}
+
+type requestOp byte
+
+const (
+	queryRequest requestOp = iota
+	getRequest
+)
+
+type request struct {
+	from      ids.NodeID
+	to        ids.NodeID
+	requestID uint32
+	op        requestOp
+}
+
+// Simulation is a network of Snowman engines.
+type Simulation struct {
+	config Config
+	rand   *rand.Rand
+	clock  *clock
+	blocks *Blocks
+
+	nodes     []*node
+	nodesByID map[ids.NodeID]*node
+	honest    []*node
+
+	// partition maps each node to its group. If nil, the network isn't
+	// partitioned.
+	partition map[ids.NodeID]int
+	// requests that haven't been answered or timed out.
+	requests set.Set[request]
+
+	// acceptedByHeight is the first block accepted by an honest node at each
+	// height.
+	acceptedByHeight map[uint64]ids.ID
+	// numAccepted is the number of honest nodes that accepted each block.
+	numAccepted map[ids.ID]int
+	results     Results
+}
+
+// New creates and starts the engines of a simulated network.
+func New(tb testing.TB, config Config) (*Simulation, error) {
+	if config.NumNodes <= 0 {
+		return nil, errNoNodes
+	}
+	if config.Latency == nil {
+		config.Latency = ConstantLatency(DefaultLatency)
+	}
+	if config.RequestTimeout <= 0 {
+		config.RequestTimeout = DefaultRequestTimeout
+	}
+	if config.BlockInterval <= 0 {
+		config.BlockInterval = DefaultBlockInterval
+	}
+	if config.NumProposers == 0 {
+		config.NumProposers = 1
+	}
+	for index := range config.Byzantine {
+		if index < 0 || index >= config.NumNodes {
+			return nil, fmt.Errorf("%w: %d", errInvalidByzantine, index)
+		}
+	}
+	numHonest := config.NumNodes - len(config.Byzantine)
+	if numHonest == 0 {
+		return nil, errNoHonestNodes
+	}
+	if config.NumProposers < 0 || config.NumProposers > numHonest {
+		return nil, fmt.Errorf("%w: %d proposers with %d honest nodes",
+			errInvalidNumProposers,
+			config.NumProposers,
+			numHonest,
+		)
+	}
+
+	s := &Simulation{
+		config:           config,
+		rand:             rand.New(rand.NewSource(config.Seed)), // #nosec G404
+		clock:            newClock(),
+		blocks:           newBlocks(),
+		nodesByID:        make(map[ids.NodeID]*node, config.NumNodes),
+		requests:         set.Set[request]{},
+		acceptedByHeight: make(map[uint64]ids.ID),
+		numAccepted:      make(map[ids.ID]int),
+	}
+
+	vdrs := validators.NewManager()
+	nodeIDs := make([]ids.NodeID, config.NumNodes)
+	for i := range nodeIDs {
+		seed := ids.Empty.Prefix(uint64(i))
+		nodeIDs[i] = ids.BuildTestNodeID(seed[:])
+		if err := vdrs.AddStaker(constants.PrimaryNetworkID, nodeIDs[i], nil, ids.Empty, 1); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, nodeID := range nodeIDs {
+		behavior := config.Byzantine[i]
+		n, err := newNode(tb, s, nodeID, behavior, vdrs, config.Params)
+		if err != nil {
+			return nil, err
+		}
+		s.nodes = append(s.nodes, n)
+		s.nodesByID[nodeID] = n
+		if n.honest() {
+			s.honest = append(s.honest, n)
+		}
+	}
+
+	for _, n := range s.nodes {
+		if err := n.engine.Start(context.Background(), 0); err != nil {
+			return nil, err
+		}
+	}
+
+	s.clock.schedule(config.BlockInterval, s.propose)
+	return s, nil
+}
+
+// NodeIDs returns the IDs of every node, in index order.
+func (s *Simulation) NodeIDs() []ids.NodeID {
+	nodeIDs := make([]ids.NodeID, len(s.nodes))
+	for i, n := range s.nodes {
+		nodeIDs[i] = n.id
+	}
+	return nodeIDs
+}
+
+// Blocks returns the registry of proposed blocks.
+func (s *Simulation) Blocks() *Blocks {
+	return s.blocks
+}
+
+// Now returns the current virtual time.
+func (s *Simulation) Now() time.Duration {
+	return s.clock.now
+}
+
+// Schedule [f] to be executed at the virtual time [at].
+func (s *Simulation) Schedule(at time.Duration, f func()) {
+	s.clock.scheduleAt(at, func() error {
+		f()
+		return nil
+	})
+}
+
+// Partition the network into [groups]. Messages sent between nodes in
+// different groups are dropped. Nodes that aren't included in any group are
+// placed into a group of their own.
+func (s *Simulation) Partition(groups ...[]ids.NodeID) {
+	s.partition = make(map[ids.NodeID]int, len(s.nodes))
+	for i, n := range s.nodes {
+		// Place every node into its own group by default.
+		s.partition[n.id] = -i - 1
+	}
+	for i, group := range groups {
+		for _, nodeID := range group {
+			s.partition[nodeID] = i
+		}
+	}
+}
+
+// Heal removes any network partition.
+func (s *Simulation) Heal() {
+	s.partition = nil
+}
+
+// Run the simulation for [duration] of virtual time and return the results
+// since the start of the simulation.
+func (s *Simulation) Run(duration time.Duration) (Results, error) {
+	if err := s.clock.run(s.clock.now + duration); err != nil {
+		return Results{}, err
+	}
+
+	results := s.results
+	results.Duration = s.clock.now
+	results.TimeToFinality = append([]time.Duration(nil), s.results.TimeToFinality...)
+	results.sort()
+	return results, nil
+}
+
+// propose asks a random set of honest nodes to build blocks.
+func (s *Simulation) propose() error {
+	s.clock.schedule(s.config.BlockInterval, s.propose)
+
+	for _, index := range s.rand.Perm(len(s.honest))[:s.config.NumProposers] {
+		if err := s.honest[index].notify(context.Background()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Simulation) partitioned(from, to ids.NodeID) bool {
+	return s.partition != nil && s.partition[from] != s.partition[to]
+}
+
+// send schedules [deliver] to be executed by [to] after the network latency.
+// Returns false if the message was dropped.
+func (s *Simulation) send(from, to ids.NodeID, deliver func(to *node) error) bool {
+	s.results.NumMessages++
+
+	recipient, ok := s.nodesByID[to]
+	if !ok || s.partitioned(from, to) {
+		s.results.NumDropped++
+		return false
+	}
+
+	latency := s.config.Latency(s.rand, from, to)
+	s.clock.schedule(latency, func() error {
+		return deliver(recipient)
+	})
+	return true
+}
+
+// sendRequest sends a request and registers its timeout.
+func (s *Simulation) sendRequest(
+	from, to ids.NodeID,
+	requestID uint32,
+	op requestOp,
+	deliver func(to *node) error,
+) {
+	req := request{
+		from:      from,
+		to:        to,
+		requestID: requestID,
+		op:        op,
+	}
+	s.requests.Add(req)
+	s.clock.schedule(s.config.RequestTimeout, func() error {
+		if !s.requests.Contains(req) {
+			return nil
+		}
+		s.requests.Remove(req)
+		s.results.NumTimeouts++
+		return s.nodesByID[from].requestFailed(context.Background(), to, requestID, op)
+	})
+
+	s.send(from, to, deliver)
+}
+
+// sendResponse sends a response that is only delivered if the request is
+// still outstanding once the response arrives.
+func (s *Simulation) sendResponse(
+	from, to ids.NodeID,
+	requestID uint32,
+	op requestOp,
+	deliver func(to *node) error,
+) {
+	req := request{
+		from:      to,
+		to:        from,
+		requestID: requestID,
+		op:        op,
+	}
+	s.send(from, to, func(recipient *node) error {
+		// Unrequested and timed out responses are dropped, as they are by the
+		// router.
+		if !s.requests.Contains(req) {
+			return nil
+		}
+		s.requests.Remove(req)
+		return deliver(recipient)
+	})
+}
+
+// accepted records that [n] accepted [blkID].
+func (s *Simulation) accepted(n *node, blkID ids.ID, height uint64) {
+	if !n.honest() {
+		return
+	}
+
+	if acceptedID, ok := s.acceptedByHeight[height]; !ok {
+		s.acceptedByHeight[height] = blkID
+	} else if acceptedID != blkID {
+		s.results.SafetyViolations++
+	}
+
+	s.numAccepted[blkID]++
+	if s.numAccepted[blkID] == len(s.honest) {
+		s.results.NumFinalized++
+	}
+
+	if info, ok := s.blocks.blocks[blkID]; ok {
+		s.results.TimeToFinality = append(s.results.TimeToFinality, s.clock.now-info.proposedAt)
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+)
+
+var testParams = snowball.Parameters{
+	K:                     5,
+	AlphaPreference:       3,
+	AlphaConfidence:       4,
+	Beta:                  3,
+	ConcurrentRepolls:     2,
+	OptimalProcessing:     10,
+	MaxOutstandingItems:   256,
+	MaxItemProcessingTime: 30 * time.Second,
+}
+
+func TestSimulationHonest(t *testing.T) {
+	tests := []struct {
+		name         string
+		latency      Latency
+		numProposers int
+	}{
+		{
+			name:         "constant latency",
+			latency:      ConstantLatency(50 * time.Millisecond),
+			numProposers: 1,
+		},
+		{
+			name:         "uniform latency with conflicts",
+			latency:      UniformLatency(10*time.Millisecond, 200*time.Millisecond),
+			numProposers: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			sim, err := New(t, Config{
+				Params:       testParams,
+				NumNodes:     10,
+				Latency:      test.latency,
+				NumProposers: test.numProposers,
+				Seed:         1,
+			})
+			require.NoError(err)
+
+			results, err := sim.Run(30 * time.Second)
+			require.NoError(err)
+			require.Equal(30*time.Second, results.Duration)
+			require.Positive(results.NumProposed)
+			require.Positive(results.NumFinalized)
+			require.Zero(results.SafetyViolations)
+			require.Zero(results.NumDropped)
+			require.NotEmpty(results.TimeToFinality)
+			require.True(isSorted(results.TimeToFinality))
+			require.LessOrEqual(results.FinalityPercentile(.5), results.FinalityPercentile(.99))
+		})
+	}
+}
+
+func TestSimulationPartition(t *testing.T) {
+	require := require.New(t)
+
+	sim, err := New(t, Config{
+		Params:   testParams,
+		NumNodes: 10,
+		Seed:     1,
+	})
+	require.NoError(err)
+
+	nodeIDs := sim.NodeIDs()
+	// Neither side of the partition can reach an alpha majority.
+	sim.Partition(nodeIDs[:5], nodeIDs[5:])
+
+	partitioned, err := sim.Run(20 * time.Second)
+	require.NoError(err)
+	require.Positive(partitioned.NumProposed)
+	require.Zero(partitioned.NumFinalized)
+	require.Positive(partitioned.NumDropped)
+	require.Positive(partitioned.NumTimeouts)
+
+	sim.Heal()
+
+	healed, err := sim.Run(60 * time.Second)
+	require.NoError(err)
+	require.Positive(healed.NumFinalized)
+	require.Zero(healed.SafetyViolations)
+}
+
+func TestSimulationByzantine(t *testing.T) {
+	tests := []struct {
+		name     string
+		behavior Behavior
+	}{
+		{
+			name:     "mute",
+			behavior: Mute,
+		},
+		{
+			name:     "contrarian",
+			behavior: Contrarian,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			sim, err := New(t, Config{
+				Params:   testParams,
+				NumNodes: 10,
+				Byzantine: map[int]Behavior{
+					0: test.behavior,
+				},
+				NumProposers: 2,
+				Seed:         1,
+			})
+			require.NoError(err)
+
+			results, err := sim.Run(60 * time.Second)
+			require.NoError(err)
+			require.Positive(results.NumFinalized)
+			require.Zero(results.SafetyViolations)
+		})
+	}
+}
+
+func TestSimulationSchedule(t *testing.T) {
+	require := require.New(t)
+
+	sim, err := New(t, Config{
+		Params:   testParams,
+		NumNodes: 10,
+		Seed:     1,
+	})
+	require.NoError(err)
+
+	var executedAt time.Duration
+	sim.Schedule(5*time.Second, func() {
+		executedAt = sim.Now()
+	})
+
+	_, err = sim.Run(10 * time.Second)
+	require.NoError(err)
+	require.Equal(5*time.Second, executedAt)
+	require.Equal(10*time.Second, sim.Now())
+}
+
+func TestNewInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      Config
+		expectedErr error
+	}{
+		{
+			name:        "no nodes",
+			config:      Config{Params: testParams},
+			expectedErr: errNoNodes,
+		},
+		{
+			name: "no honest nodes",
+			config: Config{
+				Params:    testParams,
+				NumNodes:  1,
+				Byzantine: map[int]Behavior{0: Mute},
+			},
+			expectedErr: errNoHonestNodes,
+		},
+		{
+			name: "invalid byzantine index",
+			config: Config{
+				Params:    testParams,
+				NumNodes:  1,
+				Byzantine: map[int]Behavior{1: Mute},
+			},
+			expectedErr: errInvalidByzantine,
+		},
+		{
+			name: "too many proposers",
+			config: Config{
+				Params:       testParams,
+				NumNodes:     2,
+				NumProposers: 3,
+			},
+			expectedErr: errInvalidNumProposers,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := New(t, test.config)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestContrarianChits(t *testing.T) {
+	require := require.New(t)
+
+	blocks := newBlocks()
+	genesisID := blocks.AtHeight(0)[0]
+	blk0, err := blocks.propose(genesisID, ids.EmptyNodeID, 0)
+	require.NoError(err)
+
+	honest := Chits{
+		PreferredID:         blk0.id,
+		PreferredIDAtHeight: blk0.id,
+	}
+
+	// Without a conflicting block, the honest chits are sent.
+	chits, ok := Contrarian.Chits(blocks, honest)
+	require.True(ok)
+	require.Equal(honest, chits)
+
+	blk1, err := blocks.propose(genesisID, ids.EmptyNodeID, 0)
+	require.NoError(err)
+
+	chits, ok = Contrarian.Chits(blocks, honest)
+	require.True(ok)
+	require.Equal(blk1.id, chits.PreferredID)
+	require.Equal(blk1.id, chits.PreferredIDAtHeight)
+}
+
+func isSorted(durations []time.Duration) bool {
+	for i := 1; i < len(durations); i++ {
+		if durations[i] < durations[i-1] {
+			return false
+		}
+	}
+	return true
+}
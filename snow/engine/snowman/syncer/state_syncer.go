@@ -613,6 +613,11 @@ func (ss *stateSyncer) HealthCheck(ctx context.Context) (interface{}, error) {
 		"consensus": struct{}{},
 		"vm":        vmIntf,
 	}
+	if progressVM, ok := ss.VM.(block.StateSyncProgressVM); ok {
+		if progress, err := progressVM.StateSyncProgress(ctx); err == nil {
+			intf["progress"] = progress
+		}
+	}
 	return intf, vmErr
 }
 
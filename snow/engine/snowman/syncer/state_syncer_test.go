@@ -1302,3 +1302,52 @@ func TestStateSyncIsDoneOnceVMNotifies(t *testing.T) {
 	require.NoError(syncer.Notify(context.Background(), common.StateSyncDone))
 	require.True(stateSyncFullyDone)
 }
+
+type progressVM struct {
+	*fullVM
+
+	progress block.StateSyncProgress
+}
+
+func (vm *progressVM) StateSyncProgress(context.Context) (block.StateSyncProgress, error) {
+	return vm.progress, nil
+}
+
+func TestStateSyncerHealthCheckReportsProgress(t *testing.T) {
+	require := require.New(t)
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+
+	beacons := buildTestPeers(t, ctx.SubnetID)
+	totalWeight, err := beacons.TotalWeight(ctx.SubnetID)
+	require.NoError(err)
+
+	startup := tracker.NewStartup(tracker.NewPeers(), 0)
+	syncer, fullVM, _ := buildTestsObjects(t, ctx, startup, beacons, (totalWeight+1)/2)
+	fullVM.HealthCheckF = func(context.Context) (interface{}, error) {
+		return nil, nil
+	}
+
+	// VMs that don't report their progress don't include it.
+	intf, err := syncer.HealthCheck(context.Background())
+	require.NoError(err)
+	require.NotContains(intf, "progress")
+
+	progress := block.StateSyncProgress{
+		RangesCompleted:        2,
+		KeysFetched:            100,
+		BytesFetched:           1000,
+		FractionCompleted:      .5,
+		EstimatedRemainingKeys: 100,
+	}
+	syncer.VM = &progressVM{
+		fullVM:   fullVM,
+		progress: progress,
+	}
+
+	intf, err = syncer.HealthCheck(context.Background())
+	require.NoError(err)
+	require.Contains(intf, "progress")
+	require.Equal(progress, intf.(map[string]interface{})["progress"])
+}
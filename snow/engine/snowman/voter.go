@@ -46,14 +46,17 @@ func (v *voter) Execute(ctx context.Context, _ []ids.ID, _ []ids.ID) error {
 	var results []bag.Bag[ids.ID]
 	if shouldVote {
 		v.e.selectedVoteIndex.Observe(float64(voteIndex))
+		v.e.DecisionTracer.ChitReceived(v.requestID, v.nodeID, vote)
 		results = v.e.polls.Vote(v.requestID, v.nodeID, vote)
 	} else {
+		v.e.DecisionTracer.ChitDropped(v.requestID, v.nodeID)
 		results = v.e.polls.Drop(v.requestID, v.nodeID)
 	}
 
 	if len(results) == 0 {
 		return nil
 	}
+	v.e.DecisionTracer.PollsFinished(results)
 
 	for _, result := range results {
 		v.e.Ctx.Log.Debug("finishing poll",
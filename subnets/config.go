@@ -11,6 +11,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/proposervm/proposer"
 )
 
 var errAllowedNodesWhenNotValidatorOnly = errors.New("allowedNodes can only be set when ValidatorOnly is true")
@@ -49,6 +50,11 @@ type Config struct {
 	// TODO: Move this flag once the proposervm is configurable on a per-chain
 	// basis.
 	ProposerNumHistoricalBlocks uint64 `json:"proposerNumHistoricalBlocks" yaml:"proposerNumHistoricalBlocks"`
+	// ProposerPolicy, if non-nil, replaces the default snowman++ proposer
+	// selection of this Subnet's chains once it is activated.
+	//
+	// Note: Every validator of this Subnet must use the same policy.
+	ProposerPolicy *proposer.PolicyConfig `json:"proposerPolicy" yaml:"proposerPolicy"`
 }
 
 func (c *Config) Valid() error {
@@ -58,5 +64,10 @@ func (c *Config) Valid() error {
 	if !c.ValidatorOnly && c.AllowedNodes.Len() > 0 {
 		return errAllowedNodesWhenNotValidatorOnly
 	}
+	if c.ProposerPolicy != nil {
+		if err := c.ProposerPolicy.Verify(); err != nil {
+			return fmt.Errorf("proposer policy %w", err)
+		}
+	}
 	return nil
 }
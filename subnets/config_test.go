@@ -11,6 +11,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/proposervm/proposer"
 )
 
 var validParameters = snowball.Parameters{
@@ -49,6 +50,26 @@ func TestValid(t *testing.T) {
 			},
 			expectedErr: errAllowedNodesWhenNotValidatorOnly,
 		},
+		{
+			name: "invalid proposer policy",
+			s: Config{
+				ConsensusParameters: validParameters,
+				ProposerPolicy: &proposer.PolicyConfig{
+					Policy: "unknown",
+				},
+			},
+			expectedErr: proposer.ErrUnknownPolicy,
+		},
+		{
+			name: "proposer policy without activation time",
+			s: Config{
+				ConsensusParameters: validParameters,
+				ProposerPolicy: &proposer.PolicyConfig{
+					Policy: proposer.RoundRobinPolicy,
+				},
+			},
+			expectedErr: proposer.ErrMissingActivationTime,
+		},
 		{
 			name: "valid",
 			s: Config{
@@ -0,0 +1,317 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package hdkeychain implements a BIP-32 hierarchical deterministic keychain
+// that derives keys along the BIP-44 path m/44'/9000'/account'/change/index.
+package hdkeychain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/utils/set"
+
+	bip32 "github.com/tyler-smith/go-bip32"
+	bip39 "github.com/tyler-smith/go-bip39"
+)
+
+const (
+	// Purpose is the BIP-44 purpose.
+	Purpose = 44
+	// CoinType is the SLIP-44 coin type registered for AVAX.
+	CoinType = 9000
+
+	// ExternalChain is the BIP-44 change level used for receiving addresses.
+	ExternalChain uint32 = 0
+	// InternalChain is the BIP-44 change level used for change addresses.
+	InternalChain uint32 = 1
+
+	// DefaultGapLimit is the number of consecutive unused addresses after
+	// which address discovery stops, as recommended by BIP-44.
+	DefaultGapLimit = 20
+
+	// mnemonicEntropySize is the entropy, in bits, of generated mnemonics.
+	// This results in 24 word mnemonics.
+	mnemonicEntropySize = 256
+)
+
+var (
+	_ keychain.Keychain = (*Keychain)(nil)
+
+	ErrInvalidMnemonic = errors.New("invalid mnemonic")
+	ErrInvalidChange   = errors.New("invalid change level")
+	ErrHardenedIndex   = errors.New("index must not be hardened")
+	ErrInvalidGapLimit = errors.New("gap limit must be greater than 0")
+)
+
+// UTXOClient is used to determine whether an address has been used during
+// address discovery. Both the P-chain and the X-chain clients implement this
+// interface.
+type UTXOClient interface {
+	GetUTXOs(
+		ctx context.Context,
+		addrs []ids.ShortID,
+		limit uint32,
+		startAddress ids.ShortID,
+		startUTXOID ids.ID,
+		options ...rpc.Option,
+	) ([][]byte, ids.ShortID, ids.ID, error)
+}
+
+// Keychain is a hierarchical deterministic keychain. Only the keys that have
+// been derived are made available to sign with.
+type Keychain struct {
+	lock sync.RWMutex
+
+	account uint32
+	// chainKeys are the extended keys at m/44'/9000'/account'/change, indexed
+	// by change level.
+	chainKeys [2]*bip32.Key
+	// nextIndices are the lowest address indices, indexed by change level,
+	// that have not been returned by NewAddress or found to be used during
+	// discovery.
+	nextIndices [2]uint32
+
+	addrToKey    map[ids.ShortID]*secp256k1.PrivateKey
+	ethAddrToKey map[common.Address]*secp256k1.PrivateKey
+	addrs        set.Set[ids.ShortID]
+	ethAddrs     set.Set[common.Address]
+}
+
+// NewMnemonic generates a new random 24 word BIP-39 mnemonic.
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropySize)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// New returns a keychain for [account] derived from a BIP-39 [mnemonic] and
+// optional [passphrase].
+func New(mnemonic string, passphrase string, account uint32) (*Keychain, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidMnemonic, err)
+	}
+	return NewFromSeed(seed, account)
+}
+
+// NewFromSeed returns a keychain for [account] derived from a BIP-32 [seed].
+func NewFromSeed(seed []byte, account uint32) (*Keychain, error) {
+	if account >= bip32.FirstHardenedChild {
+		return nil, ErrHardenedIndex
+	}
+
+	masterKey, err := bip32.NewMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	accountKey, err := deriveKey(
+		masterKey,
+		bip32.FirstHardenedChild+Purpose,
+		bip32.FirstHardenedChild+CoinType,
+		bip32.FirstHardenedChild+account,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	kc := &Keychain{
+		account:      account,
+		addrToKey:    make(map[ids.ShortID]*secp256k1.PrivateKey),
+		ethAddrToKey: make(map[common.Address]*secp256k1.PrivateKey),
+	}
+	for change := range kc.chainKeys {
+		kc.chainKeys[change], err = accountKey.NewChildKey(uint32(change))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return kc, nil
+}
+
+// Path returns the derivation path of the key at [change] and [index].
+func (kc *Keychain) Path(change, index uint32) string {
+	return fmt.Sprintf("m/%d'/%d'/%d'/%d/%d", Purpose, CoinType, kc.account, change, index)
+}
+
+// Derive derives the key at [change] and [index] and adds it to the keychain.
+func (kc *Keychain) Derive(change, index uint32) (*secp256k1.PrivateKey, error) {
+	kc.lock.Lock()
+	defer kc.lock.Unlock()
+
+	return kc.derive(change, index)
+}
+
+// NewAddress derives the next unused address of the [change] chain and adds
+// its key to the keychain.
+//
+// Addresses found to be used during discovery are never returned.
+func (kc *Keychain) NewAddress(change uint32) (ids.ShortID, error) {
+	kc.lock.Lock()
+	defer kc.lock.Unlock()
+
+	if change > InternalChain {
+		return ids.ShortEmpty, ErrInvalidChange
+	}
+
+	key, err := kc.derive(change, kc.nextIndices[change])
+	if err != nil {
+		return ids.ShortEmpty, err
+	}
+	kc.nextIndices[change]++
+	return key.Address(), nil
+}
+
+// Discover derives the keys of all the used addresses of the external and
+// internal chains and adds them to the keychain.
+//
+// An address is considered used if any of the [clients] report a UTXO
+// referencing it. Discovery of a chain stops once [gapLimit] consecutive
+// unused addresses are found.
+func (kc *Keychain) Discover(ctx context.Context, gapLimit uint32, clients ...UTXOClient) error {
+	if gapLimit == 0 {
+		return ErrInvalidGapLimit
+	}
+
+	kc.lock.Lock()
+	defer kc.lock.Unlock()
+
+	for change, chainKey := range kc.chainKeys {
+		var (
+			nextIndex = kc.nextIndices[change]
+			numUnused uint32
+		)
+		for index := nextIndex; numUnused < gapLimit; index++ {
+			if index >= bip32.FirstHardenedChild {
+				return ErrHardenedIndex
+			}
+
+			key, err := deriveSecp256k1Key(chainKey, index)
+			if err != nil {
+				return err
+			}
+			used, err := isUsed(ctx, key.Address(), clients)
+			if err != nil {
+				return err
+			}
+			if !used {
+				numUnused++
+				continue
+			}
+
+			numUnused = 0
+			nextIndex = index + 1
+		}
+
+		for index := kc.nextIndices[change]; index < nextIndex; index++ {
+			if _, err := kc.derive(uint32(change), index); err != nil {
+				return err
+			}
+		}
+		kc.nextIndices[change] = nextIndex
+	}
+	return nil
+}
+
+func (kc *Keychain) Get(addr ids.ShortID) (keychain.Signer, bool) {
+	kc.lock.RLock()
+	defer kc.lock.RUnlock()
+
+	key, ok := kc.addrToKey[addr]
+	if !ok {
+		return nil, false
+	}
+	return key, true
+}
+
+func (kc *Keychain) Addresses() set.Set[ids.ShortID] {
+	kc.lock.RLock()
+	defer kc.lock.RUnlock()
+
+	return set.Of(kc.addrs.List()...)
+}
+
+func (kc *Keychain) GetEth(addr common.Address) (keychain.Signer, bool) {
+	kc.lock.RLock()
+	defer kc.lock.RUnlock()
+
+	key, ok := kc.ethAddrToKey[addr]
+	if !ok {
+		return nil, false
+	}
+	return key, true
+}
+
+func (kc *Keychain) EthAddresses() set.Set[common.Address] {
+	kc.lock.RLock()
+	defer kc.lock.RUnlock()
+
+	return set.Of(kc.ethAddrs.List()...)
+}
+
+// derive assumes the lock is held.
+func (kc *Keychain) derive(change, index uint32) (*secp256k1.PrivateKey, error) {
+	if change > InternalChain {
+		return nil, ErrInvalidChange
+	}
+	if index >= bip32.FirstHardenedChild {
+		return nil, ErrHardenedIndex
+	}
+
+	key, err := deriveSecp256k1Key(kc.chainKeys[change], index)
+	if err != nil {
+		return nil, err
+	}
+
+	pk := key.PublicKey()
+	addr := pk.Address()
+	ethAddr := pk.EthAddress()
+	kc.addrToKey[addr] = key
+	kc.ethAddrToKey[ethAddr] = key
+	kc.addrs.Add(addr)
+	kc.ethAddrs.Add(ethAddr)
+	return key, nil
+}
+
+func deriveKey(key *bip32.Key, path ...uint32) (*bip32.Key, error) {
+	for _, index := range path {
+		var err error
+		key, err = key.NewChildKey(index)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+func deriveSecp256k1Key(chainKey *bip32.Key, index uint32) (*secp256k1.PrivateKey, error) {
+	key, err := chainKey.NewChildKey(index)
+	if err != nil {
+		return nil, err
+	}
+	return secp256k1.ToPrivateKey(key.Key)
+}
+
+func isUsed(ctx context.Context, addr ids.ShortID, clients []UTXOClient) (bool, error) {
+	addrs := []ids.ShortID{addr}
+	for _, client := range clients {
+		utxos, _, _, err := client.GetUTXOs(ctx, addrs, 1, ids.ShortEmpty, ids.Empty)
+		if err != nil {
+			return false, fmt.Errorf("failed to fetch UTXOs of %s: %w", addr, err)
+		}
+		if len(utxos) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,191 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package hdkeychain
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/utils/set"
+
+	bip32 "github.com/tyler-smith/go-bip32"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+var _ UTXOClient = (*testUTXOClient)(nil)
+
+type testUTXOClient struct {
+	used set.Set[ids.ShortID]
+}
+
+func (c *testUTXOClient) GetUTXOs(
+	_ context.Context,
+	addrs []ids.ShortID,
+	_ uint32,
+	_ ids.ShortID,
+	_ ids.ID,
+	_ ...rpc.Option,
+) ([][]byte, ids.ShortID, ids.ID, error) {
+	var utxos [][]byte
+	for _, addr := range addrs {
+		if c.used.Contains(addr) {
+			utxos = append(utxos, addr[:])
+		}
+	}
+	return utxos, ids.ShortEmpty, ids.Empty, nil
+}
+
+// Test vector 1 of BIP-32.
+func TestDeriveKeyVector(t *testing.T) {
+	require := require.New(t)
+
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(err)
+
+	masterKey, err := bip32.NewMasterKey(seed)
+	require.NoError(err)
+
+	// m/0'/1/2'/2/1000000000
+	key, err := deriveKey(
+		masterKey,
+		bip32.FirstHardenedChild,
+		1,
+		bip32.FirstHardenedChild+2,
+		2,
+		1_000_000_000,
+	)
+	require.NoError(err)
+	require.Equal(
+		"471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8",
+		hex.EncodeToString(key.Key),
+	)
+}
+
+func TestNewInvalidMnemonic(t *testing.T) {
+	_, err := New(strings.Repeat("abandon ", 12), "", 0)
+	require.ErrorIs(t, err, ErrInvalidMnemonic)
+}
+
+func TestNewMnemonic(t *testing.T) {
+	require := require.New(t)
+
+	mnemonic, err := NewMnemonic()
+	require.NoError(err)
+	require.Len(strings.Fields(mnemonic), 24)
+
+	_, err = New(mnemonic, "", 0)
+	require.NoError(err)
+}
+
+func TestKeychainDerive(t *testing.T) {
+	require := require.New(t)
+
+	kc, err := New(testMnemonic, "", 0)
+	require.NoError(err)
+	require.Empty(kc.Addresses())
+
+	addr, err := kc.NewAddress(ExternalChain)
+	require.NoError(err)
+
+	key, err := kc.Derive(ExternalChain, 0)
+	require.NoError(err)
+	require.Equal(key.Address(), addr)
+	require.Equal("m/44'/9000'/0'/0/0", kc.Path(ExternalChain, 0))
+
+	signer, ok := kc.Get(addr)
+	require.True(ok)
+	require.Equal(addr, signer.Address())
+
+	ethSigner, ok := kc.GetEth(key.EthAddress())
+	require.True(ok)
+	require.Equal(addr, ethSigner.Address())
+
+	changeAddr, err := kc.NewAddress(InternalChain)
+	require.NoError(err)
+	require.NotEqual(addr, changeAddr)
+	require.Equal(set.Of(addr, changeAddr), kc.Addresses())
+
+	// Derivation should be deterministic.
+	otherKC, err := New(testMnemonic, "", 0)
+	require.NoError(err)
+	otherAddr, err := otherKC.NewAddress(ExternalChain)
+	require.NoError(err)
+	require.Equal(addr, otherAddr)
+
+	// Different passphrases and accounts should result in different keys.
+	passphraseKC, err := New(testMnemonic, "passphrase", 0)
+	require.NoError(err)
+	passphraseAddr, err := passphraseKC.NewAddress(ExternalChain)
+	require.NoError(err)
+	require.NotEqual(addr, passphraseAddr)
+
+	accountKC, err := New(testMnemonic, "", 1)
+	require.NoError(err)
+	accountAddr, err := accountKC.NewAddress(ExternalChain)
+	require.NoError(err)
+	require.NotEqual(addr, accountAddr)
+
+	_, err = kc.Derive(InternalChain+1, 0)
+	require.ErrorIs(err, ErrInvalidChange)
+	_, err = kc.Derive(ExternalChain, bip32.FirstHardenedChild)
+	require.ErrorIs(err, ErrHardenedIndex)
+
+	_, ok = kc.Get(ids.GenerateTestShortID())
+	require.False(ok)
+}
+
+func TestKeychainDiscover(t *testing.T) {
+	require := require.New(t)
+
+	// Derive the addresses that will be reported as used.
+	refKC, err := New(testMnemonic, "", 0)
+	require.NoError(err)
+	usedExternal0, err := refKC.Derive(ExternalChain, 0)
+	require.NoError(err)
+	usedExternal5, err := refKC.Derive(ExternalChain, 5)
+	require.NoError(err)
+	usedInternal2, err := refKC.Derive(InternalChain, 2)
+	require.NoError(err)
+
+	pClient := &testUTXOClient{
+		used: set.Of(usedExternal0.Address(), usedInternal2.Address()),
+	}
+	xClient := &testUTXOClient{
+		used: set.Of(usedExternal5.Address()),
+	}
+
+	kc, err := New(testMnemonic, "", 0)
+	require.NoError(err)
+
+	const gapLimit = 5
+	err = kc.Discover(context.Background(), 0, pClient)
+	require.ErrorIs(err, ErrInvalidGapLimit)
+	require.NoError(kc.Discover(context.Background(), gapLimit, pClient, xClient))
+
+	// All addresses up to the last used address of each chain should be
+	// derived, even if some intermediate addresses are unused.
+	require.Len(kc.Addresses(), 6+3)
+	for _, addr := range []ids.ShortID{
+		usedExternal0.Address(),
+		usedExternal5.Address(),
+		usedInternal2.Address(),
+	} {
+		_, ok := kc.Get(addr)
+		require.True(ok)
+	}
+
+	// New addresses should follow the last used address.
+	addr, err := kc.NewAddress(ExternalChain)
+	require.NoError(err)
+	expectedKey, err := refKC.Derive(ExternalChain, 6)
+	require.NoError(err)
+	require.Equal(expectedKey.Address(), addr)
+}
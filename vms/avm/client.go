@@ -39,6 +39,10 @@ type Client interface {
 	// Deprecated: GetTxStatus only returns Accepted or Unknown, GetTx should be
 	// used instead to determine if the tx was accepted.
 	GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (choices.Status, error)
+	// WaitForTx blocks until [txID] is accepted and [confirmations] blocks
+	// were accepted on top of it, or until [timeout] elapses. If [timeout] is
+	// 0, the node's default timeout is used.
+	WaitForTx(ctx context.Context, txID ids.ID, confirmations uint64, timeout time.Duration, options ...rpc.Option) (*WaitForTxReply, error)
 	// GetTx returns the byte representation of [txID]
 	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetUTXOs returns the byte representation of the UTXOs controlled by [addrs]
@@ -146,6 +150,22 @@ func (c *client) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Op
 	return res.Status, err
 }
 
+func (c *client) WaitForTx(
+	ctx context.Context,
+	txID ids.ID,
+	confirmations uint64,
+	timeout time.Duration,
+	options ...rpc.Option,
+) (*WaitForTxReply, error) {
+	res := &WaitForTxReply{}
+	err := c.requester.SendRequest(ctx, "avm.waitForTx", &WaitForTxArgs{
+		TxID:          txID,
+		Confirmations: json.Uint64(confirmations),
+		Timeout:       json.Uint64(timeout.Milliseconds()),
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedTx{}
 	err := c.requester.SendRequest(ctx, "avm.getTx", &api.GetTxArgs{
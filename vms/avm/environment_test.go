@@ -454,5 +454,8 @@ func buildAndAccept(
 	require.Equal(txID, issuedTx.ID())
 	require.NoError(blk.Verify(context.Background()))
 	require.NoError(vm.SetPreference(context.Background(), blk.ID()))
+	// The block acceptor group of the chain notifies the tx waiter before the
+	// block is accepted.
+	require.NoError(vm.TxWaiter().Accept(snowtest.ConsensusContext(vm.ctx), blk.ID(), blk.Bytes()))
 	require.NoError(blk.Accept(context.Background()))
 }
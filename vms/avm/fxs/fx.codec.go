@@ -0,0 +1,43 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: fx.go
+
+package fxs
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*FxCredential)(nil)
+)
+
+func (t *FxCredential) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.Credential)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *FxCredential) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.Credential, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *FxCredential) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.Credential); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *FxCredential) CodecValue() interface{} {
+	return t
+}
@@ -4,6 +4,7 @@
 package avm
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -19,9 +20,11 @@ import (
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/avm/block"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/vms/txs/waiter"
 
 	avajson "github.com/ava-labs/avalanchego/utils/json"
 	safemath "github.com/ava-labs/avalanchego/utils/math"
@@ -314,6 +317,128 @@ func (s *Service) GetTxStatus(_ *http.Request, args *api.JSONTxID, reply *GetTxS
 	return nil
 }
 
+type WaitForTxArgs struct {
+	TxID ids.ID `json:"txID"`
+	// Confirmations is the number of blocks that must be accepted on top of
+	// the block that included the tx.
+	Confirmations avajson.Uint64 `json:"confirmations"`
+	// Timeout is the maximum number of milliseconds to wait for. If 0, a
+	// default timeout is used.
+	Timeout avajson.Uint64 `json:"timeout"`
+}
+
+type WaitForTxReply struct {
+	Status choices.Status `json:"status"`
+	// Confirmations is the number of blocks accepted on top of the block that
+	// included the tx, up to the requested number of confirmations.
+	Confirmations avajson.Uint64 `json:"confirmations"`
+}
+
+// WaitForTx blocks until a tx is accepted and the requested number of blocks
+// were accepted on top of it.
+func (s *Service) WaitForTx(r *http.Request, args *WaitForTxArgs, reply *WaitForTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "waitForTx"),
+		zap.Stringer("txID", args.TxID),
+		zap.Uint64("confirmations", uint64(args.Confirmations)),
+	)
+
+	if args.TxID == ids.Empty {
+		return errNilTxID
+	}
+
+	confirmations := uint64(args.Confirmations)
+	timeout, err := waiter.Timeout(uint64(args.Timeout), confirmations)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+
+	// txHeight is the height of the block that included the tx. It is looked
+	// up once, so that re-evaluating the wait after every accepted block
+	// doesn't walk the accepted blocks again.
+	var (
+		txHeight      uint64
+		txHeightKnown bool
+	)
+	*reply, err = waiter.Wait(ctx, s.vm.txWaiter, func() (WaitForTxReply, bool, error) {
+		s.vm.ctx.Lock.Lock()
+		defer s.vm.ctx.Lock.Unlock()
+
+		reply := WaitForTxReply{
+			Status: choices.Unknown,
+		}
+		if s.vm.chainManager == nil {
+			return reply, false, errNotLinearized
+		}
+
+		_, err := s.vm.state.GetTx(args.TxID)
+		switch err {
+		case nil:
+			reply.Status = choices.Accepted
+		case database.ErrNotFound:
+			return reply, false, nil
+		default:
+			return reply, false, err
+		}
+
+		lastAccepted, err := s.vm.chainManager.GetStatelessBlock(s.vm.state.GetLastAccepted())
+		if err != nil {
+			return reply, false, err
+		}
+		if !txHeightKnown {
+			txHeight, txHeightKnown, err = s.getTxHeight(args.TxID, lastAccepted, confirmations)
+			if err != nil {
+				return reply, false, err
+			}
+		}
+		txConfirmations := confirmations
+		if txHeightKnown {
+			txConfirmations = min(lastAccepted.Height()-txHeight, confirmations)
+		}
+		reply.Confirmations = avajson.Uint64(txConfirmations)
+		return reply, txConfirmations >= confirmations, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed waiting for tx %s with status %s and %d confirmations: %w",
+			args.TxID,
+			reply.Status,
+			reply.Confirmations,
+			err,
+		)
+	}
+	return nil
+}
+
+// getTxHeight returns the height of the accepted block that included [txID].
+// Only the last [maxDepth] accepted blocks, starting at [lastAccepted], are
+// searched. If the tx isn't included in them, false is returned.
+//
+// getTxHeight assumes the context lock is held.
+func (s *Service) getTxHeight(txID ids.ID, lastAccepted block.Block, maxDepth uint64) (uint64, bool, error) {
+	blk := lastAccepted
+	for depth := uint64(0); depth < maxDepth; depth++ {
+		for _, tx := range blk.Txs() {
+			if tx.ID() == txID {
+				return blk.Height(), true, nil
+			}
+		}
+		if blk.Height() == 0 {
+			break
+		}
+
+		var err error
+		blk, err = s.vm.chainManager.GetStatelessBlock(blk.Parent())
+		if err != nil {
+			return 0, false, err
+		}
+	}
+	return 0, false, nil
+}
+
 // GetTx returns the specified transaction
 func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, reply *api.GetTxReply) error {
 	s.vm.ctx.Log.Debug("API called",
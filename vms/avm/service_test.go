@@ -4,8 +4,10 @@
 package avm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
@@ -39,6 +41,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/nftfx"
 	"github.com/ava-labs/avalanchego/vms/propertyfx"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/vms/txs/waiter"
 
 	avajson "github.com/ava-labs/avalanchego/utils/json"
 )
@@ -97,6 +100,75 @@ func TestServiceGetTxStatus(t *testing.T) {
 	require.Equal(choices.Accepted, statusReply.Status)
 }
 
+func TestServiceWaitForTx(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: upgradetest.Latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	err := service.WaitForTx(&http.Request{}, &WaitForTxArgs{}, &WaitForTxReply{})
+	require.ErrorIs(err, errNilTxID)
+
+	newTx := newAvaxBaseTxWithOutputs(t, env)
+	args := &WaitForTxArgs{
+		TxID:    newTx.ID(),
+		Timeout: 10,
+	}
+
+	// Waiting for an unknown tx times out.
+	err = service.WaitForTx(&http.Request{}, args, &WaitForTxReply{})
+	require.ErrorIs(err, context.DeadlineExceeded)
+
+	issueAndAccept(require, env.vm, env.issuer, newTx)
+
+	reply := &WaitForTxReply{}
+	require.NoError(service.WaitForTx(&http.Request{}, args, reply))
+	require.Equal(choices.Accepted, reply.Status)
+	require.Zero(reply.Confirmations)
+
+	// The last observed status is reported when the wait times out.
+	args.Confirmations = 1
+	reply = &WaitForTxReply{}
+	err = service.WaitForTx(&http.Request{}, args, reply)
+	require.ErrorIs(err, context.DeadlineExceeded)
+	require.Equal(choices.Accepted, reply.Status)
+	require.Zero(reply.Confirmations)
+
+	// Wait for a confirmation on top of the block that included the tx.
+	args.Timeout = avajson.Uint64(waiter.MaxTimeout.Milliseconds())
+	done := make(chan error, 1)
+	reply = &WaitForTxReply{}
+	go func() {
+		done <- service.WaitForTx(&http.Request{}, args, reply)
+	}()
+
+	key := keys[2]
+	nextTx, err := env.txBuilder.BaseTx(
+		[]*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: env.vm.feeAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.MicroAvax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{key.Address()},
+				},
+			},
+		}},
+		nil, // memo
+		secp256k1fx.NewKeychain(key),
+		key.Address(),
+	)
+	require.NoError(err)
+	issueAndAccept(require, env.vm, env.issuer, nextTx)
+
+	require.NoError(<-done)
+	require.Equal(choices.Accepted, reply.Status)
+	require.Equal(avajson.Uint64(1), reply.Confirmations)
+}
+
 // Test the GetBalance method when argument Strict is true
 func TestServiceGetBalanceStrict(t *testing.T) {
 	require := require.New(t)
@@ -0,0 +1,43 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: base_tx.go
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*BaseTx)(nil)
+)
+
+func (t *BaseTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *BaseTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *BaseTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *BaseTx) CodecValue() interface{} {
+	return t
+}
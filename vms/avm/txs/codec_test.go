@@ -0,0 +1,310 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/codec/reflectcodec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/avm/fxs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/nftfx"
+	"github.com/ava-labs/avalanchego/vms/propertyfx"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// reflectOnlyTagName is an additional tag name that prevents codecs from using
+// the generated marshalling code, which only handles
+// [reflectcodec.DefaultTagName].
+const reflectOnlyTagName = "reflectOnly"
+
+// newCodecTestParsers returns a parser that uses the generated marshalling code
+// and a parser that only uses reflection.
+func newCodecTestParsers(t *testing.T) (Parser, Parser) {
+	newParser := func(newCodec func() linearcodec.Codec) Parser {
+		parser, err := newCustomParser(
+			newCodec,
+			make(map[reflect.Type]int),
+			&mockable.Clock{},
+			logging.NoLog{},
+			[]fxs.Fx{
+				&secp256k1fx.Fx{},
+				&nftfx.Fx{},
+				&propertyfx.Fx{},
+			},
+		)
+		require.NoError(t, err)
+		return parser
+	}
+	return newParser(linearcodec.NewDefault),
+		newParser(func() linearcodec.Codec {
+			return linearcodec.New([]string{reflectcodec.DefaultTagName, reflectOnlyTagName})
+		})
+}
+
+func newCodecTestOwners(keys ...*secp256k1.PrivateKey) secp256k1fx.OutputOwners {
+	owners := secp256k1fx.OutputOwners{
+		Locktime:  1,
+		Threshold: uint32(len(keys)),
+	}
+	for _, key := range keys {
+		owners.Addrs = append(owners.Addrs, key.Address())
+	}
+	return owners
+}
+
+func newCodecTestBaseTx() BaseTx {
+	return BaseTx{
+		BaseTx: avax.BaseTx{
+			NetworkID:    10,
+			BlockchainID: chainID,
+			Outs: []*avax.TransferableOutput{{
+				Asset: avax.Asset{ID: assetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          12345,
+					OutputOwners: newCodecTestOwners(keys[0], keys[1]),
+				},
+			}},
+			Ins: []*avax.TransferableInput{{
+				UTXOID: avax.UTXOID{
+					TxID:        ids.ID{0xf1, 0xe1, 0xd1},
+					OutputIndex: 1,
+				},
+				Asset: avax.Asset{ID: assetID},
+				In: &secp256k1fx.TransferInput{
+					Amt: 54321,
+					Input: secp256k1fx.Input{
+						SigIndices: []uint32{0, 2},
+					},
+				},
+			}},
+			Memo: []byte{0x00, 0x01, 0x02, 0x03},
+		},
+	}
+}
+
+func TestGeneratedCodecMatchesReflection(t *testing.T) {
+	utxoID := avax.UTXOID{
+		TxID:        ids.ID{0xa1, 0xb1},
+		OutputIndex: 2,
+	}
+	tests := []struct {
+		name     string
+		unsigned UnsignedTx
+		sign     func(tx *Tx, p Parser) error
+	}{
+		{
+			name: "BaseTx",
+			unsigned: func() UnsignedTx {
+				tx := newCodecTestBaseTx()
+				return &tx
+			}(),
+			sign: func(tx *Tx, p Parser) error {
+				return tx.SignSECP256K1Fx(p.Codec(), [][]*secp256k1.PrivateKey{{keys[0], keys[1]}})
+			},
+		},
+		{
+			name: "CreateAssetTx",
+			unsigned: &CreateAssetTx{
+				BaseTx:       newCodecTestBaseTx(),
+				Name:         "Volatility Index",
+				Symbol:       "VIX",
+				Denomination: 2,
+				States: []*InitialState{
+					{
+						FxIndex: 0,
+						Outs: []verify.State{
+							&secp256k1fx.MintOutput{
+								OutputOwners: newCodecTestOwners(keys[0]),
+							},
+							&secp256k1fx.TransferOutput{
+								Amt:          12345,
+								OutputOwners: newCodecTestOwners(keys[1]),
+							},
+						},
+					},
+					{
+						FxIndex: 1,
+						Outs: []verify.State{
+							&nftfx.MintOutput{
+								GroupID:      3,
+								OutputOwners: newCodecTestOwners(keys[2]),
+							},
+						},
+					},
+					{
+						FxIndex: 2,
+						Outs: []verify.State{
+							&propertyfx.MintOutput{
+								OutputOwners: newCodecTestOwners(keys[0], keys[2]),
+							},
+						},
+					},
+				},
+			},
+			sign: func(tx *Tx, p Parser) error {
+				return tx.SignSECP256K1Fx(p.Codec(), [][]*secp256k1.PrivateKey{{keys[0], keys[1]}})
+			},
+		},
+		{
+			name: "OperationTx",
+			unsigned: &OperationTx{
+				BaseTx: newCodecTestBaseTx(),
+				Ops: []*Operation{
+					{
+						Asset:   avax.Asset{ID: assetID},
+						UTXOIDs: []*avax.UTXOID{&utxoID},
+						Op: &secp256k1fx.MintOperation{
+							MintInput: secp256k1fx.Input{
+								SigIndices: []uint32{0},
+							},
+							MintOutput: secp256k1fx.MintOutput{
+								OutputOwners: newCodecTestOwners(keys[0]),
+							},
+							TransferOutput: secp256k1fx.TransferOutput{
+								Amt:          1,
+								OutputOwners: newCodecTestOwners(keys[1]),
+							},
+						},
+					},
+					{
+						Asset:   avax.Asset{ID: assetID},
+						UTXOIDs: []*avax.UTXOID{&utxoID},
+						Op: &nftfx.MintOperation{
+							MintInput: secp256k1fx.Input{
+								SigIndices: []uint32{0},
+							},
+							GroupID: 3,
+							Payload: []byte{0x04, 0x05},
+							Outputs: []*secp256k1fx.OutputOwners{
+								{Threshold: 1, Addrs: []ids.ShortID{keys[1].Address()}},
+							},
+						},
+					},
+					{
+						Asset:   avax.Asset{ID: assetID},
+						UTXOIDs: []*avax.UTXOID{&utxoID},
+						Op: &nftfx.TransferOperation{
+							Input: secp256k1fx.Input{
+								SigIndices: []uint32{0},
+							},
+							Output: nftfx.TransferOutput{
+								GroupID:      3,
+								Payload:      []byte{0x06},
+								OutputOwners: newCodecTestOwners(keys[2]),
+							},
+						},
+					},
+					{
+						Asset:   avax.Asset{ID: assetID},
+						UTXOIDs: []*avax.UTXOID{&utxoID},
+						Op: &propertyfx.MintOperation{
+							MintInput: secp256k1fx.Input{
+								SigIndices: []uint32{0},
+							},
+							MintOutput: propertyfx.MintOutput{
+								OutputOwners: newCodecTestOwners(keys[0]),
+							},
+							OwnedOutput: propertyfx.OwnedOutput{
+								OutputOwners: newCodecTestOwners(keys[1]),
+							},
+						},
+					},
+					{
+						Asset:   avax.Asset{ID: assetID},
+						UTXOIDs: []*avax.UTXOID{&utxoID},
+						Op: &propertyfx.BurnOperation{
+							Input: secp256k1fx.Input{
+								SigIndices: []uint32{0},
+							},
+						},
+					},
+				},
+			},
+			sign: func(tx *Tx, p Parser) error {
+				signers := [][]*secp256k1.PrivateKey{{keys[0]}, {keys[0]}}
+				if err := tx.SignSECP256K1Fx(p.Codec(), signers); err != nil {
+					return err
+				}
+				if err := tx.SignNFTFx(p.Codec(), signers); err != nil {
+					return err
+				}
+				return tx.SignPropertyFx(p.Codec(), signers)
+			},
+		},
+		{
+			name: "ImportTx",
+			unsigned: &ImportTx{
+				BaseTx:      newCodecTestBaseTx(),
+				SourceChain: ids.ID{0x1f},
+				ImportedIns: []*avax.TransferableInput{{
+					UTXOID: utxoID,
+					Asset:  avax.Asset{ID: assetID},
+					In: &secp256k1fx.TransferInput{
+						Amt: 1000,
+						Input: secp256k1fx.Input{
+							SigIndices: []uint32{0},
+						},
+					},
+				}},
+			},
+			sign: func(tx *Tx, p Parser) error {
+				return tx.SignSECP256K1Fx(p.Codec(), [][]*secp256k1.PrivateKey{{keys[0], keys[1]}, {keys[2]}})
+			},
+		},
+		{
+			name: "ExportTx",
+			unsigned: &ExportTx{
+				BaseTx:           newCodecTestBaseTx(),
+				DestinationChain: ids.ID{0x2f},
+				ExportedOuts: []*avax.TransferableOutput{{
+					Asset: avax.Asset{ID: assetID},
+					Out: &secp256k1fx.TransferOutput{
+						Amt:          1000,
+						OutputOwners: newCodecTestOwners(keys[2]),
+					},
+				}},
+			},
+			sign: func(tx *Tx, p Parser) error {
+				return tx.SignSECP256K1Fx(p.Codec(), [][]*secp256k1.PrivateKey{{keys[0], keys[1]}})
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			generated, reflected := newCodecTestParsers(t)
+
+			generatedTx := &Tx{Unsigned: test.unsigned}
+			require.NoError(test.sign(generatedTx, generated))
+			reflectedTx := &Tx{Unsigned: test.unsigned}
+			require.NoError(test.sign(reflectedTx, reflected))
+			require.Equal(reflectedTx.Bytes(), generatedTx.Bytes())
+
+			generatedSize, err := generated.Codec().Size(CodecVersion, generatedTx)
+			require.NoError(err)
+			reflectedSize, err := reflected.Codec().Size(CodecVersion, generatedTx)
+			require.NoError(err)
+			require.Equal(reflectedSize, generatedSize)
+			require.Len(generatedTx.Bytes(), generatedSize)
+
+			parsedGeneratedTx, err := generated.ParseTx(generatedTx.Bytes())
+			require.NoError(err)
+			parsedReflectedTx, err := reflected.ParseTx(generatedTx.Bytes())
+			require.NoError(err)
+			require.Equal(parsedReflectedTx, parsedGeneratedTx)
+			require.Equal(generatedTx.Bytes(), parsedGeneratedTx.Bytes())
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: create_asset_tx.go
+
+package txs
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*CreateAssetTx)(nil)
+)
+
+func (t *CreateAssetTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += wrappers.StringLen(string(t.Name))
+	size += wrappers.StringLen(string(t.Symbol))
+	size += 1
+	size += wrappers.IntLen
+	for i0 := range t.States {
+		start1 := size
+		if t.States[i0] == nil {
+			return 0, codec.ErrMarshalNil
+		}
+		{
+			n, err := c.Size(&(*t.States[i0]))
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		if i0 == 0 && size == start1 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	return size, nil
+}
+
+func (t *CreateAssetTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	p.PackStr(string(t.Name))
+	p.PackStr(string(t.Symbol))
+	p.PackByte(byte(t.Denomination))
+	if len(t.States) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.States)))
+	for i0 := range t.States {
+		start1 := p.Offset
+		if t.States[i0] == nil {
+			return codec.ErrMarshalNil
+		}
+		if err := c.MarshalInto(&(*t.States[i0]), p); err != nil {
+			return err
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start1 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	return p.Err
+}
+
+func (t *CreateAssetTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	t.Name = string(p.UnpackStr())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Symbol = string(p.UnpackStr())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Denomination = byte(p.UnpackByte())
+	if p.Err != nil {
+		return p.Err
+	}
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.States = make([]*InitialState, 0, min(int(n0), 16))
+	for i1 := 0; i1 < int(n0); i1++ {
+		var elem3 *InitialState
+		t.States = append(t.States, elem3)
+		start2 := p.Offset
+		t.States[i1] = new(InitialState)
+		if err := c.UnmarshalFrom(p, &(*t.States[i1])); err != nil {
+			return err
+		}
+		if p.Offset == start2 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	return nil
+}
+
+func (t *CreateAssetTx) CodecValue() interface{} {
+	return t
+}
@@ -0,0 +1,108 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: export_tx.go
+
+package txs
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+)
+
+var (
+	_ codec.Marshaler = (*ExportTx)(nil)
+)
+
+func (t *ExportTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 32
+	size += wrappers.IntLen
+	for i0 := range t.ExportedOuts {
+		start1 := size
+		if t.ExportedOuts[i0] == nil {
+			return 0, codec.ErrMarshalNil
+		}
+		{
+			n, err := c.Size(&(*t.ExportedOuts[i0]))
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		if i0 == 0 && size == start1 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	return size, nil
+}
+
+func (t *ExportTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	p.PackFixedBytes(t.DestinationChain[:])
+	if len(t.ExportedOuts) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.ExportedOuts)))
+	for i0 := range t.ExportedOuts {
+		start1 := p.Offset
+		if t.ExportedOuts[i0] == nil {
+			return codec.ErrMarshalNil
+		}
+		if err := c.MarshalInto(&(*t.ExportedOuts[i0]), p); err != nil {
+			return err
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start1 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	return p.Err
+}
+
+func (t *ExportTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	copy(t.DestinationChain[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.ExportedOuts = make([]*avax.TransferableOutput, 0, min(int(n0), 16))
+	for i1 := 0; i1 < int(n0); i1++ {
+		var elem3 *avax.TransferableOutput
+		t.ExportedOuts = append(t.ExportedOuts, elem3)
+		start2 := p.Offset
+		t.ExportedOuts[i1] = new(avax.TransferableOutput)
+		if err := c.UnmarshalFrom(p, &(*t.ExportedOuts[i1])); err != nil {
+			return err
+		}
+		if p.Offset == start2 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	return nil
+}
+
+func (t *ExportTx) CodecValue() interface{} {
+	return t
+}
@@ -3,6 +3,8 @@
 
 package txs
 
+//go:generate go run github.com/ava-labs/avalanchego/codec/codecgen/cmd $GOFILE
+
 import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/set"
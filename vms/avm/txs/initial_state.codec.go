@@ -0,0 +1,88 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: initial_state.go
+
+package txs
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+)
+
+var (
+	_ codec.Marshaler = (*InitialState)(nil)
+)
+
+func (t *InitialState) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += 4
+	size += wrappers.IntLen
+	for i0 := range t.Outs {
+		start1 := size
+		{
+			n, err := c.Size(&t.Outs[i0])
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		if i0 == 0 && size == start1 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	return size, nil
+}
+
+func (t *InitialState) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	p.PackInt(uint32(t.FxIndex))
+	if len(t.Outs) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Outs)))
+	for i0 := range t.Outs {
+		start1 := p.Offset
+		if err := c.MarshalInto(&t.Outs[i0], p); err != nil {
+			return err
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start1 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	return p.Err
+}
+
+func (t *InitialState) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	t.FxIndex = uint32(p.UnpackInt())
+	if p.Err != nil {
+		return p.Err
+	}
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Outs = make([]verify.State, 0, min(int(n0), 16))
+	for i1 := 0; i1 < int(n0); i1++ {
+		var elem3 verify.State
+		t.Outs = append(t.Outs, elem3)
+		start2 := p.Offset
+		if err := c.UnmarshalFrom(p, &t.Outs[i1]); err != nil {
+			return err
+		}
+		if p.Offset == start2 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	return nil
+}
+
+func (t *InitialState) CodecValue() interface{} {
+	return t
+}
@@ -0,0 +1,115 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: operation.go
+
+package txs
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+)
+
+var (
+	_ codec.Marshaler = (*Operation)(nil)
+)
+
+func (t *Operation) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.Asset)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += wrappers.IntLen
+	for i0 := range t.UTXOIDs {
+		start1 := size
+		if t.UTXOIDs[i0] == nil {
+			return 0, codec.ErrMarshalNil
+		}
+		{
+			n, err := c.Size(&(*t.UTXOIDs[i0]))
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		if i0 == 0 && size == start1 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	{
+		n, err := c.Size(&t.Op)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *Operation) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.Asset, p); err != nil {
+		return err
+	}
+	if len(t.UTXOIDs) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.UTXOIDs)))
+	for i0 := range t.UTXOIDs {
+		start1 := p.Offset
+		if t.UTXOIDs[i0] == nil {
+			return codec.ErrMarshalNil
+		}
+		if err := c.MarshalInto(&(*t.UTXOIDs[i0]), p); err != nil {
+			return err
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start1 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	if err := c.MarshalInto(&t.Op, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *Operation) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.Asset); err != nil {
+		return err
+	}
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.UTXOIDs = make([]*avax.UTXOID, 0, min(int(n0), 16))
+	for i1 := 0; i1 < int(n0); i1++ {
+		var elem3 *avax.UTXOID
+		t.UTXOIDs = append(t.UTXOIDs, elem3)
+		start2 := p.Offset
+		t.UTXOIDs[i1] = new(avax.UTXOID)
+		if err := c.UnmarshalFrom(p, &(*t.UTXOIDs[i1])); err != nil {
+			return err
+		}
+		if p.Offset == start2 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	if err := c.UnmarshalFrom(p, &t.Op); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *Operation) CodecValue() interface{} {
+	return t
+}
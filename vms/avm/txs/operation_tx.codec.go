@@ -0,0 +1,101 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: operation_tx.go
+
+package txs
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*OperationTx)(nil)
+)
+
+func (t *OperationTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += wrappers.IntLen
+	for i0 := range t.Ops {
+		start1 := size
+		if t.Ops[i0] == nil {
+			return 0, codec.ErrMarshalNil
+		}
+		{
+			n, err := c.Size(&(*t.Ops[i0]))
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		if i0 == 0 && size == start1 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	return size, nil
+}
+
+func (t *OperationTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	if len(t.Ops) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Ops)))
+	for i0 := range t.Ops {
+		start1 := p.Offset
+		if t.Ops[i0] == nil {
+			return codec.ErrMarshalNil
+		}
+		if err := c.MarshalInto(&(*t.Ops[i0]), p); err != nil {
+			return err
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start1 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	return p.Err
+}
+
+func (t *OperationTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Ops = make([]*Operation, 0, min(int(n0), 16))
+	for i1 := 0; i1 < int(n0); i1++ {
+		var elem3 *Operation
+		t.Ops = append(t.Ops, elem3)
+		start2 := p.Offset
+		t.Ops[i1] = new(Operation)
+		if err := c.UnmarshalFrom(p, &(*t.Ops[i1])); err != nil {
+			return err
+		}
+		if p.Offset == start2 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	return nil
+}
+
+func (t *OperationTx) CodecValue() interface{} {
+	return t
+}
@@ -54,8 +54,19 @@ func NewCustomParser(
 	log logging.Logger,
 	fxs []fxs.Fx,
 ) (Parser, error) {
-	gc := linearcodec.NewDefault()
-	c := linearcodec.NewDefault()
+	return newCustomParser(linearcodec.NewDefault, typeToFxIndex, clock, log, fxs)
+}
+
+// newCustomParser returns a parser whose codecs are created by [newCodec].
+func newCustomParser(
+	newCodec func() linearcodec.Codec,
+	typeToFxIndex map[reflect.Type]int,
+	clock *mockable.Clock,
+	log logging.Logger,
+	fxs []fxs.Fx,
+) (Parser, error) {
+	gc := newCodec()
+	c := newCodec()
 
 	gcm := codec.NewManager(math.MaxInt32)
 	cm := codec.NewDefaultManager()
@@ -0,0 +1,102 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: tx.go
+
+package txs
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/avm/fxs"
+)
+
+var (
+	_ codec.Marshaler = (*Tx)(nil)
+)
+
+func (t *Tx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.Unsigned)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += wrappers.IntLen
+	for i0 := range t.Creds {
+		start1 := size
+		if t.Creds[i0] == nil {
+			return 0, codec.ErrMarshalNil
+		}
+		{
+			n, err := c.Size(&(*t.Creds[i0]))
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		if i0 == 0 && size == start1 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	return size, nil
+}
+
+func (t *Tx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.Unsigned, p); err != nil {
+		return err
+	}
+	if len(t.Creds) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Creds)))
+	for i0 := range t.Creds {
+		start1 := p.Offset
+		if t.Creds[i0] == nil {
+			return codec.ErrMarshalNil
+		}
+		if err := c.MarshalInto(&(*t.Creds[i0]), p); err != nil {
+			return err
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start1 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	return p.Err
+}
+
+func (t *Tx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.Unsigned); err != nil {
+		return err
+	}
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Creds = make([]*fxs.FxCredential, 0, min(int(n0), 16))
+	for i1 := 0; i1 < int(n0); i1++ {
+		var elem3 *fxs.FxCredential
+		t.Creds = append(t.Creds, elem3)
+		start2 := p.Offset
+		t.Creds[i1] = new(fxs.FxCredential)
+		if err := c.UnmarshalFrom(p, &(*t.Creds[i1])); err != nil {
+			return err
+		}
+		if p.Offset == start2 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	return nil
+}
+
+func (t *Tx) CodecValue() interface{} {
+	return t
+}
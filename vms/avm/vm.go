@@ -39,6 +39,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/index"
 	"github.com/ava-labs/avalanchego/vms/txs/mempool"
+	"github.com/ava-labs/avalanchego/vms/txs/waiter"
 
 	blockbuilder "github.com/ava-labs/avalanchego/vms/avm/block/builder"
 	blockexecutor "github.com/ava-labs/avalanchego/vms/avm/block/executor"
@@ -56,6 +57,7 @@ var (
 	errGenesisAssetMustHaveState = errors.New("genesis asset must have non-empty state")
 
 	_ vertex.LinearizableVMWithEngine = (*VM)(nil)
+	_ waiter.VM                       = (*VM)(nil)
 )
 
 type VM struct {
@@ -103,6 +105,10 @@ type VM struct {
 
 	walletService WalletService
 
+	// txWaiter wakes up API calls that are waiting for txs when blocks are
+	// accepted.
+	txWaiter *waiter.Waiter
+
 	addressTxsIndexer index.AddressTxsIndexer
 
 	txBackend *txexecutor.Backend
@@ -239,6 +245,7 @@ func (vm *VM) Initialize(
 
 	vm.walletService.vm = vm
 	vm.walletService.pendingTxs = linked.NewHashmap[ids.ID, *txs.Tx]()
+	vm.txWaiter = waiter.New()
 
 	// use no op impl when disabled in config
 	if avmConfig.IndexTransactions {
@@ -322,6 +329,11 @@ func (*VM) Version(context.Context) (string, error) {
 	return version.Current.String(), nil
 }
 
+// TxWaiter returns the waiter of the API calls that are waiting for txs
+func (vm *VM) TxWaiter() *waiter.Waiter {
+	return vm.txWaiter
+}
+
 func (vm *VM) CreateHandlers(context.Context) (map[string]http.Handler, error) {
 	codec := json.NewCodec()
 
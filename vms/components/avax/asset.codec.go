@@ -0,0 +1,36 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: asset.go
+
+package avax
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*Asset)(nil)
+)
+
+func (t *Asset) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += 32
+	return size, nil
+}
+
+func (t *Asset) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	p.PackFixedBytes(t.ID[:])
+	return p.Err
+}
+
+func (t *Asset) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	copy(t.ID[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	return nil
+}
+
+func (t *Asset) CodecValue() interface{} {
+	return t
+}
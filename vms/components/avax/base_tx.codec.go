@@ -0,0 +1,174 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: base_tx.go
+
+package avax
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*BaseTx)(nil)
+)
+
+func (t *BaseTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += 4
+	size += 32
+	size += wrappers.IntLen
+	for i0 := range t.Outs {
+		start1 := size
+		if t.Outs[i0] == nil {
+			return 0, codec.ErrMarshalNil
+		}
+		{
+			n, err := c.Size(&(*t.Outs[i0]))
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		if i0 == 0 && size == start1 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	size += wrappers.IntLen
+	for i2 := range t.Ins {
+		start3 := size
+		if t.Ins[i2] == nil {
+			return 0, codec.ErrMarshalNil
+		}
+		{
+			n, err := c.Size(&(*t.Ins[i2]))
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		if i2 == 0 && size == start3 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	size += wrappers.IntLen
+	size += len(t.Memo)
+	return size, nil
+}
+
+func (t *BaseTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	p.PackInt(uint32(t.NetworkID))
+	p.PackFixedBytes(t.BlockchainID[:])
+	if len(t.Outs) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Outs)))
+	for i0 := range t.Outs {
+		start1 := p.Offset
+		if t.Outs[i0] == nil {
+			return codec.ErrMarshalNil
+		}
+		if err := c.MarshalInto(&(*t.Outs[i0]), p); err != nil {
+			return err
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start1 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	if len(t.Ins) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Ins)))
+	for i2 := range t.Ins {
+		start3 := p.Offset
+		if t.Ins[i2] == nil {
+			return codec.ErrMarshalNil
+		}
+		if err := c.MarshalInto(&(*t.Ins[i2]), p); err != nil {
+			return err
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start3 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	if len(t.Memo) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Memo)))
+	p.PackFixedBytes(t.Memo)
+	return p.Err
+}
+
+func (t *BaseTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	t.NetworkID = uint32(p.UnpackInt())
+	if p.Err != nil {
+		return p.Err
+	}
+	copy(t.BlockchainID[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Outs = make([]*TransferableOutput, 0, min(int(n0), 16))
+	for i1 := 0; i1 < int(n0); i1++ {
+		var elem3 *TransferableOutput
+		t.Outs = append(t.Outs, elem3)
+		start2 := p.Offset
+		t.Outs[i1] = new(TransferableOutput)
+		if err := c.UnmarshalFrom(p, &(*t.Outs[i1])); err != nil {
+			return err
+		}
+		if p.Offset == start2 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	n4 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n4 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Ins = make([]*TransferableInput, 0, min(int(n4), 16))
+	for i5 := 0; i5 < int(n4); i5++ {
+		var elem7 *TransferableInput
+		t.Ins = append(t.Ins, elem7)
+		start6 := p.Offset
+		t.Ins[i5] = new(TransferableInput)
+		if err := c.UnmarshalFrom(p, &(*t.Ins[i5])); err != nil {
+			return err
+		}
+		if p.Offset == start6 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	n8 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n8 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Memo = p.UnpackFixedBytes(int(n8))
+	if p.Err != nil {
+		return p.Err
+	}
+	return nil
+}
+
+func (t *BaseTx) CodecValue() interface{} {
+	return t
+}
@@ -3,6 +3,8 @@
 
 package avax
 
+//go:generate go run github.com/ava-labs/avalanchego/codec/codecgen/cmd $GOFILE
+
 import (
 	"errors"
 	"fmt"
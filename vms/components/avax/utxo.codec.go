@@ -0,0 +1,69 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: utxo.go
+
+package avax
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*UTXO)(nil)
+)
+
+func (t *UTXO) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.UTXOID)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	{
+		n, err := c.Size(&t.Asset)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	{
+		n, err := c.Size(&t.Out)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *UTXO) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.UTXOID, p); err != nil {
+		return err
+	}
+	if err := c.MarshalInto(&t.Asset, p); err != nil {
+		return err
+	}
+	if err := c.MarshalInto(&t.Out, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *UTXO) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.UTXOID); err != nil {
+		return err
+	}
+	if err := c.UnmarshalFrom(p, &t.Asset); err != nil {
+		return err
+	}
+	if err := c.UnmarshalFrom(p, &t.Out); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *UTXO) CodecValue() interface{} {
+	return t
+}
@@ -0,0 +1,42 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: utxo_id.go
+
+package avax
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*UTXOID)(nil)
+)
+
+func (t *UTXOID) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += 32
+	size += 4
+	return size, nil
+}
+
+func (t *UTXOID) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	p.PackFixedBytes(t.TxID[:])
+	p.PackInt(uint32(t.OutputIndex))
+	return p.Err
+}
+
+func (t *UTXOID) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	copy(t.TxID[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	t.OutputIndex = uint32(p.UnpackInt())
+	if p.Err != nil {
+		return p.Err
+	}
+	return nil
+}
+
+func (t *UTXOID) CodecValue() interface{} {
+	return t
+}
@@ -42,13 +42,17 @@ type blockMetrics struct {
 	parseStateSummary,
 	parseStateSummaryErr,
 	getStateSummary,
-	getStateSummaryErr metric.Averager
+	getStateSummaryErr,
+	// Pre-verification metrics
+	preVerify,
+	preVerifyErr metric.Averager
 }
 
 func (m *blockMetrics) Initialize(
 	supportsBlockBuildingWithContext bool,
 	supportsBatchedFetching bool,
 	supportsStateSync bool,
+	supportsPreVerification bool,
 	reg prometheus.Registerer,
 ) error {
 	errs := wrappers.Errs{}
@@ -86,5 +90,9 @@ func (m *blockMetrics) Initialize(
 		m.getStateSummary = newAverager("get_state_summary", reg, &errs)
 		m.getStateSummaryErr = newAverager("get_state_summary_err", reg, &errs)
 	}
+	if supportsPreVerification {
+		m.preVerify = newAverager("pre_verify", reg, &errs)
+		m.preVerifyErr = newAverager("pre_verify_err", reg, &errs)
+	}
 	return errs.Err
 }
@@ -22,6 +22,8 @@ var (
 	_ block.BuildBlockWithContextChainVM = (*blockVM)(nil)
 	_ block.BatchedChainVM               = (*blockVM)(nil)
 	_ block.StateSyncableVM              = (*blockVM)(nil)
+	_ block.StateSyncProgressVM          = (*blockVM)(nil)
+	_ block.PreVerifierChainVM           = (*blockVM)(nil)
 )
 
 type blockVM struct {
@@ -29,6 +31,7 @@ type blockVM struct {
 	buildBlockVM block.BuildBlockWithContextChainVM
 	batchedVM    block.BatchedChainVM
 	ssVM         block.StateSyncableVM
+	preVerifyVM  block.PreVerifierChainVM
 
 	blockMetrics
 	registry prometheus.Registerer
@@ -42,11 +45,13 @@ func NewBlockVM(
 	buildBlockVM, _ := vm.(block.BuildBlockWithContextChainVM)
 	batchedVM, _ := vm.(block.BatchedChainVM)
 	ssVM, _ := vm.(block.StateSyncableVM)
+	preVerifyVM, _ := vm.(block.PreVerifierChainVM)
 	return &blockVM{
 		ChainVM:      vm,
 		buildBlockVM: buildBlockVM,
 		batchedVM:    batchedVM,
 		ssVM:         ssVM,
+		preVerifyVM:  preVerifyVM,
 		registry:     reg,
 	}
 }
@@ -66,6 +71,7 @@ func (vm *blockVM) Initialize(
 		vm.buildBlockVM != nil,
 		vm.batchedVM != nil,
 		vm.ssVM != nil,
+		vm.preVerifyVM != nil,
 		vm.registry,
 	)
 	if err != nil {
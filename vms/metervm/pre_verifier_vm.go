@@ -0,0 +1,33 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package metervm
+
+import (
+	"context"
+
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+)
+
+func (vm *blockVM) PreVerify(ctx context.Context, blk snowman.Block) error {
+	if vm.preVerifyVM == nil {
+		return block.ErrPreVerifyNotImplemented
+	}
+
+	// Blocks may have been parsed by this VM or by the VM it wraps.
+	if meterBlk, ok := blk.(*meterBlock); ok {
+		blk = meterBlk.Block
+	}
+
+	start := vm.clock.Time()
+	err := vm.preVerifyVM.PreVerify(ctx, blk)
+	end := vm.clock.Time()
+	duration := float64(end.Sub(start))
+	if err != nil {
+		vm.blockMetrics.preVerifyErr.Observe(duration)
+	} else {
+		vm.blockMetrics.preVerify.Observe(duration)
+	}
+	return err
+}
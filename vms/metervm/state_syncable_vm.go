@@ -78,3 +78,12 @@ func (vm *blockVM) GetStateSummary(ctx context.Context, height uint64) (block.St
 	vm.blockMetrics.getStateSummary.Observe(duration)
 	return summary, nil
 }
+
+func (vm *blockVM) StateSyncProgress(ctx context.Context) (block.StateSyncProgress, error) {
+	progressVM, ok := vm.ChainVM.(block.StateSyncProgressVM)
+	if !ok {
+		return block.StateSyncProgress{}, block.ErrStateSyncProgressNotImplemented
+	}
+
+	return progressVM.StateSyncProgress(ctx)
+}
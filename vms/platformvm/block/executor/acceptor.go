@@ -6,13 +6,17 @@ package executor
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/events"
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators"
 )
 
@@ -30,6 +34,10 @@ type acceptor struct {
 	metrics      metrics.Metrics
 	validators   validators.Manager
 	bootstrapped *utils.Atomic[bool]
+
+	// publisher is notified of the events caused by accepted blocks. If nil,
+	// events are not built.
+	publisher events.Publisher
 }
 
 func (a *acceptor) BanffAbortBlock(b *block.BanffAbortBlock) error {
@@ -80,7 +88,8 @@ func (a *acceptor) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 	}
 
 	// Update the state to reflect the changes made in [onAcceptState].
-	if err := blkState.onAcceptState.Apply(a.state); err != nil {
+	chain, recorder := a.newChain()
+	if err := blkState.onAcceptState.Apply(chain); err != nil {
 		return err
 	}
 
@@ -104,6 +113,8 @@ func (a *acceptor) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 		)
 	}
 
+	a.publishEvents(recorder, b, blkState.timestamp, nil)
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", "apricot atomic"),
@@ -136,8 +147,9 @@ func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 		return err
 	}
 
+	chain, recorder := a.newChain()
 	if parentState.onDecisionState != nil {
-		if err := parentState.onDecisionState.Apply(a.state); err != nil {
+		if err := parentState.onDecisionState.Apply(chain); err != nil {
 			return err
 		}
 	}
@@ -151,7 +163,7 @@ func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 		return err
 	}
 
-	if err := blkState.onAcceptState.Apply(a.state); err != nil {
+	if err := blkState.onAcceptState.Apply(chain); err != nil {
 		return err
 	}
 
@@ -174,6 +186,8 @@ func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 		onAcceptFunc()
 	}
 
+	a.publishEvents(recorder, b, blkState.timestamp, parentState.statelessBlock)
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", blockType),
@@ -230,7 +244,8 @@ func (a *acceptor) standardBlock(b block.Block, blockType string) error {
 	}
 
 	// Update the state to reflect the changes made in [onAcceptState].
-	if err := blkState.onAcceptState.Apply(a.state); err != nil {
+	chain, recorder := a.newChain()
+	if err := blkState.onAcceptState.Apply(chain); err != nil {
 		return err
 	}
 
@@ -253,6 +268,8 @@ func (a *acceptor) standardBlock(b block.Block, blockType string) error {
 		onAcceptFunc()
 	}
 
+	a.publishEvents(recorder, b, blkState.timestamp, nil)
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", blockType),
@@ -280,3 +297,55 @@ func (a *acceptor) commonAccept(b *blockState) error {
 	a.validators.OnAcceptedBlockID(blkID)
 	return nil
 }
+
+// newChain returns the chain that the state diffs of accepted blocks should be
+// applied to. If events are being published, the returned recorder records the
+// changes that are applied. Otherwise, the returned recorder is nil.
+//
+// Events are not published while the chain is bootstrapping, so that
+// subscribers aren't sent the historical validator set changes of a syncing
+// node.
+func (a *acceptor) newChain() (state.Chain, *events.Recorder) {
+	if a.publisher == nil || !a.bootstrapped.Get() {
+		return a.state, nil
+	}
+	recorder := events.NewRecorder(a.state)
+	return recorder, recorder
+}
+
+// publishEvents publishes the events caused by the changes recorded while
+// accepting [b]. If [b] is an option block, [proposal] is its parent.
+func (a *acceptor) publishEvents(
+	recorder *events.Recorder,
+	b block.Block,
+	timestamp time.Time,
+	proposal block.Block,
+) {
+	if recorder == nil {
+		return
+	}
+
+	a.publisher.Publish(recorder.Events(events.BlockContext{
+		BlockID:      b.ID(),
+		Height:       b.Height(),
+		Timestamp:    timestamp,
+		RewardedTxID: rewardedTxID(b, proposal),
+	}))
+}
+
+// rewardedTxID returns the ID of the tx that added the staker that is rewarded
+// by accepting [b]. If no staker is rewarded, ids.Empty is returned.
+func rewardedTxID(b block.Block, proposal block.Block) ids.ID {
+	switch b.(type) {
+	case *block.BanffCommitBlock, *block.ApricotCommitBlock:
+	default:
+		return ids.Empty
+	}
+
+	blkTxs := proposal.Txs()
+	rewardTx, ok := blkTxs[len(blkTxs)-1].Unsigned.(*txs.RewardValidatorTx)
+	if !ok {
+		return ids.Empty
+	}
+	return rewardTx.TxID
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/events"
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -243,6 +244,141 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 	require.Equal(blk.ID(), acceptor.backend.lastAccepted)
 }
 
+type eventRecorder struct {
+	events []events.Event
+}
+
+func (r *eventRecorder) Publish(events []events.Event) {
+	r.events = append(r.events, events...)
+}
+
+func TestAcceptorPublishesEvents(t *testing.T) {
+	tests := []struct {
+		name         string
+		bootstrapped bool
+	}{
+		{
+			name:         "bootstrapped",
+			bootstrapped: true,
+		},
+		{
+			// Historical validator set changes shouldn't be published while
+			// the chain is bootstrapping.
+			name:         "bootstrapping",
+			bootstrapped: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			s := state.NewMockState(ctrl)
+			sharedMemory := atomicmock.NewSharedMemory(ctrl)
+			recorder := &eventRecorder{}
+
+			parentID := ids.GenerateTestID()
+			acceptor := &acceptor{
+				backend: &backend{
+					lastAccepted: parentID,
+					blkIDToState: make(map[ids.ID]*blockState),
+					state:        s,
+					ctx: &snow.Context{
+						Log:          logging.NoLog{},
+						SharedMemory: sharedMemory,
+					},
+				},
+				metrics:      metrics.Noop,
+				validators:   validatorstest.Manager,
+				bootstrapped: &utils.Atomic[bool]{},
+				publisher:    recorder,
+			}
+			acceptor.bootstrapped.Set(test.bootstrapped)
+
+			removeTx := &txs.Tx{
+				Unsigned: &txs.RemoveSubnetValidatorTx{
+					NodeID:     ids.GenerateTestNodeID(),
+					Subnet:     ids.GenerateTestID(),
+					SubnetAuth: &secp256k1fx.Input{},
+				},
+				Creds: []verify.Verifiable{},
+			}
+			utx := removeTx.Unsigned.(*txs.RemoveSubnetValidatorTx)
+			timestamp := time.Unix(1_000, 0)
+			removedStaker := &state.Staker{
+				TxID:      ids.GenerateTestID(),
+				NodeID:    utx.NodeID,
+				SubnetID:  utx.Subnet,
+				Weight:    1,
+				StartTime: time.Unix(100, 0),
+				EndTime:   time.Unix(2_000, 0),
+				Priority:  txs.SubnetPermissionedValidatorCurrentPriority,
+			}
+			blk, err := block.NewBanffStandardBlock(
+				timestamp,
+				parentID,
+				1,
+				[]*txs.Tx{removeTx},
+			)
+			require.NoError(err)
+
+			// Set [blk]'s state in the map as though it had been verified.
+			onAcceptState := state.NewMockDiff(ctrl)
+			atomicRequests := make(map[ids.ID]*atomic.Requests)
+			acceptor.backend.blkIDToState[blk.ID()] = &blockState{
+				statelessBlock: blk,
+				onAcceptState:  onAcceptState,
+				timestamp:      timestamp,
+				atomicRequests: atomicRequests,
+				metrics: metrics.Block{
+					Block: blk,
+				},
+			}
+
+			// Set expected calls on dependencies.
+			s.EXPECT().SetLastAccepted(blk.ID()).Times(1)
+			s.EXPECT().SetHeight(blk.Height()).Times(1)
+			s.EXPECT().AddStatelessBlock(blk).Times(1)
+			batch := databasemock.NewBatch(ctrl)
+			s.EXPECT().CommitBatch().Return(batch, nil).Times(1)
+			s.EXPECT().Abort().Times(1)
+			onAcceptState.EXPECT().Apply(gomock.Any()).DoAndReturn(func(chain state.Chain) error {
+				chain.DeleteCurrentValidator(removedStaker)
+				return nil
+			}).Times(1)
+			s.EXPECT().DeleteCurrentValidator(removedStaker).Times(1)
+			sharedMemory.EXPECT().Apply(atomicRequests, batch).Return(nil).Times(1)
+			s.EXPECT().Checksum().Return(ids.Empty).Times(1)
+
+			require.NoError(acceptor.BanffStandardBlock(blk))
+
+			if !test.bootstrapped {
+				require.Empty(recorder.events)
+				return
+			}
+			require.Equal(
+				[]events.Event{
+					{
+						Type:      events.StakerRemoved,
+						BlockID:   blk.ID(),
+						Height:    1,
+						Timestamp: timestamp,
+						Staker: &events.Staker{
+							TxID:      removedStaker.TxID,
+							SubnetID:  utx.Subnet,
+							NodeID:    utx.NodeID,
+							Weight:    1,
+							StartTime: 100,
+							EndTime:   2_000,
+						},
+					},
+				},
+				recorder.events,
+			)
+		})
+	}
+}
+
 func TestAcceptorVisitCommitBlock(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
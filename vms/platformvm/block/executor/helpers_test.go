@@ -165,6 +165,7 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller, f upgradetest.Fork) *
 			res.state,
 			res.backend,
 			validatorstest.Manager,
+			nil, // publisher
 		)
 		addSubnet(t, res)
 	} else {
@@ -174,6 +175,7 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller, f upgradetest.Fork) *
 			res.mockedState,
 			res.backend,
 			validatorstest.Manager,
+			nil, // publisher
 		)
 		// we do not add any subnet to state, since we can mock
 		// whatever we need
@@ -91,6 +91,10 @@ type Client interface {
 	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetTxStatus returns the status of the transaction corresponding to [txID]
 	GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (*GetTxStatusResponse, error)
+	// WaitForTx blocks until the transaction corresponding to [txID] is
+	// decided and [confirmations] blocks were accepted on top of it, or until
+	// [timeout] elapses. If [timeout] is 0, the node's default timeout is used.
+	WaitForTx(ctx context.Context, txID ids.ID, confirmations uint64, timeout time.Duration, options ...rpc.Option) (*WaitForTxResponse, error)
 	// GetStake returns the amount of nAVAX that [addrs] have cumulatively
 	// staked on the Primary Network.
 	//
@@ -482,6 +486,28 @@ func (c *client) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Op
 	return res, err
 }
 
+func (c *client) WaitForTx(
+	ctx context.Context,
+	txID ids.ID,
+	confirmations uint64,
+	timeout time.Duration,
+	options ...rpc.Option,
+) (*WaitForTxResponse, error) {
+	res := &WaitForTxResponse{}
+	err := c.requester.SendRequest(
+		ctx,
+		"platform.waitForTx",
+		&WaitForTxArgs{
+			TxID:          txID,
+			Confirmations: json.Uint64(confirmations),
+			Timeout:       json.Uint64(timeout.Milliseconds()),
+		},
+		res,
+		options...,
+	)
+	return res, err
+}
+
 func (c *client) GetStake(
 	ctx context.Context,
 	addrs []ids.ShortID,
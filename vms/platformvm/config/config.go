@@ -12,6 +12,7 @@ import (
 
 var Default = Config{
 	Network:                       DefaultNetwork,
+	Events:                        DefaultEvents,
 	BlockCacheSize:                64 * units.MiB,
 	TxCacheSize:                   128 * units.MiB,
 	TransformedSubnetTxCacheSize:  4 * units.MiB,
@@ -31,6 +32,7 @@ var Default = Config{
 // Config contains all of the user-configurable parameters of the PlatformVM.
 type Config struct {
 	Network                       Network       `json:"network"`
+	Events                        Events        `json:"events"`
 	BlockCacheSize                int           `json:"block-cache-size"`
 	TxCacheSize                   int           `json:"tx-cache-size"`
 	TransformedSubnetTxCacheSize  int           `json:"transformed-subnet-tx-cache-size"`
@@ -79,6 +79,15 @@ func TestConfigUnmarshal(t *testing.T) {
 				ExpectedBloomFilterFalsePositiveProbability: 16,
 				MaxBloomFilterFalsePositiveProbability:      17,
 			},
+			Events: Events{
+				Enabled:              true,
+				SubscriberBufferSize: 1,
+				WebhookURLs:          []string{"http://localhost:8080"},
+				WebhookQueueSize:     2,
+				WebhookTimeout:       3,
+				WebhookMaxRetries:    4,
+				WebhookRetryBackoff:  5,
+			},
 			BlockCacheSize:                1,
 			TxCacheSize:                   2,
 			TransformedSubnetTxCacheSize:  3,
@@ -96,6 +105,7 @@ func TestConfigUnmarshal(t *testing.T) {
 		}
 		verifyInitializedStruct(t, *expected)
 		verifyInitializedStruct(t, expected.Network)
+		verifyInitializedStruct(t, expected.Events)
 
 		b, err := json.Marshal(expected)
 		require.NoError(err)
@@ -0,0 +1,43 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package config
+
+import "time"
+
+var DefaultEvents = Events{
+	Enabled:              false,
+	SubscriberBufferSize: 1024,
+	WebhookURLs:          nil,
+	WebhookQueueSize:     1024,
+	WebhookTimeout:       10 * time.Second,
+	WebhookMaxRetries:    5,
+	WebhookRetryBackoff:  time.Second,
+}
+
+type Events struct {
+	// Enabled specifies whether validator set change events should be
+	// produced when blocks are accepted. Events are only produced for blocks
+	// accepted after the chain has finished bootstrapping. If false, neither
+	// the websocket endpoint nor the webhooks are enabled.
+	Enabled bool `json:"enabled"`
+	// SubscriberBufferSize is the number of events that can be buffered for a
+	// websocket subscriber. If a subscriber falls further behind than this, it
+	// is disconnected.
+	SubscriberBufferSize int `json:"subscriber-buffer-size"`
+	// WebhookURLs are the URLs that accepted events will be POSTed to.
+	WebhookURLs []string `json:"webhook-urls"`
+	// WebhookQueueSize is the number of batches of events that can be queued
+	// for a webhook. If the queue is full, new batches are dropped.
+	WebhookQueueSize int `json:"webhook-queue-size"`
+	// WebhookTimeout is the maximum amount of time a single webhook request
+	// may take.
+	WebhookTimeout time.Duration `json:"webhook-timeout"`
+	// WebhookMaxRetries is the number of times a failed webhook request will
+	// be retried before the batch of events is dropped.
+	WebhookMaxRetries int `json:"webhook-max-retries"`
+	// WebhookRetryBackoff is the amount of time to wait before the first
+	// retry of a failed webhook request. The backoff doubles after every
+	// failed attempt.
+	WebhookRetryBackoff time.Duration `json:"webhook-retry-backoff"`
+}
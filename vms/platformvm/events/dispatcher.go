@@ -0,0 +1,175 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+)
+
+var _ Publisher = (*Dispatcher)(nil)
+
+// Publisher is notified of the events caused by blocks accepted after the chain
+// has finished bootstrapping.
+//
+// Publish is called synchronously during block acceptance, so implementations
+// must not block.
+type Publisher interface {
+	Publish(events []Event)
+}
+
+// Dispatcher forwards published events to all current subscribers and
+// configured webhooks.
+type Dispatcher struct {
+	log        logging.Logger
+	bufferSize int
+
+	lock        sync.Mutex
+	subscribers set.Set[*Subscription]
+
+	webhooks []*webhook
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func NewDispatcher(log logging.Logger, config config.Events) *Dispatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &Dispatcher{
+		log:         log,
+		bufferSize:  config.SubscriberBufferSize,
+		subscribers: set.Set[*Subscription]{},
+		cancel:      cancel,
+	}
+
+	client := &http.Client{
+		Timeout: config.WebhookTimeout,
+	}
+	for _, url := range config.WebhookURLs {
+		w := &webhook{
+			log:          log,
+			client:       client,
+			url:          url,
+			queue:        make(chan []Event, config.WebhookQueueSize),
+			maxRetries:   config.WebhookMaxRetries,
+			retryBackoff: config.WebhookRetryBackoff,
+		}
+		d.webhooks = append(d.webhooks, w)
+
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			w.run(ctx)
+		}()
+	}
+	return d
+}
+
+func (d *Dispatcher) Publish(events []Event) {
+	if len(events) == 0 {
+		return
+	}
+
+	d.lock.Lock()
+	for sub := range d.subscribers {
+		if !sub.send(events) {
+			d.log.Debug("dropping slow events subscriber")
+			d.unsubscribe(sub)
+		}
+	}
+	d.lock.Unlock()
+
+	for _, w := range d.webhooks {
+		select {
+		case w.queue <- events:
+		default:
+			w.log.Warn("dropping events for webhook",
+				zap.String("reason", "queue is full"),
+				zap.String("url", w.url),
+				zap.Int("numEvents", len(events)),
+			)
+		}
+	}
+}
+
+// Subscribe registers a new subscriber that will be sent all events with one
+// of the provided [types]. If [types] is empty, all events are sent.
+func (d *Dispatcher) Subscribe(types set.Set[Type]) *Subscription {
+	sub := &Subscription{
+		types:  types,
+		events: make(chan Event, d.bufferSize),
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.subscribers.Add(sub)
+	return sub
+}
+
+// Unsubscribe removes [sub] and closes its events channel. It is safe to call
+// Unsubscribe multiple times.
+func (d *Dispatcher) Unsubscribe(sub *Subscription) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.unsubscribe(sub)
+}
+
+func (d *Dispatcher) unsubscribe(sub *Subscription) {
+	if !d.subscribers.Contains(sub) {
+		return
+	}
+	d.subscribers.Remove(sub)
+	close(sub.events)
+}
+
+// Shutdown disconnects all subscribers and stops delivering events to the
+// webhooks. Any events that have not yet been delivered to a webhook are
+// dropped.
+func (d *Dispatcher) Shutdown() {
+	d.lock.Lock()
+	for sub := range d.subscribers {
+		d.unsubscribe(sub)
+	}
+	d.lock.Unlock()
+
+	d.cancel()
+	d.wg.Wait()
+}
+
+type Subscription struct {
+	types  set.Set[Type]
+	events chan Event
+}
+
+// Events returns the channel that events are delivered on. The channel is
+// closed once the subscription is removed, which happens if the subscriber
+// can not keep up with the published events.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// send attempts to enqueue [events] without blocking. Returns false if the
+// subscriber's buffer is full.
+func (s *Subscription) send(events []Event) bool {
+	for _, event := range events {
+		if s.types.Len() != 0 && !s.types.Contains(event.Type) {
+			continue
+		}
+
+		select {
+		case s.events <- event:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+)
+
+func newTestConfig() config.Events {
+	c := config.DefaultEvents
+	c.Enabled = true
+	c.SubscriberBufferSize = 2
+	c.WebhookRetryBackoff = time.Millisecond
+	return c
+}
+
+func TestDispatcherSubscribe(t *testing.T) {
+	require := require.New(t)
+
+	d := NewDispatcher(logging.NoLog{}, newTestConfig())
+	defer d.Shutdown()
+
+	all := d.Subscribe(nil)
+	filtered := d.Subscribe(set.Of(StakerRemoved))
+
+	added := Event{
+		Type:    StakerAdded,
+		BlockID: ids.GenerateTestID(),
+	}
+	removed := Event{
+		Type:    StakerRemoved,
+		BlockID: ids.GenerateTestID(),
+	}
+	d.Publish([]Event{added, removed})
+
+	require.Equal(added, <-all.Events())
+	require.Equal(removed, <-all.Events())
+	require.Equal(removed, <-filtered.Events())
+
+	d.Unsubscribe(filtered)
+	_, ok := <-filtered.Events()
+	require.False(ok)
+
+	// Unsubscribing multiple times should be a noop.
+	d.Unsubscribe(filtered)
+}
+
+func TestDispatcherDropsSlowSubscriber(t *testing.T) {
+	require := require.New(t)
+
+	d := NewDispatcher(logging.NoLog{}, newTestConfig())
+	defer d.Shutdown()
+
+	sub := d.Subscribe(nil)
+	event := Event{
+		Type: StakerAdded,
+	}
+	d.Publish([]Event{event, event, event})
+
+	require.Equal(event, <-sub.Events())
+	require.Equal(event, <-sub.Events())
+	_, ok := <-sub.Events()
+	require.False(ok)
+}
+
+func TestDispatcherWebhookRetries(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		attempts int
+		received = make(chan []Event, 1)
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var events []Event
+		if err := json.NewDecoder(r.Body).Decode(&events); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- events
+	}))
+	defer server.Close()
+
+	c := newTestConfig()
+	c.WebhookURLs = []string{server.URL}
+	d := NewDispatcher(logging.NoLog{}, c)
+	defer d.Shutdown()
+
+	event := Event{
+		Type:      StakerAdded,
+		BlockID:   ids.GenerateTestID(),
+		Height:    5,
+		Timestamp: time.Unix(1_000, 0).UTC(),
+		Staker: &Staker{
+			TxID:   ids.GenerateTestID(),
+			NodeID: ids.GenerateTestNodeID(),
+		},
+	}
+	d.Publish([]Event{event})
+
+	require.Equal([]Event{event}, <-received)
+	require.Equal(3, attempts)
+}
+
+func TestParseTypes(t *testing.T) {
+	require := require.New(t)
+
+	types, err := ParseTypes("")
+	require.NoError(err)
+	require.Empty(types)
+
+	types, err = ParseTypes("stakerAdded, l1ValidatorDisabled")
+	require.NoError(err)
+	require.Equal(set.Of(StakerAdded, L1ValidatorDisabled), types)
+
+	_, err = ParseTypes("stakerAdded,unknown")
+	require.ErrorIs(err, errUnknownType)
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/types"
+)
+
+const (
+	// StakerAdded is emitted when a primary network or subnet validator or
+	// delegator is added to the current staker set. Stakers that are
+	// scheduled to start in the future are reported once they start.
+	StakerAdded Type = "stakerAdded"
+	// StakerRemoved is emitted when a staker is removed from the current
+	// staker set, either because its staking period ended or because it was
+	// removed by the subnet owner.
+	StakerRemoved Type = "stakerRemoved"
+	// L1ValidatorRegistered is emitted when a validator is registered to an
+	// L1.
+	L1ValidatorRegistered Type = "l1ValidatorRegistered"
+	// L1ValidatorWeightChanged is emitted when the weight of an L1 validator
+	// is modified to a non-zero value.
+	L1ValidatorWeightChanged Type = "l1ValidatorWeightChanged"
+	// L1ValidatorDisabled is emitted when an L1 validator becomes inactive,
+	// either because it was disabled by its disable owner or because its
+	// balance was exhausted.
+	L1ValidatorDisabled Type = "l1ValidatorDisabled"
+	// L1ValidatorRemoved is emitted when an L1 validator is removed, which
+	// happens when its weight is set to 0.
+	L1ValidatorRemoved Type = "l1ValidatorRemoved"
+	// SubnetConverted is emitted when a subnet is converted into an L1.
+	SubnetConverted Type = "subnetConverted"
+)
+
+// Types is the set of all event types.
+var Types = set.Of(
+	StakerAdded,
+	StakerRemoved,
+	L1ValidatorRegistered,
+	L1ValidatorWeightChanged,
+	L1ValidatorDisabled,
+	L1ValidatorRemoved,
+	SubnetConverted,
+)
+
+// Type identifies the kind of validator set change an Event describes.
+type Type string
+
+// Event describes a change to a validator set that occurred as a result of a
+// block being accepted.
+//
+// Exactly one of Staker, L1Validator, or Conversion is populated, depending on
+// the event's Type.
+type Event struct {
+	Type Type `json:"type"`
+	// BlockID is the ID of the accepted block that caused the event.
+	BlockID ids.ID `json:"blockID"`
+	// Height is the height of the accepted block that caused the event.
+	Height json.Uint64 `json:"height"`
+	// Timestamp is the chain time at which the event occurred.
+	Timestamp time.Time `json:"timestamp"`
+
+	Staker      *Staker      `json:"staker,omitempty"`
+	L1Validator *L1Validator `json:"l1Validator,omitempty"`
+	Conversion  *Conversion  `json:"conversion,omitempty"`
+}
+
+type Staker struct {
+	// TxID is the ID of the transaction that added the staker.
+	TxID      ids.ID      `json:"txID"`
+	SubnetID  ids.ID      `json:"subnetID"`
+	NodeID    ids.NodeID  `json:"nodeID"`
+	Delegator bool        `json:"delegator"`
+	Weight    json.Uint64 `json:"weight"`
+	StartTime json.Uint64 `json:"startTime"`
+	EndTime   json.Uint64 `json:"endTime"`
+
+	// Rewarded and PotentialReward are only populated for StakerRemoved
+	// events. Rewarded reports whether the staker received PotentialReward.
+	Rewarded        bool        `json:"rewarded"`
+	PotentialReward json.Uint64 `json:"potentialReward"`
+}
+
+type L1Validator struct {
+	ValidationID ids.ID      `json:"validationID"`
+	SubnetID     ids.ID      `json:"subnetID"`
+	NodeID       ids.NodeID  `json:"nodeID"`
+	Weight       json.Uint64 `json:"weight"`
+	// Nonce is only populated for L1ValidatorWeightChanged events. It is the
+	// nonce of the message that changed the weight.
+	Nonce json.Uint64 `json:"nonce"`
+}
+
+type Conversion struct {
+	SubnetID   ids.ID              `json:"subnetID"`
+	ChainID    ids.ID              `json:"chainID"`
+	Address    types.JSONByteSlice `json:"address"`
+	Validators []L1Validator       `json:"validators"`
+}
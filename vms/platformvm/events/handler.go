@@ -0,0 +1,135 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+const (
+	// TypesQueryParam is the query parameter that can be used to limit the
+	// events sent to a subscriber. Its value is a comma separated list of
+	// event types.
+	TypesQueryParam = "types"
+
+	writeTimeout = 10 * time.Second
+	pingPeriod   = 30 * time.Second
+	pongTimeout  = pingPeriod + writeTimeout
+)
+
+var (
+	_ http.Handler = (*handler)(nil)
+
+	errUnknownType = errors.New("unknown event type")
+)
+
+type handler struct {
+	log        logging.Logger
+	dispatcher *Dispatcher
+	upgrader   websocket.Upgrader
+}
+
+// NewHandler returns an http.Handler that upgrades requests to websocket
+// connections and streams events from [dispatcher] over them as JSON.
+func NewHandler(log logging.Logger, dispatcher *Dispatcher) http.Handler {
+	return &handler{
+		log:        log,
+		dispatcher: dispatcher,
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	types, err := ParseTypes(r.URL.Query().Get(TypesQueryParam))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade has already written an error response.
+		h.log.Debug("failed to upgrade events connection",
+			zap.Error(err),
+		)
+		return
+	}
+	defer conn.Close()
+
+	sub := h.dispatcher.Subscribe(types)
+	defer h.dispatcher.Unsubscribe(sub)
+
+	// The connection must be read from to process control messages. No
+	// messages are expected from the client, so any read error, including
+	// the client closing the connection, terminates the subscription.
+	closed := make(chan struct{})
+	_ = conn.SetReadDeadline(time.Now().Add(pongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongTimeout))
+	})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	pingTicker := time.NewTicker(pingPeriod)
+	defer pingTicker.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case <-pingTicker.C:
+			deadline := time.Now().Add(writeTimeout)
+			if err := conn.WriteControl(websocket.PingMessage, nil, deadline); err != nil {
+				return
+			}
+		case event, ok := <-sub.Events():
+			if !ok {
+				deadline := time.Now().Add(writeTimeout)
+				msg := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "subscription closed")
+				_ = conn.WriteControl(websocket.CloseMessage, msg, deadline)
+				return
+			}
+
+			_ = conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if err := conn.WriteJSON(event); err != nil {
+				h.log.Debug("failed to write event",
+					zap.Error(err),
+				)
+				return
+			}
+		}
+	}
+}
+
+// ParseTypes parses a comma separated list of event types.
+func ParseTypes(s string) (set.Set[Type], error) {
+	types := set.Set[Type]{}
+	if s == "" {
+		return types, nil
+	}
+
+	for _, typeStr := range strings.Split(s, ",") {
+		t := Type(strings.TrimSpace(typeStr))
+		if !Types.Contains(t) {
+			return nil, fmt.Errorf("%w: %q", errUnknownType, t)
+		}
+		types.Add(t)
+	}
+	return types, nil
+}
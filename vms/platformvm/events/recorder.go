@@ -0,0 +1,241 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+)
+
+var _ state.Chain = (*Recorder)(nil)
+
+// BlockContext describes the accepted block that events are being built for.
+type BlockContext struct {
+	BlockID   ids.ID
+	Height    uint64
+	Timestamp time.Time
+	// RewardedTxID is the ID of the tx that added the staker that received
+	// its potential reward in the block, if any.
+	RewardedTxID ids.ID
+}
+
+// Recorder is a [state.Chain] that records the validator set changes that are
+// made to it. Changes are forwarded to the wrapped chain.
+//
+// Applying the state diff of an accepted block to a Recorder captures every
+// change made by the block, including changes made by advancing the chain
+// time, such as pending stakers becoming current and L1 validators being
+// deactivated.
+type Recorder struct {
+	state.Chain
+
+	addedStakers   []*state.Staker
+	removedStakers []*state.Staker
+	l1Validators   []l1ValidatorChange
+	conversions    map[ids.ID]state.SubnetToL1Conversion
+}
+
+type l1ValidatorChange struct {
+	// previous is nil if the validator didn't previously exist.
+	previous *state.L1Validator
+	current  state.L1Validator
+}
+
+func NewRecorder(chain state.Chain) *Recorder {
+	return &Recorder{
+		Chain:       chain,
+		conversions: make(map[ids.ID]state.SubnetToL1Conversion),
+	}
+}
+
+func (r *Recorder) PutCurrentValidator(staker *state.Staker) error {
+	if err := r.Chain.PutCurrentValidator(staker); err != nil {
+		return err
+	}
+	r.addedStakers = append(r.addedStakers, staker)
+	return nil
+}
+
+func (r *Recorder) DeleteCurrentValidator(staker *state.Staker) {
+	r.Chain.DeleteCurrentValidator(staker)
+	r.removedStakers = append(r.removedStakers, staker)
+}
+
+func (r *Recorder) PutCurrentDelegator(staker *state.Staker) {
+	r.Chain.PutCurrentDelegator(staker)
+	r.addedStakers = append(r.addedStakers, staker)
+}
+
+func (r *Recorder) DeleteCurrentDelegator(staker *state.Staker) {
+	r.Chain.DeleteCurrentDelegator(staker)
+	r.removedStakers = append(r.removedStakers, staker)
+}
+
+func (r *Recorder) PutL1Validator(l1Validator state.L1Validator) error {
+	change := l1ValidatorChange{
+		current: l1Validator,
+	}
+	previous, err := r.Chain.GetL1Validator(l1Validator.ValidationID)
+	switch {
+	case err == nil:
+		change.previous = &previous
+	case !errors.Is(err, database.ErrNotFound):
+		return err
+	}
+
+	if err := r.Chain.PutL1Validator(l1Validator); err != nil {
+		return err
+	}
+	r.l1Validators = append(r.l1Validators, change)
+	return nil
+}
+
+func (r *Recorder) SetSubnetToL1Conversion(subnetID ids.ID, c state.SubnetToL1Conversion) {
+	r.Chain.SetSubnetToL1Conversion(subnetID, c)
+	r.conversions[subnetID] = c
+}
+
+// Events returns the events caused by the recorded changes.
+//
+// The events are sorted by type and then by the ID of the changed validator,
+// so that every node reports the same events in the same order.
+func (r *Recorder) Events(blkCtx BlockContext) []Event {
+	events := make([]Event, 0, len(r.addedStakers)+len(r.removedStakers)+len(r.l1Validators)+len(r.conversions))
+	for _, staker := range r.addedStakers {
+		events = append(events, Event{
+			Type:   StakerAdded,
+			Staker: newStaker(staker),
+		})
+	}
+	for _, staker := range r.removedStakers {
+		s := newStaker(staker)
+		s.Rewarded = staker.TxID == blkCtx.RewardedTxID
+		s.PotentialReward = json.Uint64(staker.PotentialReward)
+		events = append(events, Event{
+			Type:   StakerRemoved,
+			Staker: s,
+		})
+	}
+
+	// Validators registered during a conversion are reported with the
+	// conversion.
+	conversionValidators := make(map[ids.ID][]L1Validator)
+	for _, change := range r.l1Validators {
+		var (
+			current   = change.current
+			validator = &L1Validator{
+				ValidationID: current.ValidationID,
+				SubnetID:     current.SubnetID,
+				NodeID:       current.NodeID,
+				Weight:       json.Uint64(current.Weight),
+			}
+		)
+		switch {
+		case change.previous == nil && current.Weight == 0:
+			// The validator was registered and removed in the same block.
+		case change.previous == nil:
+			if _, ok := r.conversions[current.SubnetID]; ok {
+				conversionValidators[current.SubnetID] = append(conversionValidators[current.SubnetID], *validator)
+				continue
+			}
+			events = append(events, Event{
+				Type:        L1ValidatorRegistered,
+				L1Validator: validator,
+			})
+		case current.Weight == 0:
+			events = append(events, Event{
+				Type:        L1ValidatorRemoved,
+				L1Validator: validator,
+			})
+		case current.Weight != change.previous.Weight:
+			if current.MinNonce > 0 {
+				validator.Nonce = json.Uint64(current.MinNonce - 1)
+			}
+			events = append(events, Event{
+				Type:        L1ValidatorWeightChanged,
+				L1Validator: validator,
+			})
+		case change.previous.IsActive() && !current.IsActive():
+			events = append(events, Event{
+				Type:        L1ValidatorDisabled,
+				L1Validator: validator,
+			})
+		}
+	}
+	for subnetID, c := range r.conversions {
+		validators := conversionValidators[subnetID]
+		slices.SortFunc(validators, func(a, b L1Validator) int {
+			return a.ValidationID.Compare(b.ValidationID)
+		})
+		events = append(events, Event{
+			Type: SubnetConverted,
+			Conversion: &Conversion{
+				SubnetID:   subnetID,
+				ChainID:    c.ChainID,
+				Address:    c.Addr,
+				Validators: validators,
+			},
+		})
+	}
+
+	for i := range events {
+		events[i].BlockID = blkCtx.BlockID
+		events[i].Height = json.Uint64(blkCtx.Height)
+		events[i].Timestamp = blkCtx.Timestamp
+	}
+	slices.SortFunc(events, compareEvents)
+	return events
+}
+
+func newStaker(staker *state.Staker) *Staker {
+	return &Staker{
+		TxID:      staker.TxID,
+		SubnetID:  staker.SubnetID,
+		NodeID:    staker.NodeID,
+		Delegator: staker.Priority.IsDelegator(),
+		Weight:    json.Uint64(staker.Weight),
+		StartTime: json.Uint64(staker.StartTime.Unix()),
+		EndTime:   json.Uint64(staker.EndTime.Unix()),
+	}
+}
+
+func compareEvents(a, b Event) int {
+	if c := slices.Index(typeOrder, a.Type) - slices.Index(typeOrder, b.Type); c != 0 {
+		return c
+	}
+	return bytes.Compare(a.key(), b.key())
+}
+
+// typeOrder is the order in which events of each type are reported for a
+// block.
+var typeOrder = []Type{
+	SubnetConverted,
+	L1ValidatorRegistered,
+	L1ValidatorWeightChanged,
+	L1ValidatorDisabled,
+	L1ValidatorRemoved,
+	StakerRemoved,
+	StakerAdded,
+}
+
+// key returns the ID of the validator changed by the event.
+func (e *Event) key() []byte {
+	switch {
+	case e.Staker != nil:
+		return e.Staker.TxID[:]
+	case e.L1Validator != nil:
+		return e.L1Validator.ValidationID[:]
+	case e.Conversion != nil:
+		return e.Conversion.SubnetID[:]
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state/statetest"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+func newSubnetStaker(priority txs.Priority) *state.Staker {
+	return &state.Staker{
+		TxID:      ids.GenerateTestID(),
+		NodeID:    ids.GenerateTestNodeID(),
+		SubnetID:  ids.GenerateTestID(),
+		Weight:    5,
+		StartTime: time.Unix(1_000, 0),
+		EndTime:   time.Unix(2_000, 0),
+		NextTime:  time.Unix(2_000, 0),
+		Priority:  priority,
+	}
+}
+
+func newL1Validator(t *testing.T, subnetID ids.ID) state.L1Validator {
+	sk, err := localsigner.New()
+	require.NoError(t, err)
+
+	return state.L1Validator{
+		ValidationID:          ids.GenerateTestID(),
+		SubnetID:              subnetID,
+		NodeID:                ids.GenerateTestNodeID(),
+		PublicKey:             bls.PublicKeyToUncompressedBytes(sk.PublicKey()),
+		RemainingBalanceOwner: []byte{},
+		DeactivationOwner:     []byte{},
+		Weight:                10,
+		EndAccumulatedFee:     100,
+	}
+}
+
+func TestRecorderEvents(t *testing.T) {
+	var (
+		pendingStaker = newSubnetStaker(txs.SubnetPermissionedValidatorPendingPriority)
+		currentStaker = newSubnetStaker(txs.SubnetPermissionedValidatorCurrentPriority)
+
+		promotedStaker = *pendingStaker
+		l1Validator    = newL1Validator(t, ids.GenerateTestID())
+
+		convertedSubnetID = ids.GenerateTestID()
+		conversion        = state.SubnetToL1Conversion{
+			ConversionID: ids.GenerateTestID(),
+			ChainID:      ids.GenerateTestID(),
+			Addr:         []byte{1, 2, 3},
+		}
+		conversionValidator = newL1Validator(t, convertedSubnetID)
+	)
+	promotedStaker.Priority = txs.SubnetPermissionedValidatorCurrentPriority
+
+	tests := []struct {
+		name         string
+		initial      func(*require.Assertions, state.Chain)
+		diff         func(*require.Assertions, state.Diff)
+		rewardedTxID ids.ID
+		expected     []Event
+	}{
+		{
+			name: "pending staker added",
+			diff: func(require *require.Assertions, d state.Diff) {
+				require.NoError(d.PutPendingValidator(pendingStaker))
+			},
+			expected: []Event{},
+		},
+		{
+			name: "pending staker promoted",
+			initial: func(require *require.Assertions, s state.Chain) {
+				require.NoError(s.PutPendingValidator(pendingStaker))
+			},
+			diff: func(require *require.Assertions, d state.Diff) {
+				d.DeletePendingValidator(pendingStaker)
+				require.NoError(d.PutCurrentValidator(&promotedStaker))
+			},
+			expected: []Event{
+				{
+					Type: StakerAdded,
+					Staker: &Staker{
+						TxID:      pendingStaker.TxID,
+						SubnetID:  pendingStaker.SubnetID,
+						NodeID:    pendingStaker.NodeID,
+						Weight:    5,
+						StartTime: 1_000,
+						EndTime:   2_000,
+					},
+				},
+			},
+		},
+		{
+			name: "staker removed",
+			initial: func(require *require.Assertions, s state.Chain) {
+				require.NoError(s.PutCurrentValidator(currentStaker))
+			},
+			diff: func(_ *require.Assertions, d state.Diff) {
+				d.DeleteCurrentValidator(currentStaker)
+			},
+			expected: []Event{
+				{
+					Type: StakerRemoved,
+					Staker: &Staker{
+						TxID:      currentStaker.TxID,
+						SubnetID:  currentStaker.SubnetID,
+						NodeID:    currentStaker.NodeID,
+						Weight:    5,
+						StartTime: 1_000,
+						EndTime:   2_000,
+					},
+				},
+			},
+		},
+		{
+			name: "staker rewarded",
+			initial: func(require *require.Assertions, s state.Chain) {
+				require.NoError(s.PutCurrentValidator(currentStaker))
+			},
+			diff: func(_ *require.Assertions, d state.Diff) {
+				d.DeleteCurrentValidator(currentStaker)
+			},
+			rewardedTxID: currentStaker.TxID,
+			expected: []Event{
+				{
+					Type: StakerRemoved,
+					Staker: &Staker{
+						TxID:      currentStaker.TxID,
+						SubnetID:  currentStaker.SubnetID,
+						NodeID:    currentStaker.NodeID,
+						Weight:    5,
+						StartTime: 1_000,
+						EndTime:   2_000,
+						Rewarded:  true,
+					},
+				},
+			},
+		},
+		{
+			name: "l1 validator registered",
+			diff: func(require *require.Assertions, d state.Diff) {
+				require.NoError(d.PutL1Validator(l1Validator))
+			},
+			expected: []Event{
+				{
+					Type: L1ValidatorRegistered,
+					L1Validator: &L1Validator{
+						ValidationID: l1Validator.ValidationID,
+						SubnetID:     l1Validator.SubnetID,
+						NodeID:       l1Validator.NodeID,
+						Weight:       10,
+					},
+				},
+			},
+		},
+		{
+			name: "l1 validator weight changed",
+			initial: func(require *require.Assertions, s state.Chain) {
+				require.NoError(s.PutL1Validator(l1Validator))
+			},
+			diff: func(require *require.Assertions, d state.Diff) {
+				modified := l1Validator
+				modified.Weight = 20
+				modified.MinNonce = 3
+				require.NoError(d.PutL1Validator(modified))
+			},
+			expected: []Event{
+				{
+					Type: L1ValidatorWeightChanged,
+					L1Validator: &L1Validator{
+						ValidationID: l1Validator.ValidationID,
+						SubnetID:     l1Validator.SubnetID,
+						NodeID:       l1Validator.NodeID,
+						Weight:       20,
+						Nonce:        2,
+					},
+				},
+			},
+		},
+		{
+			name: "l1 validator removed",
+			initial: func(require *require.Assertions, s state.Chain) {
+				require.NoError(s.PutL1Validator(l1Validator))
+			},
+			diff: func(require *require.Assertions, d state.Diff) {
+				removed := l1Validator
+				removed.Weight = 0
+				require.NoError(d.PutL1Validator(removed))
+			},
+			expected: []Event{
+				{
+					Type: L1ValidatorRemoved,
+					L1Validator: &L1Validator{
+						ValidationID: l1Validator.ValidationID,
+						SubnetID:     l1Validator.SubnetID,
+						NodeID:       l1Validator.NodeID,
+					},
+				},
+			},
+		},
+		{
+			name: "l1 validator balance exhausted",
+			initial: func(require *require.Assertions, s state.Chain) {
+				require.NoError(s.PutL1Validator(l1Validator))
+			},
+			diff: func(require *require.Assertions, d state.Diff) {
+				deactivated := l1Validator
+				deactivated.EndAccumulatedFee = 0
+				require.NoError(d.PutL1Validator(deactivated))
+			},
+			expected: []Event{
+				{
+					Type: L1ValidatorDisabled,
+					L1Validator: &L1Validator{
+						ValidationID: l1Validator.ValidationID,
+						SubnetID:     l1Validator.SubnetID,
+						NodeID:       l1Validator.NodeID,
+						Weight:       10,
+					},
+				},
+			},
+		},
+		{
+			name: "subnet converted",
+			diff: func(require *require.Assertions, d state.Diff) {
+				d.SetSubnetToL1Conversion(convertedSubnetID, conversion)
+				require.NoError(d.PutL1Validator(conversionValidator))
+			},
+			expected: []Event{
+				{
+					Type: SubnetConverted,
+					Conversion: &Conversion{
+						SubnetID: convertedSubnetID,
+						ChainID:  conversion.ChainID,
+						Address:  conversion.Addr,
+						Validators: []L1Validator{
+							{
+								ValidationID: conversionValidator.ValidationID,
+								SubnetID:     convertedSubnetID,
+								NodeID:       conversionValidator.NodeID,
+								Weight:       10,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			s := statetest.New(t, statetest.Config{})
+			if test.initial != nil {
+				test.initial(require, s)
+			}
+
+			d, err := state.NewDiffOn(s)
+			require.NoError(err)
+			test.diff(require, d)
+
+			recorder := NewRecorder(s)
+			require.NoError(d.Apply(recorder))
+
+			blkCtx := BlockContext{
+				BlockID:      ids.GenerateTestID(),
+				Height:       10,
+				Timestamp:    time.Unix(1_500, 0),
+				RewardedTxID: test.rewardedTxID,
+			}
+			for i := range test.expected {
+				test.expected[i].BlockID = blkCtx.BlockID
+				test.expected[i].Height = 10
+				test.expected[i].Timestamp = blkCtx.Timestamp
+			}
+			require.Equal(test.expected, recorder.Events(blkCtx))
+		})
+	}
+}
+
+func TestRecorderEventsOrder(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		s             = statetest.New(t, statetest.Config{})
+		removedStaker = newSubnetStaker(txs.SubnetPermissionedValidatorCurrentPriority)
+		addedStaker   = newSubnetStaker(txs.SubnetPermissionedValidatorCurrentPriority)
+		l1Validator   = newL1Validator(t, ids.GenerateTestID())
+	)
+	require.NoError(s.PutCurrentValidator(removedStaker))
+
+	d, err := state.NewDiffOn(s)
+	require.NoError(err)
+	require.NoError(d.PutCurrentValidator(addedStaker))
+	d.DeleteCurrentValidator(removedStaker)
+	require.NoError(d.PutL1Validator(l1Validator))
+
+	recorder := NewRecorder(s)
+	require.NoError(d.Apply(recorder))
+
+	events := recorder.Events(BlockContext{})
+	types := make([]Type, len(events))
+	for i, event := range events {
+		types[i] = event.Type
+	}
+	require.Equal(
+		[]Type{
+			L1ValidatorRegistered,
+			StakerRemoved,
+			StakerAdded,
+		},
+		types,
+	)
+}
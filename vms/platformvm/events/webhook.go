@@ -0,0 +1,113 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+// webhook POSTs batches of events, encoded as a JSON array, to a URL.
+type webhook struct {
+	log          logging.Logger
+	client       *http.Client
+	url          string
+	queue        chan []Event
+	maxRetries   int
+	retryBackoff time.Duration
+}
+
+func (w *webhook) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case events := <-w.queue:
+			w.deliver(ctx, events)
+		}
+	}
+}
+
+// deliver attempts to POST [events] until it succeeds, the maximum number of
+// retries is exhausted, or [ctx] is cancelled.
+func (w *webhook) deliver(ctx context.Context, events []Event) {
+	body, err := json.Marshal(events)
+	if err != nil {
+		w.log.Error("failed to marshal events",
+			zap.Error(err),
+		)
+		return
+	}
+
+	backoff := w.retryBackoff
+	for attempt := 0; ; attempt++ {
+		err := w.post(ctx, body)
+		if err == nil {
+			return
+		}
+		if attempt >= w.maxRetries {
+			w.log.Warn("dropping events for webhook",
+				zap.String("reason", "retries exhausted"),
+				zap.String("url", w.url),
+				zap.Int("numEvents", len(events)),
+				zap.Error(err),
+			)
+			return
+		}
+
+		w.log.Debug("failed to deliver events to webhook",
+			zap.String("url", w.url),
+			zap.Int("attempt", attempt),
+			zap.Duration("backoff", backoff),
+			zap.Error(err),
+		)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+func (w *webhook) post(ctx context.Context, body []byte) error {
+	request, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		w.url,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to issue request: %w", err)
+	}
+
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if err := resp.Body.Close(); err != nil {
+		return fmt.Errorf("failed to close response body: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("received status code: %d", resp.StatusCode)
+	}
+	return nil
+}
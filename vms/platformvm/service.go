@@ -28,6 +28,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
@@ -38,6 +39,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators/fee"
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp/message"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/vms/txs/waiter"
 	"github.com/ava-labs/avalanchego/vms/types"
 
 	avajson "github.com/ava-labs/avalanchego/utils/json"
@@ -1488,7 +1490,12 @@ func (s *Service) GetTxStatus(_ *http.Request, args *GetTxStatusArgs, response *
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
-	_, txStatus, err := s.vm.state.GetTx(args.TxID)
+	return s.getTxStatus(args.TxID, response)
+}
+
+// getTxStatus assumes the context lock is held.
+func (s *Service) getTxStatus(txID ids.ID, response *GetTxStatusResponse) error {
+	_, txStatus, err := s.vm.state.GetTx(txID)
 	if err == nil { // Found the status. Report it.
 		response.Status = txStatus
 		return nil
@@ -1505,7 +1512,7 @@ func (s *Service) GetTxStatus(_ *http.Request, args *GetTxStatusArgs, response *
 		return fmt.Errorf("could not retrieve state for block %s", preferredID)
 	}
 
-	_, _, err = onAccept.GetTx(args.TxID)
+	_, _, err = onAccept.GetTx(txID)
 	if err == nil {
 		// Found the status in the preferred block's db. Report tx is processing.
 		response.Status = status.Processing
@@ -1515,7 +1522,7 @@ func (s *Service) GetTxStatus(_ *http.Request, args *GetTxStatusArgs, response *
 		return err
 	}
 
-	if _, ok := s.vm.Builder.Get(args.TxID); ok {
+	if _, ok := s.vm.Builder.Get(txID); ok {
 		// Found the tx in the mempool. Report tx is processing.
 		response.Status = status.Processing
 		return nil
@@ -1523,7 +1530,7 @@ func (s *Service) GetTxStatus(_ *http.Request, args *GetTxStatusArgs, response *
 
 	// Note: we check if tx is dropped only after having looked for it
 	// in the database and the mempool, because dropped txs may be re-issued.
-	reason := s.vm.Builder.GetDropReason(args.TxID)
+	reason := s.vm.Builder.GetDropReason(txID)
 	if reason == nil {
 		// The tx isn't being tracked by the node.
 		response.Status = status.Unknown
@@ -1536,6 +1543,121 @@ func (s *Service) GetTxStatus(_ *http.Request, args *GetTxStatusArgs, response *
 	return nil
 }
 
+type WaitForTxArgs struct {
+	TxID ids.ID `json:"txID"`
+	// Confirmations is the number of blocks that must be accepted on top of
+	// the block that included the tx.
+	Confirmations avajson.Uint64 `json:"confirmations"`
+	// Timeout is the maximum number of milliseconds to wait for. If 0, a
+	// default timeout is used.
+	Timeout avajson.Uint64 `json:"timeout"`
+}
+
+type WaitForTxResponse struct {
+	GetTxStatusResponse
+	// Confirmations is the number of blocks accepted on top of the block that
+	// included the tx, up to the requested number of confirmations.
+	// Only non-zero if Status is committed or aborted
+	Confirmations avajson.Uint64 `json:"confirmations"`
+}
+
+// WaitForTx blocks until a tx is committed or aborted and the requested number
+// of blocks were accepted on top of it, or until the tx is dropped.
+func (s *Service) WaitForTx(r *http.Request, args *WaitForTxArgs, response *WaitForTxResponse) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "waitForTx"),
+		zap.Stringer("txID", args.TxID),
+		zap.Uint64("confirmations", uint64(args.Confirmations)),
+	)
+
+	confirmations := uint64(args.Confirmations)
+	timeout, err := waiter.Timeout(uint64(args.Timeout), confirmations)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+
+	// txHeight is the height of the block that included the tx. It is looked
+	// up once, so that re-evaluating the wait after every accepted block
+	// doesn't walk the accepted blocks again.
+	var (
+		txHeight      uint64
+		txHeightKnown bool
+	)
+	*response, err = waiter.Wait(ctx, s.vm.txWaiter, func() (WaitForTxResponse, bool, error) {
+		s.vm.ctx.Lock.Lock()
+		defer s.vm.ctx.Lock.Unlock()
+
+		var response WaitForTxResponse
+		if err := s.getTxStatus(args.TxID, &response.GetTxStatusResponse); err != nil {
+			return response, false, err
+		}
+
+		switch response.Status {
+		case status.Committed, status.Aborted:
+		case status.Dropped:
+			return response, true, nil
+		default:
+			return response, false, nil
+		}
+
+		lastAccepted, err := s.vm.manager.GetStatelessBlock(s.vm.manager.LastAccepted())
+		if err != nil {
+			return response, false, err
+		}
+		if !txHeightKnown {
+			txHeight, txHeightKnown, err = s.getTxHeight(args.TxID, lastAccepted, confirmations)
+			if err != nil {
+				return response, false, err
+			}
+		}
+		txConfirmations := confirmations
+		if txHeightKnown {
+			txConfirmations = min(lastAccepted.Height()-txHeight, confirmations)
+		}
+		response.Confirmations = avajson.Uint64(txConfirmations)
+		return response, txConfirmations >= confirmations, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed waiting for tx %s with status %s and %d confirmations: %w",
+			args.TxID,
+			response.Status,
+			response.Confirmations,
+			err,
+		)
+	}
+	return nil
+}
+
+// getTxHeight returns the height of the accepted block that included [txID].
+// Only the last [maxDepth] accepted blocks, starting at [lastAccepted], are
+// searched. If the tx isn't included in them, false is returned.
+//
+// getTxHeight assumes the context lock is held.
+func (s *Service) getTxHeight(txID ids.ID, lastAccepted block.Block, maxDepth uint64) (uint64, bool, error) {
+	blk := lastAccepted
+	for depth := uint64(0); depth < maxDepth; depth++ {
+		for _, tx := range blk.Txs() {
+			if tx.ID() == txID {
+				return blk.Height(), true, nil
+			}
+		}
+		if blk.Height() == 0 {
+			break
+		}
+
+		var err error
+		blk, err = s.vm.manager.GetStatelessBlock(blk.Parent())
+		if err != nil {
+			return 0, false, err
+		}
+	}
+	return 0, false, nil
+}
+
 type GetStakeArgs struct {
 	api.JSONAddresses
 	ValidatorsOnly bool                `json:"validatorsOnly"`
@@ -26,6 +26,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
 	"github.com/ava-labs/avalanchego/utils/constants"
@@ -49,6 +50,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators/fee"
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp/message"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/vms/txs/waiter"
 	"github.com/ava-labs/avalanchego/vms/types"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
 
@@ -231,6 +233,108 @@ func TestGetTxStatus(t *testing.T) {
 	require.Zero(resp.Reason)
 }
 
+func TestWaitForTx(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	// Waiting for an unknown tx times out.
+	err := service.WaitForTx(
+		&http.Request{},
+		&WaitForTxArgs{
+			TxID:    ids.GenerateTestID(),
+			Timeout: 10,
+		},
+		&WaitForTxResponse{},
+	)
+	require.ErrorIs(err, context.DeadlineExceeded)
+
+	issueAndAccept := func() *txs.Tx {
+		service.vm.ctx.Lock.Lock()
+		wallet := newWallet(t, service.vm, walletConfig{})
+		tx, err := wallet.IssueCreateSubnetTx(
+			&secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+			},
+		)
+		require.NoError(err)
+		service.vm.ctx.Lock.Unlock()
+
+		require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+
+		service.vm.ctx.Lock.Lock()
+		defer service.vm.ctx.Lock.Unlock()
+
+		blk, err := service.vm.BuildBlock(context.Background())
+		require.NoError(err)
+		require.NoError(blk.Verify(context.Background()))
+		// The block acceptor group of the chain notifies the tx waiter before
+		// the block is accepted.
+		require.NoError(service.vm.TxWaiter().Accept(snowtest.ConsensusContext(service.vm.ctx), blk.ID(), blk.Bytes()))
+		require.NoError(blk.Accept(context.Background()))
+		require.NoError(service.vm.SetPreference(context.Background(), blk.ID()))
+		return tx
+	}
+
+	tx := issueAndAccept()
+
+	var resp WaitForTxResponse
+	require.NoError(service.WaitForTx(
+		&http.Request{},
+		&WaitForTxArgs{
+			TxID: tx.ID(),
+		},
+		&resp,
+	))
+	require.Equal(status.Committed, resp.Status)
+	require.Zero(resp.Confirmations)
+
+	// The last observed status is reported when the wait times out.
+	resp = WaitForTxResponse{}
+	err = service.WaitForTx(
+		&http.Request{},
+		&WaitForTxArgs{
+			TxID:          tx.ID(),
+			Confirmations: 1,
+			Timeout:       10,
+		},
+		&resp,
+	)
+	require.ErrorIs(err, context.DeadlineExceeded)
+	require.Equal(status.Committed, resp.Status)
+	require.Zero(resp.Confirmations)
+
+	// Wait for a confirmation on top of the block that included the tx.
+	type result struct {
+		resp WaitForTxResponse
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		var resp WaitForTxResponse
+		err := service.WaitForTx(
+			&http.Request{},
+			&WaitForTxArgs{
+				TxID:          tx.ID(),
+				Confirmations: 1,
+				Timeout:       avajson.Uint64(waiter.MaxTimeout.Milliseconds()),
+			},
+			&resp,
+		)
+		done <- result{
+			resp: resp,
+			err:  err,
+		}
+	}()
+
+	issueAndAccept()
+
+	res := <-done
+	require.NoError(res.err)
+	require.Equal(status.Committed, res.resp.Status)
+	require.Equal(avajson.Uint64(1), res.resp.Confirmations)
+}
+
 // Test issuing and then retrieving a transaction
 func TestGetTx(t *testing.T) {
 	type test struct {
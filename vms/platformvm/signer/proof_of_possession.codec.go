@@ -0,0 +1,42 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: proof_of_possession.go
+
+package signer
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*ProofOfPossession)(nil)
+)
+
+func (t *ProofOfPossession) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += 48
+	size += 96
+	return size, nil
+}
+
+func (t *ProofOfPossession) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	p.PackFixedBytes(t.PublicKey[:])
+	p.PackFixedBytes(t.ProofOfPossession[:])
+	return p.Err
+}
+
+func (t *ProofOfPossession) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	copy(t.PublicKey[:], p.UnpackFixedBytes(48))
+	if p.Err != nil {
+		return p.Err
+	}
+	copy(t.ProofOfPossession[:], p.UnpackFixedBytes(96))
+	if p.Err != nil {
+		return p.Err
+	}
+	return nil
+}
+
+func (t *ProofOfPossession) CodecValue() interface{} {
+	return t
+}
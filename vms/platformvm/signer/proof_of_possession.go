@@ -3,6 +3,8 @@
 
 package signer
 
+//go:generate go run github.com/ava-labs/avalanchego/codec/codecgen/cmd $GOFILE
+
 import (
 	"encoding/json"
 	"errors"
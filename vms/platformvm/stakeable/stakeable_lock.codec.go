@@ -0,0 +1,86 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: stakeable_lock.go
+
+package stakeable
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*LockOut)(nil)
+	_ codec.Marshaler = (*LockIn)(nil)
+)
+
+func (t *LockOut) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += 8
+	{
+		n, err := c.Size(&t.TransferableOut)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *LockOut) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	p.PackLong(uint64(t.Locktime))
+	if err := c.MarshalInto(&t.TransferableOut, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *LockOut) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	t.Locktime = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	if err := c.UnmarshalFrom(p, &t.TransferableOut); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *LockOut) CodecValue() interface{} {
+	return t
+}
+
+func (t *LockIn) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += 8
+	{
+		n, err := c.Size(&t.TransferableIn)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *LockIn) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	p.PackLong(uint64(t.Locktime))
+	if err := c.MarshalInto(&t.TransferableIn, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *LockIn) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	t.Locktime = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	if err := c.UnmarshalFrom(p, &t.TransferableIn); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *LockIn) CodecValue() interface{} {
+	return t
+}
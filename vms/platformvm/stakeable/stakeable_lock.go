@@ -3,6 +3,8 @@
 
 package stakeable
 
+//go:generate go run github.com/ava-labs/avalanchego/codec/codecgen/cmd $GOFILE
+
 import (
 	"errors"
 
@@ -0,0 +1,128 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: add_delegator_tx.go
+
+package txs
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+)
+
+var (
+	_ codec.Marshaler = (*AddDelegatorTx)(nil)
+)
+
+func (t *AddDelegatorTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	{
+		n, err := c.Size(&t.Validator)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += wrappers.IntLen
+	for i0 := range t.StakeOuts {
+		start1 := size
+		if t.StakeOuts[i0] == nil {
+			return 0, codec.ErrMarshalNil
+		}
+		{
+			n, err := c.Size(&(*t.StakeOuts[i0]))
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		if i0 == 0 && size == start1 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	{
+		n, err := c.Size(&t.DelegationRewardsOwner)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *AddDelegatorTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	if err := c.MarshalInto(&t.Validator, p); err != nil {
+		return err
+	}
+	if len(t.StakeOuts) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.StakeOuts)))
+	for i0 := range t.StakeOuts {
+		start1 := p.Offset
+		if t.StakeOuts[i0] == nil {
+			return codec.ErrMarshalNil
+		}
+		if err := c.MarshalInto(&(*t.StakeOuts[i0]), p); err != nil {
+			return err
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start1 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	if err := c.MarshalInto(&t.DelegationRewardsOwner, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *AddDelegatorTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	if err := c.UnmarshalFrom(p, &t.Validator); err != nil {
+		return err
+	}
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.StakeOuts = make([]*avax.TransferableOutput, 0, min(int(n0), 16))
+	for i1 := 0; i1 < int(n0); i1++ {
+		var elem3 *avax.TransferableOutput
+		t.StakeOuts = append(t.StakeOuts, elem3)
+		start2 := p.Offset
+		t.StakeOuts[i1] = new(avax.TransferableOutput)
+		if err := c.UnmarshalFrom(p, &(*t.StakeOuts[i1])); err != nil {
+			return err
+		}
+		if p.Offset == start2 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	if err := c.UnmarshalFrom(p, &t.DelegationRewardsOwner); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *AddDelegatorTx) CodecValue() interface{} {
+	return t
+}
@@ -0,0 +1,69 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: add_subnet_validator_tx.go
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*AddSubnetValidatorTx)(nil)
+)
+
+func (t *AddSubnetValidatorTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	{
+		n, err := c.Size(&t.SubnetValidator)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	{
+		n, err := c.Size(&t.SubnetAuth)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *AddSubnetValidatorTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	if err := c.MarshalInto(&t.SubnetValidator, p); err != nil {
+		return err
+	}
+	if err := c.MarshalInto(&t.SubnetAuth, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *AddSubnetValidatorTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	if err := c.UnmarshalFrom(p, &t.SubnetValidator); err != nil {
+		return err
+	}
+	if err := c.UnmarshalFrom(p, &t.SubnetAuth); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *AddSubnetValidatorTx) CodecValue() interface{} {
+	return t
+}
@@ -0,0 +1,36 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: advance_time_tx.go
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*AdvanceTimeTx)(nil)
+)
+
+func (t *AdvanceTimeTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += 8
+	return size, nil
+}
+
+func (t *AdvanceTimeTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	p.PackLong(uint64(t.Time))
+	return p.Err
+}
+
+func (t *AdvanceTimeTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	t.Time = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	return nil
+}
+
+func (t *AdvanceTimeTx) CodecValue() interface{} {
+	return t
+}
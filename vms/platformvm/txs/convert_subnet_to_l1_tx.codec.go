@@ -0,0 +1,231 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: convert_subnet_to_l1_tx.go
+
+package txs
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*ConvertSubnetToL1Tx)(nil)
+	_ codec.Marshaler = (*ConvertSubnetToL1Validator)(nil)
+)
+
+func (t *ConvertSubnetToL1Tx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 32
+	size += 32
+	size += wrappers.IntLen
+	size += len(t.Address)
+	size += wrappers.IntLen
+	for i0 := range t.Validators {
+		start1 := size
+		if t.Validators[i0] == nil {
+			return 0, codec.ErrMarshalNil
+		}
+		{
+			n, err := (*t.Validators[i0]).CodecSize(c)
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+		if i0 == 0 && size == start1 {
+			return 0, codec.ErrMarshalZeroLength
+		}
+	}
+	{
+		n, err := c.Size(&t.SubnetAuth)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *ConvertSubnetToL1Tx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	p.PackFixedBytes(t.Subnet[:])
+	p.PackFixedBytes(t.ChainID[:])
+	if len(t.Address) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Address)))
+	p.PackFixedBytes(t.Address)
+	if len(t.Validators) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Validators)))
+	for i0 := range t.Validators {
+		start1 := p.Offset
+		if t.Validators[i0] == nil {
+			return codec.ErrMarshalNil
+		}
+		if err := (*t.Validators[i0]).CodecMarshalInto(c, p); err != nil {
+			return err
+		}
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start1 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	if err := c.MarshalInto(&t.SubnetAuth, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *ConvertSubnetToL1Tx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	copy(t.Subnet[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	copy(t.ChainID[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Address = p.UnpackFixedBytes(int(n0))
+	if p.Err != nil {
+		return p.Err
+	}
+	n1 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n1 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Validators = make([]*ConvertSubnetToL1Validator, 0, min(int(n1), 16))
+	for i2 := 0; i2 < int(n1); i2++ {
+		var elem4 *ConvertSubnetToL1Validator
+		t.Validators = append(t.Validators, elem4)
+		start3 := p.Offset
+		t.Validators[i2] = new(ConvertSubnetToL1Validator)
+		if err := (*t.Validators[i2]).CodecUnmarshalFrom(c, p); err != nil {
+			return err
+		}
+		if p.Offset == start3 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	if err := c.UnmarshalFrom(p, &t.SubnetAuth); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *ConvertSubnetToL1Tx) CodecValue() interface{} {
+	return t
+}
+
+func (t *ConvertSubnetToL1Validator) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += wrappers.IntLen
+	size += len(t.NodeID)
+	size += 8
+	size += 8
+	{
+		n, err := c.Size(&t.Signer)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	{
+		n, err := c.Size(&t.RemainingBalanceOwner)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	{
+		n, err := c.Size(&t.DeactivationOwner)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *ConvertSubnetToL1Validator) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if len(t.NodeID) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.NodeID)))
+	p.PackFixedBytes(t.NodeID)
+	p.PackLong(uint64(t.Weight))
+	p.PackLong(uint64(t.Balance))
+	if err := c.MarshalInto(&t.Signer, p); err != nil {
+		return err
+	}
+	if err := c.MarshalInto(&t.RemainingBalanceOwner, p); err != nil {
+		return err
+	}
+	if err := c.MarshalInto(&t.DeactivationOwner, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *ConvertSubnetToL1Validator) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.NodeID = p.UnpackFixedBytes(int(n0))
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Weight = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Balance = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	if err := c.UnmarshalFrom(p, &t.Signer); err != nil {
+		return err
+	}
+	if err := c.UnmarshalFrom(p, &t.RemainingBalanceOwner); err != nil {
+		return err
+	}
+	if err := c.UnmarshalFrom(p, &t.DeactivationOwner); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *ConvertSubnetToL1Validator) CodecValue() interface{} {
+	return t
+}
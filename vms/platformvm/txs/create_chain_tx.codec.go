@@ -0,0 +1,131 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: create_chain_tx.go
+
+package txs
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*CreateChainTx)(nil)
+)
+
+func (t *CreateChainTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 32
+	size += wrappers.StringLen(string(t.ChainName))
+	size += 32
+	size += wrappers.IntLen
+	size += len(t.FxIDs) * 32
+	size += wrappers.IntLen
+	size += len(t.GenesisData)
+	{
+		n, err := c.Size(&t.SubnetAuth)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *CreateChainTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	p.PackFixedBytes(t.SubnetID[:])
+	p.PackStr(string(t.ChainName))
+	p.PackFixedBytes(t.VMID[:])
+	if len(t.FxIDs) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.FxIDs)))
+	for i0 := range t.FxIDs {
+		start1 := p.Offset
+		p.PackFixedBytes(t.FxIDs[i0][:])
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start1 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	if len(t.GenesisData) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.GenesisData)))
+	p.PackFixedBytes(t.GenesisData)
+	if err := c.MarshalInto(&t.SubnetAuth, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *CreateChainTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	copy(t.SubnetID[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	t.ChainName = string(p.UnpackStr())
+	if p.Err != nil {
+		return p.Err
+	}
+	copy(t.VMID[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.FxIDs = make([]ids.ID, 0, min(int(n0), 16))
+	for i1 := 0; i1 < int(n0); i1++ {
+		var elem3 ids.ID
+		t.FxIDs = append(t.FxIDs, elem3)
+		start2 := p.Offset
+		copy(t.FxIDs[i1][:], p.UnpackFixedBytes(32))
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start2 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	n4 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n4 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.GenesisData = p.UnpackFixedBytes(int(n4))
+	if p.Err != nil {
+		return p.Err
+	}
+	if err := c.UnmarshalFrom(p, &t.SubnetAuth); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *CreateChainTx) CodecValue() interface{} {
+	return t
+}
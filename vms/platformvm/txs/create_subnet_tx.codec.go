@@ -0,0 +1,56 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: create_subnet_tx.go
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*CreateSubnetTx)(nil)
+)
+
+func (t *CreateSubnetTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	{
+		n, err := c.Size(&t.Owner)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *CreateSubnetTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	if err := c.MarshalInto(&t.Owner, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *CreateSubnetTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	if err := c.UnmarshalFrom(p, &t.Owner); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *CreateSubnetTx) CodecValue() interface{} {
+	return t
+}
@@ -0,0 +1,62 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: disable_l1_validator_tx.go
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*DisableL1ValidatorTx)(nil)
+)
+
+func (t *DisableL1ValidatorTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 32
+	{
+		n, err := c.Size(&t.DisableAuth)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *DisableL1ValidatorTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	p.PackFixedBytes(t.ValidationID[:])
+	if err := c.MarshalInto(&t.DisableAuth, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *DisableL1ValidatorTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	copy(t.ValidationID[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	if err := c.UnmarshalFrom(p, &t.DisableAuth); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *DisableL1ValidatorTx) CodecValue() interface{} {
+	return t
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/codec/reflectcodec"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+// reflectOnlyTagName is an additional tag name that prevents codecs from using
+// the generated marshalling code, which only handles
+// [reflectcodec.DefaultTagName].
+const reflectOnlyTagName = "reflectOnly"
+
+// newReflectionCodec returns a codec with the same type registrations as
+// [txs.Codec] that only uses reflection.
+func newReflectionCodec(t *testing.T) codec.Manager {
+	require := require.New(t)
+
+	c := linearcodec.New([]string{reflectcodec.DefaultTagName, reflectOnlyTagName})
+	c.SkipRegistrations(5)
+	require.NoError(txs.RegisterApricotTypes(c))
+	require.NoError(txs.RegisterBanffTypes(c))
+	c.SkipRegistrations(4)
+	require.NoError(txs.RegisterDurangoTypes(c))
+	require.NoError(txs.RegisterEtnaTypes(c))
+
+	m := codec.NewManager(math.MaxInt32)
+	require.NoError(m.RegisterCodec(txs.CodecVersion, c))
+	return m
+}
+
+func TestGeneratedCodecMatchesReflection(t *testing.T) {
+	reflectionCodec := newReflectionCodec(t)
+	for _, test := range txTests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			txBytes, err := hex.DecodeString(test.tx)
+			require.NoError(err)
+
+			generatedTx, err := txs.Parse(txs.Codec, txBytes)
+			require.NoError(err)
+			reflectedTx, err := txs.Parse(reflectionCodec, txBytes)
+			require.NoError(err)
+			require.Equal(reflectedTx, generatedTx)
+
+			generatedBytes, err := txs.Codec.Marshal(txs.CodecVersion, generatedTx)
+			require.NoError(err)
+			require.Equal(txBytes, generatedBytes)
+			reflectedBytes, err := reflectionCodec.Marshal(txs.CodecVersion, generatedTx)
+			require.NoError(err)
+			require.Equal(txBytes, reflectedBytes)
+
+			generatedSize, err := txs.Codec.Size(txs.CodecVersion, generatedTx)
+			require.NoError(err)
+			require.Len(txBytes, generatedSize)
+			reflectedSize, err := reflectionCodec.Size(txs.CodecVersion, generatedTx)
+			require.NoError(err)
+			require.Len(txBytes, reflectedSize)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: increase_l1_validator_balance_tx.go
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*IncreaseL1ValidatorBalanceTx)(nil)
+)
+
+func (t *IncreaseL1ValidatorBalanceTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 32
+	size += 8
+	return size, nil
+}
+
+func (t *IncreaseL1ValidatorBalanceTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	p.PackFixedBytes(t.ValidationID[:])
+	p.PackLong(uint64(t.Balance))
+	return p.Err
+}
+
+func (t *IncreaseL1ValidatorBalanceTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	copy(t.ValidationID[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Balance = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	return nil
+}
+
+func (t *IncreaseL1ValidatorBalanceTx) CodecValue() interface{} {
+	return t
+}
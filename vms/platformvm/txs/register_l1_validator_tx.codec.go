@@ -0,0 +1,75 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: register_l1_validator_tx.go
+
+package txs
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*RegisterL1ValidatorTx)(nil)
+)
+
+func (t *RegisterL1ValidatorTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 8
+	size += 96
+	size += wrappers.IntLen
+	size += len(t.Message)
+	return size, nil
+}
+
+func (t *RegisterL1ValidatorTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	p.PackLong(uint64(t.Balance))
+	p.PackFixedBytes(t.ProofOfPossession[:])
+	if len(t.Message) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Message)))
+	p.PackFixedBytes(t.Message)
+	return p.Err
+}
+
+func (t *RegisterL1ValidatorTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	t.Balance = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	copy(t.ProofOfPossession[:], p.UnpackFixedBytes(96))
+	if p.Err != nil {
+		return p.Err
+	}
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Message = p.UnpackFixedBytes(int(n0))
+	if p.Err != nil {
+		return p.Err
+	}
+	return nil
+}
+
+func (t *RegisterL1ValidatorTx) CodecValue() interface{} {
+	return t
+}
@@ -0,0 +1,68 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: remove_subnet_validator_tx.go
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*RemoveSubnetValidatorTx)(nil)
+)
+
+func (t *RemoveSubnetValidatorTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 20
+	size += 32
+	{
+		n, err := c.Size(&t.SubnetAuth)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *RemoveSubnetValidatorTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	p.PackFixedBytes(t.NodeID[:])
+	p.PackFixedBytes(t.Subnet[:])
+	if err := c.MarshalInto(&t.SubnetAuth, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *RemoveSubnetValidatorTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	copy(t.NodeID[:], p.UnpackFixedBytes(20))
+	if p.Err != nil {
+		return p.Err
+	}
+	copy(t.Subnet[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	if err := c.UnmarshalFrom(p, &t.SubnetAuth); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *RemoveSubnetValidatorTx) CodecValue() interface{} {
+	return t
+}
@@ -0,0 +1,36 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: reward_validator_tx.go
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*RewardValidatorTx)(nil)
+)
+
+func (t *RewardValidatorTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += 32
+	return size, nil
+}
+
+func (t *RewardValidatorTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	p.PackFixedBytes(t.TxID[:])
+	return p.Err
+}
+
+func (t *RewardValidatorTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	copy(t.TxID[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	return nil
+}
+
+func (t *RewardValidatorTx) CodecValue() interface{} {
+	return t
+}
@@ -0,0 +1,63 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: set_l1_validator_weight_tx.go
+
+package txs
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*SetL1ValidatorWeightTx)(nil)
+)
+
+func (t *SetL1ValidatorWeightTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += wrappers.IntLen
+	size += len(t.Message)
+	return size, nil
+}
+
+func (t *SetL1ValidatorWeightTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	if len(t.Message) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Message)))
+	p.PackFixedBytes(t.Message)
+	return p.Err
+}
+
+func (t *SetL1ValidatorWeightTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Message = p.UnpackFixedBytes(int(n0))
+	if p.Err != nil {
+		return p.Err
+	}
+	return nil
+}
+
+func (t *SetL1ValidatorWeightTx) CodecValue() interface{} {
+	return t
+}
@@ -0,0 +1,49 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: subnet_validator.go
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*SubnetValidator)(nil)
+)
+
+func (t *SubnetValidator) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.Validator)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 32
+	return size, nil
+}
+
+func (t *SubnetValidator) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.Validator, p); err != nil {
+		return err
+	}
+	p.PackFixedBytes(t.Subnet[:])
+	return p.Err
+}
+
+func (t *SubnetValidator) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.Validator); err != nil {
+		return err
+	}
+	copy(t.Subnet[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	return nil
+}
+
+func (t *SubnetValidator) CodecValue() interface{} {
+	return t
+}
@@ -0,0 +1,75 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: transfer_subnet_ownership_tx.go
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*TransferSubnetOwnershipTx)(nil)
+)
+
+func (t *TransferSubnetOwnershipTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 32
+	{
+		n, err := c.Size(&t.SubnetAuth)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	{
+		n, err := c.Size(&t.Owner)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *TransferSubnetOwnershipTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	p.PackFixedBytes(t.Subnet[:])
+	if err := c.MarshalInto(&t.SubnetAuth, p); err != nil {
+		return err
+	}
+	if err := c.MarshalInto(&t.Owner, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *TransferSubnetOwnershipTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	copy(t.Subnet[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	if err := c.UnmarshalFrom(p, &t.SubnetAuth); err != nil {
+		return err
+	}
+	if err := c.UnmarshalFrom(p, &t.Owner); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *TransferSubnetOwnershipTx) CodecValue() interface{} {
+	return t
+}
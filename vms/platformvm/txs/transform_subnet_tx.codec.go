@@ -0,0 +1,140 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: transform_subnet_tx.go
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*TransformSubnetTx)(nil)
+)
+
+func (t *TransformSubnetTx) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.BaseTx)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 32
+	size += 32
+	size += 8
+	size += 8
+	size += 8
+	size += 8
+	size += 8
+	size += 8
+	size += 4
+	size += 4
+	size += 4
+	size += 8
+	size += 1
+	size += 4
+	{
+		n, err := c.Size(&t.SubnetAuth)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *TransformSubnetTx) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.BaseTx, p); err != nil {
+		return err
+	}
+	p.PackFixedBytes(t.Subnet[:])
+	p.PackFixedBytes(t.AssetID[:])
+	p.PackLong(uint64(t.InitialSupply))
+	p.PackLong(uint64(t.MaximumSupply))
+	p.PackLong(uint64(t.MinConsumptionRate))
+	p.PackLong(uint64(t.MaxConsumptionRate))
+	p.PackLong(uint64(t.MinValidatorStake))
+	p.PackLong(uint64(t.MaxValidatorStake))
+	p.PackInt(uint32(t.MinStakeDuration))
+	p.PackInt(uint32(t.MaxStakeDuration))
+	p.PackInt(uint32(t.MinDelegationFee))
+	p.PackLong(uint64(t.MinDelegatorStake))
+	p.PackByte(byte(t.MaxValidatorWeightFactor))
+	p.PackInt(uint32(t.UptimeRequirement))
+	if err := c.MarshalInto(&t.SubnetAuth, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *TransformSubnetTx) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.BaseTx); err != nil {
+		return err
+	}
+	copy(t.Subnet[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	copy(t.AssetID[:], p.UnpackFixedBytes(32))
+	if p.Err != nil {
+		return p.Err
+	}
+	t.InitialSupply = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.MaximumSupply = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.MinConsumptionRate = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.MaxConsumptionRate = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.MinValidatorStake = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.MaxValidatorStake = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.MinStakeDuration = uint32(p.UnpackInt())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.MaxStakeDuration = uint32(p.UnpackInt())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.MinDelegationFee = uint32(p.UnpackInt())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.MinDelegatorStake = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.MaxValidatorWeightFactor = byte(p.UnpackByte())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.UptimeRequirement = uint32(p.UnpackInt())
+	if p.Err != nil {
+		return p.Err
+	}
+	if err := c.UnmarshalFrom(p, &t.SubnetAuth); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *TransformSubnetTx) CodecValue() interface{} {
+	return t
+}
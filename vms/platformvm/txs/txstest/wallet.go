@@ -133,3 +133,14 @@ func (c *client) IssueTx(
 	ctx := ops.Context()
 	return c.backend.AcceptTx(ctx, tx)
 }
+
+func (c *client) IssueTxWithFeeBump(
+	build common.BuildFunc[*txs.Tx],
+	options ...common.Option,
+) (*txs.Tx, error) {
+	tx, _, err := build(common.PercentDenominator)
+	if err != nil {
+		return nil, err
+	}
+	return tx, c.IssueTx(tx, options...)
+}
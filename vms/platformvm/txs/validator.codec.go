@@ -0,0 +1,54 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: validator.go
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*Validator)(nil)
+)
+
+func (t *Validator) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += 20
+	size += 8
+	size += 8
+	size += 8
+	return size, nil
+}
+
+func (t *Validator) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	p.PackFixedBytes(t.NodeID[:])
+	p.PackLong(uint64(t.Start))
+	p.PackLong(uint64(t.End))
+	p.PackLong(uint64(t.Wght))
+	return p.Err
+}
+
+func (t *Validator) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	copy(t.NodeID[:], p.UnpackFixedBytes(20))
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Start = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.End = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Wght = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	return nil
+}
+
+func (t *Validator) CodecValue() interface{} {
+	return t
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/events"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/network"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
@@ -41,6 +42,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/utxo"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/vms/txs/mempool"
+	"github.com/ava-labs/avalanchego/vms/txs/waiter"
 
 	snowmanblock "github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	blockbuilder "github.com/ava-labs/avalanchego/vms/platformvm/block/builder"
@@ -56,6 +58,7 @@ var (
 	_ snowmanblock.BuildBlockWithContextChainVM = (*VM)(nil)
 	_ secp256k1fx.VM                            = (*VM)(nil)
 	_ validators.State                          = (*VM)(nil)
+	_ waiter.VM                                 = (*VM)(nil)
 )
 
 type VM struct {
@@ -85,6 +88,13 @@ type VM struct {
 
 	manager blockexecutor.Manager
 
+	// events is nil if validator set change events are disabled.
+	events *events.Dispatcher
+
+	// txWaiter wakes up API calls that are waiting for txs when blocks are
+	// accepted.
+	txWaiter *waiter.Waiter
+
 	// Cancelled on shutdown
 	onShutdownCtx context.Context
 	// Call [onShutdownCtxCancel] to cancel [onShutdownCtx] during Shutdown()
@@ -172,12 +182,20 @@ func (vm *VM) Initialize(
 		return fmt.Errorf("failed to create mempool: %w", err)
 	}
 
+	var publisher events.Publisher
+	if execConfig.Events.Enabled {
+		vm.events = events.NewDispatcher(chainCtx.Log, execConfig.Events)
+		publisher = vm.events
+	}
+
+	vm.txWaiter = waiter.New()
 	vm.manager = blockexecutor.NewManager(
 		mempool,
 		vm.metrics,
 		vm.state,
 		txExecutorBackend,
 		validatorManager,
+		publisher,
 	)
 
 	txVerifier := network.NewLockedTxVerifier(&txExecutorBackend.Ctx.Lock, vm.manager)
@@ -394,6 +412,9 @@ func (vm *VM) Shutdown(context.Context) error {
 
 	vm.onShutdownCtxCancel()
 	vm.Builder.ShutdownBlockTimer()
+	if vm.events != nil {
+		vm.events.Shutdown()
+	}
 
 	if vm.uptimeManager.StartedTracking() {
 		primaryVdrIDs := vm.Validators.GetValidatorIDs(constants.PrimaryNetworkID)
@@ -443,6 +464,11 @@ func (*VM) Version(context.Context) (string, error) {
 	return version.Current.String(), nil
 }
 
+// TxWaiter returns the waiter of the API calls that are waiting for txs
+func (vm *VM) TxWaiter() *waiter.Waiter {
+	return vm.txWaiter
+}
+
 // CreateHandlers returns a map where:
 // * keys are API endpoint extensions
 // * values are API handlers
@@ -459,10 +485,17 @@ func (vm *VM) CreateHandlers(context.Context) (map[string]http.Handler, error) {
 			Size: stakerAttributesCacheSize,
 		},
 	}
-	err := server.RegisterService(service, "platform")
-	return map[string]http.Handler{
+	if err := server.RegisterService(service, "platform"); err != nil {
+		return nil, err
+	}
+
+	handlers := map[string]http.Handler{
 		"": server,
-	}, err
+	}
+	if vm.events != nil {
+		handlers["/events"] = events.NewHandler(vm.ctx.Log, vm.events)
+	}
+	return handlers, nil
 }
 
 func (vm *VM) Connected(ctx context.Context, nodeID ids.NodeID, version *version.Application) error {
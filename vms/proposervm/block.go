@@ -374,16 +374,19 @@ func (p *postForkCommonComponents) verifyPostDurangoBlockDelay(
 	blk *postForkBlock,
 ) (bool, error) {
 	var (
+		windower     = p.vm.postDurangoWindower(parentTimestamp)
 		blkTimestamp = blk.Timestamp()
 		blkHeight    = blk.Height()
-		currentSlot  = proposer.TimeToSlot(parentTimestamp, blkTimestamp)
+		currentSlot  = proposer.TimeToSlotWithDuration(parentTimestamp, blkTimestamp, windower.SlotDuration())
 		proposerID   = blk.Proposer()
 	)
 	// populate the slot for the block.
 	blk.slot = &currentSlot
+	blk.parentTimestamp = parentTimestamp
+	blk.parentPChainHeight = parentPChainHeight
 
 	// find the expected proposer
-	expectedProposerID, err := p.vm.Windower.ExpectedProposer(
+	expectedProposerID, err := windower.ExpectedProposer(
 		ctx,
 		blkHeight,
 		parentPChainHeight,
@@ -413,9 +416,12 @@ func (p *postForkCommonComponents) shouldBuildSignedBlockPostDurango(
 	parentPChainHeight uint64,
 	newTimestamp time.Time,
 ) (bool, error) {
-	parentHeight := p.innerBlk.Height()
-	currentSlot := proposer.TimeToSlot(parentTimestamp, newTimestamp)
-	expectedProposerID, err := p.vm.Windower.ExpectedProposer(
+	var (
+		parentHeight = p.innerBlk.Height()
+		windower     = p.vm.postDurangoWindower(parentTimestamp)
+		currentSlot  = proposer.TimeToSlotWithDuration(parentTimestamp, newTimestamp, windower.SlotDuration())
+	)
+	expectedProposerID, err := windower.ExpectedProposer(
 		ctx,
 		parentHeight+1,
 		parentPChainHeight,
@@ -467,7 +473,7 @@ func (p *postForkCommonComponents) shouldBuildSignedBlockPostDurango(
 	}
 
 	// report the build slot to the metrics.
-	p.vm.proposerBuildSlotGauge.Set(float64(proposer.TimeToSlot(parentTimestamp, nextStartTime)))
+	p.vm.proposerBuildSlotGauge.Set(float64(proposer.TimeToSlotWithDuration(parentTimestamp, nextStartTime, windower.SlotDuration())))
 
 	// set the scheduler to let us know when the next block need to be built.
 	p.vm.Scheduler.SetBuildBlockTime(nextStartTime)
@@ -70,6 +70,7 @@ func TestPostForkCommonComponents_buildChild(t *testing.T) {
 	vdrState.EXPECT().GetMinimumHeight(context.Background()).Return(pChainHeight, nil).AnyTimes()
 
 	windower := proposermock.NewWindower(ctrl)
+	windower.EXPECT().SlotDuration().Return(proposer.WindowDuration).AnyTimes()
 	windower.EXPECT().ExpectedProposer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nodeID, nil).AnyTimes()
 
 	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
@@ -379,6 +380,7 @@ func TestPostDurangoBuildChildResetScheduler(t *testing.T) {
 	vdrState.EXPECT().GetMinimumHeight(context.Background()).Return(pChainHeight, nil).AnyTimes()
 
 	windower := proposermock.NewWindower(ctrl)
+	windower.EXPECT().SlotDuration().Return(proposer.WindowDuration).AnyTimes()
 	windower.EXPECT().ExpectedProposer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(selectedProposer, nil).AnyTimes() // return a proposer different from thisNode, to check whether scheduler is reset
 
@@ -462,6 +464,7 @@ func TestPreEtnaContextPChainHeight(t *testing.T) {
 	vdrState.EXPECT().GetMinimumHeight(context.Background()).Return(pChainHeight, nil).AnyTimes()
 
 	windower := proposermock.NewWindower(ctrl)
+	windower.EXPECT().SlotDuration().Return(proposer.WindowDuration).AnyTimes()
 	windower.EXPECT().ExpectedProposer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nodeID, nil).AnyTimes()
 
 	vm := &VM{
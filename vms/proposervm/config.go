@@ -11,6 +11,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/vms/proposervm/proposer"
 )
 
 type Config struct {
@@ -31,4 +32,8 @@ type Config struct {
 
 	// Registerer for prometheus metrics
 	Registerer prometheus.Registerer
+
+	// ProposerPolicy, if non-nil, replaces the default Post-Durango proposer
+	// selection once it is activated.
+	ProposerPolicy *proposer.PolicyConfig
 }
@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/vms/proposervm/state"
+)
+
+const (
+	// slotWindowSize is the number of most recently assigned proposer slots
+	// that are persisted and considered by the health check.
+	slotWindowSize = 128
+	// minAssignedSlots is the number of assigned proposer slots required
+	// before the health check reports this node as failing to produce blocks.
+	minAssignedSlots = 16
+	// maxMissedSlotRatio is the maximum ratio of assigned proposer slots that
+	// this node may miss before being reported as unhealthy.
+	maxMissedSlotRatio = .5
+)
+
+var errMissedProposerSlots = errors.New("missed too many proposer slots")
+
+type proposerSlotHealth struct {
+	AssignedSlots int `json:"assignedSlots"`
+	ProducedSlots int `json:"producedSlots"`
+	MissedSlots   int `json:"missedSlots"`
+	// LastMissedHeight is the height of the most recent block that was
+	// proposed by another node after this node's slot had started.
+	LastMissedHeight uint64 `json:"lastMissedHeight,omitempty"`
+}
+
+// ProposerHealthCheck reports whether this node is producing blocks during its
+// assigned proposer slots.
+//
+// It is separate from HealthCheck, which reports the health of the inner VM
+// unchanged, so that it can be registered as its own health check.
+func (vm *VM) ProposerHealthCheck(context.Context) (interface{}, error) {
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	return vm.proposerHealthCheck()
+}
+
+func (vm *VM) proposerHealthCheck() (proposerSlotHealth, error) {
+	health := proposerSlotHealth{
+		AssignedSlots: len(vm.slotWindow),
+	}
+	for _, record := range vm.slotWindow {
+		if record.Produced {
+			health.ProducedSlots++
+		} else {
+			health.MissedSlots++
+			health.LastMissedHeight = record.Height
+		}
+	}
+
+	if health.AssignedSlots < minAssignedSlots {
+		return health, nil
+	}
+	missedRatio := float64(health.MissedSlots) / float64(health.AssignedSlots)
+	if missedRatio > maxMissedSlotRatio {
+		return health, fmt.Errorf("%w: missed %d of the last %d assigned slots",
+			errMissedProposerSlots,
+			health.MissedSlots,
+			health.AssignedSlots,
+		)
+	}
+	return health, nil
+}
+
+// recordProposerSlot adds [record] to the rolling window of assigned proposer
+// slots and persists the window.
+func (vm *VM) recordProposerSlot(record state.SlotRecord) error {
+	vm.assignedProposerSlotsCounter.Inc()
+	if record.Produced {
+		vm.producedProposerSlotsCounter.Inc()
+	}
+
+	vm.slotWindow = append(vm.slotWindow, record)
+	if len(vm.slotWindow) > slotWindowSize {
+		vm.slotWindow = vm.slotWindow[len(vm.slotWindow)-slotWindowSize:]
+	}
+	return vm.State.SetSlotWindow(vm.slotWindow)
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/vms/proposervm/state"
+)
+
+var errUnhealthyInnerVM = errors.New("unhealthy inner VM")
+
+func TestProposerHealthCheck(t *testing.T) {
+	tests := []struct {
+		name           string
+		records        []state.SlotRecord
+		expectedHealth proposerSlotHealth
+		expectedErr    error
+	}{
+		{
+			name:           "no assigned slots",
+			expectedHealth: proposerSlotHealth{},
+		},
+		{
+			name:    "too few assigned slots",
+			records: makeSlotRecords(minAssignedSlots-1, 0),
+			expectedHealth: proposerSlotHealth{
+				AssignedSlots:    minAssignedSlots - 1,
+				MissedSlots:      minAssignedSlots - 1,
+				LastMissedHeight: minAssignedSlots - 2,
+			},
+		},
+		{
+			name:    "produced enough slots",
+			records: makeSlotRecords(minAssignedSlots/2, minAssignedSlots/2),
+			expectedHealth: proposerSlotHealth{
+				AssignedSlots:    minAssignedSlots,
+				ProducedSlots:    minAssignedSlots / 2,
+				MissedSlots:      minAssignedSlots / 2,
+				LastMissedHeight: minAssignedSlots/2 - 1,
+			},
+		},
+		{
+			name:    "missed too many slots",
+			records: makeSlotRecords(minAssignedSlots/2+1, minAssignedSlots/2-1),
+			expectedHealth: proposerSlotHealth{
+				AssignedSlots:    minAssignedSlots,
+				ProducedSlots:    minAssignedSlots/2 - 1,
+				MissedSlots:      minAssignedSlots/2 + 1,
+				LastMissedHeight: minAssignedSlots / 2,
+			},
+			expectedErr: errMissedProposerSlots,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			vm := &VM{
+				slotWindow: test.records,
+			}
+			health, err := vm.proposerHealthCheck()
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedHealth, health)
+		})
+	}
+}
+
+func TestRecordProposerSlot(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		activationTime = time.Unix(0, 0)
+		durangoTime    = activationTime
+	)
+	_, _, proVM, _ := initTestProposerVM(t, activationTime, durangoTime, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+
+	for height := uint64(0); height < slotWindowSize+1; height++ {
+		require.NoError(proVM.recordProposerSlot(state.SlotRecord{
+			Height:   height,
+			Produced: height%2 == 0,
+		}))
+	}
+
+	// The oldest record should have been dropped.
+	require.Len(proVM.slotWindow, slotWindowSize)
+	require.Equal(uint64(1), proVM.slotWindow[0].Height)
+
+	records, err := proVM.State.GetSlotWindow()
+	require.NoError(err)
+	require.Equal(proVM.slotWindow, records)
+}
+
+func TestHealthCheckReportsInnerVM(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		activationTime = time.Unix(0, 0)
+		durangoTime    = activationTime
+	)
+	coreVM, _, proVM, _ := initTestProposerVM(t, activationTime, durangoTime, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+
+	innerHealth := map[string]interface{}{"inner": true}
+	coreVM.HealthCheckF = func(context.Context) (interface{}, error) {
+		return innerHealth, errUnhealthyInnerVM
+	}
+	for height := uint64(0); height < minAssignedSlots; height++ {
+		require.NoError(proVM.recordProposerSlot(state.SlotRecord{
+			Height: height,
+		}))
+	}
+
+	// The inner VM's health is reported unchanged.
+	health, err := proVM.HealthCheck(context.Background())
+	require.ErrorIs(err, errUnhealthyInnerVM)
+	require.Equal(innerHealth, health)
+
+	// The proposer slots are reported by their own health check.
+	health, err = proVM.ProposerHealthCheck(context.Background())
+	require.ErrorIs(err, errMissedProposerSlots)
+	require.Equal(
+		proposerSlotHealth{
+			AssignedSlots:    minAssignedSlots,
+			MissedSlots:      minAssignedSlots,
+			LastMissedHeight: minAssignedSlots - 1,
+		},
+		health,
+	)
+}
+
+// makeSlotRecords returns [numMissed] missed slots followed by [numProduced]
+// produced slots.
+func makeSlotRecords(numMissed, numProduced int) []state.SlotRecord {
+	records := make([]state.SlotRecord, 0, numMissed+numProduced)
+	for i := 0; i < numMissed; i++ {
+		records = append(records, state.SlotRecord{
+			Height: uint64(len(records)),
+		})
+	}
+	for i := 0; i < numProduced; i++ {
+		records = append(records, state.SlotRecord{
+			Height:   uint64(len(records)),
+			Produced: true,
+		})
+	}
+	return records
+}
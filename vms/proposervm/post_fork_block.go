@@ -5,11 +5,17 @@ package proposervm
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"go.uber.org/zap"
+
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/vms/proposervm/block"
+	"github.com/ava-labs/avalanchego/vms/proposervm/proposer"
+	"github.com/ava-labs/avalanchego/vms/proposervm/state"
 )
 
 var _ PostForkBlock = (*postForkBlock)(nil)
@@ -22,6 +28,11 @@ type postForkBlock struct {
 	// It is populated in verifyPostDurangoBlockDelay.
 	// It is used to report metrics during Accept.
 	slot *uint64
+	// parentTimestamp and parentPChainHeight are the timestamp and P-chain
+	// height of the parent block.
+	// They are populated along with [slot].
+	parentTimestamp    time.Time
+	parentPChainHeight uint64
 }
 
 // Accept:
@@ -29,6 +40,9 @@ type postForkBlock struct {
 // 2) Persists this block in storage
 // 3) Calls Reject() on siblings of this block and their descendants.
 func (b *postForkBlock) Accept(ctx context.Context) error {
+	if err := b.recordProposerSlot(ctx); err != nil {
+		return err
+	}
 	if err := b.acceptOuterBlk(); err != nil {
 		return err
 	}
@@ -37,6 +51,7 @@ func (b *postForkBlock) Accept(ctx context.Context) error {
 	}
 	if b.slot != nil {
 		b.vm.acceptedBlocksSlotHistogram.Observe(float64(*b.slot))
+		b.vm.lastAcceptedSlotGauge.Set(float64(*b.slot))
 	}
 	b.updateLastAcceptedTimestampMetric(outerBlockTypeMetricLabel, b.Timestamp())
 	b.updateLastAcceptedTimestampMetric(innerBlockTypeMetricLabel, b.innerBlk.Timestamp())
@@ -53,6 +68,54 @@ func (b *postForkBlock) updateLastAcceptedTimestampMetric(blockTypeLabel string,
 	g.Set(float64(t.Unix()))
 }
 
+// recordProposerSlot records whether this node produced this block or missed
+// its proposer slot at this block's height.
+//
+// Slots are only recorded during normal operation, as this node can't be
+// expected to propose blocks while it is bootstrapping.
+func (b *postForkBlock) recordProposerSlot(ctx context.Context) error {
+	if b.slot == nil || b.vm.consensusState != snow.NormalOp {
+		return nil
+	}
+
+	nodeID := b.vm.ctx.NodeID
+	produced := b.Proposer() == nodeID
+	if !produced {
+		if *b.slot == 0 {
+			return nil
+		}
+
+		windower := b.vm.postDurangoWindower(b.parentTimestamp)
+		delay, err := windower.MinDelayForProposer(
+			ctx,
+			b.Height(),
+			b.parentPChainHeight,
+			nodeID,
+			0,
+		)
+		switch {
+		case errors.Is(err, proposer.ErrAnyoneCanPropose):
+			return nil
+		case err != nil:
+			b.vm.ctx.Log.Debug("failed to calculate proposer window",
+				zap.Stringer("blkID", b.ID()),
+				zap.Error(err),
+			)
+			return nil
+		}
+
+		if nodeSlot := uint64(delay / windower.SlotDuration()); nodeSlot >= *b.slot {
+			return nil
+		}
+		b.vm.missedProposerWindowsCounter.Inc()
+	}
+
+	return b.vm.recordProposerSlot(state.SlotRecord{
+		Height:   b.Height(),
+		Produced: produced,
+	})
+}
+
 func (b *postForkBlock) acceptOuterBlk() error {
 	// Update in-memory references
 	b.vm.lastAcceptedTime = b.Timestamp()
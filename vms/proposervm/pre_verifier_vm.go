@@ -0,0 +1,28 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+)
+
+var _ block.PreVerifierChainVM = (*VM)(nil)
+
+// PreVerify pre-verifies the inner block of [blk] with the inner VM.
+func (vm *VM) PreVerify(ctx context.Context, blk snowman.Block) error {
+	preVerifyVM, ok := vm.ChainVM.(block.PreVerifierChainVM)
+	if !ok {
+		return block.ErrPreVerifyNotImplemented
+	}
+
+	proBlk, ok := blk.(Block)
+	if !ok {
+		return fmt.Errorf("%w: %T", errUnexpectedBlockType, blk)
+	}
+	return preVerifyVM.PreVerify(ctx, proBlk.getInnerBlk())
+}
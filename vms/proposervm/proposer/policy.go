@@ -0,0 +1,113 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+)
+
+const (
+	// StakeWeightedPolicy samples proposers by stake weight. This is the
+	// default policy.
+	StakeWeightedPolicy = "stake-weighted"
+	// RoundRobinPolicy assigns slots to validators in order of their node
+	// IDs, regardless of their stake weight.
+	RoundRobinPolicy = "round-robin"
+)
+
+var (
+	ErrUnknownPolicy         = errors.New("unknown proposer policy")
+	ErrInvalidSlotDuration   = errors.New("invalid slot duration")
+	ErrMissingActivationTime = errors.New("missing activation time")
+)
+
+// PolicyConfig specifies the policy used to select the proposers of
+// Post-Durango blocks.
+//
+// Every validator of a chain must use the same policy config, otherwise they
+// will disagree on the validity of blocks. Changes must therefore be scheduled
+// with an activation time.
+type PolicyConfig struct {
+	// Policy is the name of the proposer selection policy. Defaults to
+	// [StakeWeightedPolicy].
+	Policy string `json:"policy" yaml:"policy"`
+	// SlotDuration is the duration of each slot. It must be a whole number of
+	// seconds, as block timestamps are only specific to the second. Defaults
+	// to [WindowDuration].
+	SlotDuration time.Duration `json:"slotDuration" yaml:"slotDuration"`
+	// ActivationTime is the time at which the policy is activated. The policy
+	// is used to select the proposers of blocks whose parent's timestamp is at
+	// or after the activation time.
+	//
+	// It must be set unless the policy is the default policy. It must be a
+	// future time agreed upon by all validators of the chain, otherwise
+	// previously accepted blocks would be verified with a different proposer
+	// schedule than the one they were built with.
+	ActivationTime time.Time `json:"activationTime" yaml:"activationTime"`
+}
+
+func (c *PolicyConfig) Verify() error {
+	switch c.Policy {
+	case "", StakeWeightedPolicy, RoundRobinPolicy:
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownPolicy, c.Policy)
+	}
+
+	if c.SlotDuration < 0 || c.SlotDuration%time.Second != 0 {
+		return fmt.Errorf("%w: %s must be a non-negative whole number of seconds",
+			ErrInvalidSlotDuration,
+			c.SlotDuration,
+		)
+	}
+
+	if !c.isDefault() && c.ActivationTime.IsZero() {
+		return fmt.Errorf("%w: required by policy %q with slot duration %s",
+			ErrMissingActivationTime,
+			c.Policy,
+			c.SlotDuration,
+		)
+	}
+	return nil
+}
+
+// isDefault returns true if the config selects the same proposers as the
+// default snowman++ proposer selection.
+func (c *PolicyConfig) isDefault() bool {
+	return (c.Policy == "" || c.Policy == StakeWeightedPolicy) &&
+		(c.SlotDuration == 0 || c.SlotDuration == WindowDuration)
+}
+
+// IsActivated returns true if the policy should be used to select the
+// proposers of children of a block with [parentTimestamp].
+func (c *PolicyConfig) IsActivated(parentTimestamp time.Time) bool {
+	return !parentTimestamp.Before(c.ActivationTime)
+}
+
+// NewWindower returns the windower that implements the configured policy.
+func (c *PolicyConfig) NewWindower(
+	state validators.State,
+	subnetID,
+	chainID ids.ID,
+) (Windower, error) {
+	if err := c.Verify(); err != nil {
+		return nil, err
+	}
+
+	slotDuration := c.SlotDuration
+	if slotDuration == 0 {
+		slotDuration = WindowDuration
+	}
+
+	switch c.Policy {
+	case RoundRobinPolicy:
+		return NewRoundRobin(state, subnetID, slotDuration), nil
+	default:
+		return NewStakeWeighted(state, subnetID, chainID, slotDuration), nil
+	}
+}
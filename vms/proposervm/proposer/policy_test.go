@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyConfigVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      PolicyConfig
+		expectedErr error
+	}{
+		{
+			name:   "default",
+			config: PolicyConfig{},
+		},
+		{
+			name: "explicit default",
+			config: PolicyConfig{
+				Policy:       StakeWeightedPolicy,
+				SlotDuration: WindowDuration,
+			},
+		},
+		{
+			name: "round robin",
+			config: PolicyConfig{
+				Policy:         RoundRobinPolicy,
+				SlotDuration:   2 * time.Second,
+				ActivationTime: time.Unix(1_000, 0),
+			},
+		},
+		{
+			name: "round robin without activation time",
+			config: PolicyConfig{
+				Policy:       RoundRobinPolicy,
+				SlotDuration: 2 * time.Second,
+			},
+			expectedErr: ErrMissingActivationTime,
+		},
+		{
+			name: "slot duration without activation time",
+			config: PolicyConfig{
+				SlotDuration: time.Second,
+			},
+			expectedErr: ErrMissingActivationTime,
+		},
+		{
+			name: "unknown policy",
+			config: PolicyConfig{
+				Policy: "random",
+			},
+			expectedErr: ErrUnknownPolicy,
+		},
+		{
+			name: "negative slot duration",
+			config: PolicyConfig{
+				SlotDuration: -time.Second,
+			},
+			expectedErr: ErrInvalidSlotDuration,
+		},
+		{
+			name: "fractional slot duration",
+			config: PolicyConfig{
+				SlotDuration: 1500 * time.Millisecond,
+			},
+			expectedErr: ErrInvalidSlotDuration,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Verify()
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestPolicyConfigIsActivated(t *testing.T) {
+	require := require.New(t)
+
+	activationTime := time.Unix(1_000, 0)
+	config := PolicyConfig{
+		ActivationTime: activationTime,
+	}
+	require.False(config.IsActivated(activationTime.Add(-time.Second)))
+	require.True(config.IsActivated(activationTime))
+	require.True(config.IsActivated(activationTime.Add(time.Second)))
+}
+
+func TestPolicyConfigNewWindower(t *testing.T) {
+	tests := []struct {
+		name                 string
+		config               PolicyConfig
+		expectedType         Windower
+		expectedSlotDuration time.Duration
+	}{
+		{
+			name:                 "default",
+			config:               PolicyConfig{},
+			expectedType:         &windower{},
+			expectedSlotDuration: WindowDuration,
+		},
+		{
+			name: "stake weighted",
+			config: PolicyConfig{
+				Policy:         StakeWeightedPolicy,
+				SlotDuration:   time.Second,
+				ActivationTime: time.Unix(1_000, 0),
+			},
+			expectedType:         &windower{},
+			expectedSlotDuration: time.Second,
+		},
+		{
+			name: "round robin",
+			config: PolicyConfig{
+				Policy:         RoundRobinPolicy,
+				SlotDuration:   2 * time.Second,
+				ActivationTime: time.Unix(1_000, 0),
+			},
+			expectedType:         &roundRobin{},
+			expectedSlotDuration: 2 * time.Second,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			w, err := test.config.NewWindower(
+				makeValidatorState(t, nil),
+				subnetID,
+				randomChainID,
+			)
+			require.NoError(err)
+			require.IsType(test.expectedType, w)
+			require.Equal(test.expectedSlotDuration, w.SlotDuration())
+		})
+	}
+}
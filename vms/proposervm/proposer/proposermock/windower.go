@@ -101,3 +101,17 @@ func (mr *WindowerMockRecorder) Proposers(ctx, blockHeight, pChainHeight, maxWin
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Proposers", reflect.TypeOf((*Windower)(nil).Proposers), ctx, blockHeight, pChainHeight, maxWindows)
 }
+
+// SlotDuration mocks base method.
+func (m *Windower) SlotDuration() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlotDuration")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// SlotDuration indicates an expected call of SlotDuration.
+func (mr *WindowerMockRecorder) SlotDuration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlotDuration", reflect.TypeOf((*Windower)(nil).SlotDuration))
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposer
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils"
+)
+
+var _ Windower = (*roundRobin)(nil)
+
+// roundRobin assigns proposers by iterating over the validator set, sorted by
+// node ID. The proposer of [slot] at [blockHeight] is the validator at index
+// (blockHeight + slot) mod the number of validators, so that every validator
+// is expected to propose one of every n blocks.
+type roundRobin struct {
+	state        validators.State
+	subnetID     ids.ID
+	slotDuration time.Duration
+}
+
+// NewRoundRobin returns a windower that assigns proposers in a round-robin
+// order using Post-Durango slots of [slotDuration].
+func NewRoundRobin(state validators.State, subnetID ids.ID, slotDuration time.Duration) Windower {
+	return &roundRobin{
+		state:        state,
+		subnetID:     subnetID,
+		slotDuration: slotDuration,
+	}
+}
+
+func (r *roundRobin) Proposers(ctx context.Context, blockHeight, pChainHeight uint64, maxWindows int) ([]ids.NodeID, error) {
+	nodeIDs, err := r.validators(ctx, pChainHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	numValidators := uint64(len(nodeIDs))
+	numProposers := min(uint64(maxWindows), numValidators)
+	proposers := make([]ids.NodeID, numProposers)
+	for i := range proposers {
+		proposers[i] = nodeIDs[r.index(blockHeight, uint64(i), numValidators)]
+	}
+	return proposers, nil
+}
+
+func (r *roundRobin) Delay(ctx context.Context, blockHeight, pChainHeight uint64, validatorID ids.NodeID, maxWindows int) (time.Duration, error) {
+	if validatorID == ids.EmptyNodeID {
+		return time.Duration(maxWindows) * WindowDuration, nil
+	}
+
+	proposers, err := r.Proposers(ctx, blockHeight, pChainHeight, maxWindows)
+	if err != nil {
+		return 0, err
+	}
+
+	delay := time.Duration(0)
+	for _, nodeID := range proposers {
+		if nodeID == validatorID {
+			return delay, nil
+		}
+		delay += WindowDuration
+	}
+	return delay, nil
+}
+
+func (r *roundRobin) ExpectedProposer(
+	ctx context.Context,
+	blockHeight,
+	pChainHeight,
+	slot uint64,
+) (ids.NodeID, error) {
+	nodeIDs, err := r.validators(ctx, pChainHeight)
+	if err != nil {
+		return ids.EmptyNodeID, err
+	}
+	if len(nodeIDs) == 0 {
+		return ids.EmptyNodeID, ErrAnyoneCanPropose
+	}
+	return nodeIDs[r.index(blockHeight, slot, uint64(len(nodeIDs)))], nil
+}
+
+func (r *roundRobin) MinDelayForProposer(
+	ctx context.Context,
+	blockHeight,
+	pChainHeight uint64,
+	nodeID ids.NodeID,
+	startSlot uint64,
+) (time.Duration, error) {
+	nodeIDs, err := r.validators(ctx, pChainHeight)
+	if err != nil {
+		return 0, err
+	}
+	if len(nodeIDs) == 0 {
+		return 0, ErrAnyoneCanPropose
+	}
+
+	maxSlot := startSlot + MaxLookAheadSlots
+	index, ok := slices.BinarySearchFunc(nodeIDs, nodeID, ids.NodeID.Compare)
+	if !ok {
+		// no slots scheduled for the max window we inspect. Return max delay
+		return time.Duration(maxSlot) * r.slotDuration, nil
+	}
+
+	// Find the first slot at or after [startSlot] that maps to [index].
+	var (
+		numValidators = uint64(len(nodeIDs))
+		startIndex    = r.index(blockHeight, startSlot, numValidators)
+		slotsToWait   = (uint64(index) + numValidators - startIndex) % numValidators
+		slot          = min(startSlot+slotsToWait, maxSlot)
+	)
+	return time.Duration(slot) * r.slotDuration, nil
+}
+
+func (r *roundRobin) SlotDuration() time.Duration {
+	return r.slotDuration
+}
+
+// validators returns the canonically sorted node IDs of the validators at
+// [pChainHeight].
+func (r *roundRobin) validators(ctx context.Context, pChainHeight uint64) ([]ids.NodeID, error) {
+	validatorsMap, err := r.state.GetValidatorSet(ctx, pChainHeight, r.subnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(validatorsMap, ids.EmptyNodeID) // Ignore inactive ACP-77 validators.
+
+	nodeIDs := make([]ids.NodeID, 0, len(validatorsMap))
+	for nodeID := range validatorsMap {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	utils.Sort(nodeIDs)
+	return nodeIDs, nil
+}
+
+func (*roundRobin) index(blockHeight, slot, numValidators uint64) uint64 {
+	return (blockHeight%numValidators + slot%numValidators) % numValidators
+}
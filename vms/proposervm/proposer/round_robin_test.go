@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+)
+
+func TestRoundRobinNoValidators(t *testing.T) {
+	require := require.New(t)
+
+	w := NewRoundRobin(
+		makeValidatorState(t, []ids.NodeID{ids.EmptyNodeID}),
+		subnetID,
+		WindowDuration,
+	)
+
+	var (
+		ctx                 = context.Background()
+		chainHeight  uint64 = 1
+		pChainHeight uint64 = 0
+		nodeID              = ids.GenerateTestNodeID()
+	)
+	proposers, err := w.Proposers(ctx, chainHeight, pChainHeight, MaxVerifyWindows)
+	require.NoError(err)
+	require.Empty(proposers)
+
+	_, err = w.ExpectedProposer(ctx, chainHeight, pChainHeight, 0)
+	require.ErrorIs(err, ErrAnyoneCanPropose)
+
+	_, err = w.MinDelayForProposer(ctx, chainHeight, pChainHeight, nodeID, 0)
+	require.ErrorIs(err, ErrAnyoneCanPropose)
+}
+
+func TestRoundRobinExpectedProposer(t *testing.T) {
+	require := require.New(t)
+
+	validatorIDs := make([]ids.NodeID, 4)
+	for i := range validatorIDs {
+		validatorIDs[i] = ids.GenerateTestNodeID()
+	}
+	w := NewRoundRobin(makeValidatorState(t, validatorIDs), subnetID, WindowDuration)
+
+	utils.Sort(validatorIDs)
+
+	var (
+		ctx                 = context.Background()
+		pChainHeight uint64 = 0
+	)
+	for chainHeight := uint64(0); chainHeight < 8; chainHeight++ {
+		for slot := uint64(0); slot < 8; slot++ {
+			proposerID, err := w.ExpectedProposer(ctx, chainHeight, pChainHeight, slot)
+			require.NoError(err)
+			require.Equal(validatorIDs[(chainHeight+slot)%4], proposerID)
+		}
+	}
+
+	// Every validator should propose exactly once every 4 heights.
+	proposed := make(map[ids.NodeID]int)
+	for chainHeight := uint64(0); chainHeight < 4; chainHeight++ {
+		proposerID, err := w.ExpectedProposer(ctx, chainHeight, pChainHeight, 0)
+		require.NoError(err)
+		proposed[proposerID]++
+	}
+	require.Len(proposed, 4)
+	for _, count := range proposed {
+		require.Equal(1, count)
+	}
+
+	proposers, err := w.Proposers(ctx, 2, pChainHeight, MaxVerifyWindows)
+	require.NoError(err)
+	require.Equal(
+		[]ids.NodeID{validatorIDs[2], validatorIDs[3], validatorIDs[0], validatorIDs[1]},
+		proposers,
+	)
+
+	delay, err := w.Delay(ctx, 2, pChainHeight, validatorIDs[0], MaxVerifyWindows)
+	require.NoError(err)
+	require.Equal(2*WindowDuration, delay)
+}
+
+func TestRoundRobinCoherenceOfExpectedProposerAndMinDelayForProposer(t *testing.T) {
+	require := require.New(t)
+
+	validatorIDs := make([]ids.NodeID, 5)
+	for i := range validatorIDs {
+		validatorIDs[i] = ids.GenerateTestNodeID()
+	}
+
+	const slotDuration = 2 * time.Second
+	w := NewRoundRobin(makeValidatorState(t, validatorIDs), subnetID, slotDuration)
+	require.Equal(slotDuration, w.SlotDuration())
+
+	var (
+		ctx                 = context.Background()
+		chainHeight  uint64 = 7
+		pChainHeight uint64 = 0
+	)
+	for _, nodeID := range validatorIDs {
+		for startSlot := uint64(0); startSlot < 10; startSlot++ {
+			delay, err := w.MinDelayForProposer(ctx, chainHeight, pChainHeight, nodeID, startSlot)
+			require.NoError(err)
+			require.Zero(delay % slotDuration)
+
+			slot := uint64(delay / slotDuration)
+			require.GreaterOrEqual(slot, startSlot)
+			require.Less(slot, startSlot+uint64(len(validatorIDs)))
+
+			proposerID, err := w.ExpectedProposer(ctx, chainHeight, pChainHeight, slot)
+			require.NoError(err)
+			require.Equal(nodeID, proposerID)
+		}
+	}
+
+	// A node that isn't a validator should never be scheduled.
+	delay, err := w.MinDelayForProposer(ctx, chainHeight, pChainHeight, ids.GenerateTestNodeID(), 0)
+	require.NoError(err)
+	require.Equal(MaxLookAheadSlots*slotDuration, delay)
+}
@@ -93,22 +93,39 @@ type Windower interface {
 		nodeID ids.NodeID,
 		startSlot uint64,
 	) (time.Duration, error)
+
+	// SlotDuration returns the duration of each slot in the Post-Durango
+	// windowing scheme.
+	SlotDuration() time.Duration
 }
 
 // windower interfaces with P-Chain and it is responsible for calculating the
 // delay for the block submission window of a given validator
 type windower struct {
-	state       validators.State
-	subnetID    ids.ID
-	chainSource uint64
+	state        validators.State
+	subnetID     ids.ID
+	chainSource  uint64
+	slotDuration time.Duration
 }
 
 func New(state validators.State, subnetID, chainID ids.ID) Windower {
+	return NewStakeWeighted(state, subnetID, chainID, WindowDuration)
+}
+
+// NewStakeWeighted returns a windower that samples proposers by stake weight
+// using Post-Durango slots of [slotDuration].
+func NewStakeWeighted(
+	state validators.State,
+	subnetID,
+	chainID ids.ID,
+	slotDuration time.Duration,
+) Windower {
 	w := wrappers.Packer{Bytes: chainID[:]}
 	return &windower{
-		state:       state,
-		subnetID:    subnetID,
-		chainSource: w.UnpackLong(),
+		state:        state,
+		subnetID:     subnetID,
+		chainSource:  w.UnpackLong(),
+		slotDuration: slotDuration,
 	}
 }
 
@@ -218,12 +235,16 @@ func (w *windower) MinDelayForProposer(
 		}
 
 		if expectedNodeID == nodeID {
-			return time.Duration(slot) * WindowDuration, nil
+			return time.Duration(slot) * w.slotDuration, nil
 		}
 	}
 
 	// no slots scheduled for the max window we inspect. Return max delay
-	return time.Duration(maxSlot) * WindowDuration, nil
+	return time.Duration(maxSlot) * w.slotDuration, nil
+}
+
+func (w *windower) SlotDuration() time.Duration {
+	return w.slotDuration
 }
 
 func (w *windower) makeSampler(
@@ -281,8 +302,14 @@ func (w *windower) expectedProposer(
 }
 
 func TimeToSlot(start, now time.Time) uint64 {
+	return TimeToSlotWithDuration(start, now, WindowDuration)
+}
+
+// TimeToSlotWithDuration returns the slot that [now] falls into when slots of
+// [slotDuration] start at [start].
+func TimeToSlotWithDuration(start, now time.Time, slotDuration time.Duration) uint64 {
 	if now.Before(start) {
 		return 0
 	}
-	return uint64(now.Sub(start) / WindowDuration)
+	return uint64(now.Sub(start) / slotDuration)
 }
@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/proposervm/proposer"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+var errTooManySlots = fmt.Errorf("numSlots must be <= %d", proposer.MaxLookAheadSlots)
+
+// Service exposes the proposer schedule of the chain.
+type Service struct {
+	vm *VM
+}
+
+type GetProposerScheduleArgs struct {
+	// Height of the block to be proposed. If 0, the height after the last
+	// accepted block is used.
+	Height avajson.Uint64 `json:"height"`
+	// PChainHeight that defines the validator set. If 0, the P-chain height
+	// of the last accepted block is used.
+	PChainHeight avajson.Uint64 `json:"pChainHeight"`
+	// NumSlots is the number of post-Durango slots to report. If 0,
+	// [proposer.MaxVerifyWindows] slots are reported.
+	NumSlots avajson.Uint64 `json:"numSlots"`
+}
+
+// ProposerSlot is the expected proposer of a post-Durango slot. Slot i starts
+// i * SlotDuration after the parent block's timestamp.
+type ProposerSlot struct {
+	Slot   avajson.Uint64 `json:"slot"`
+	NodeID ids.NodeID     `json:"nodeID"`
+}
+
+type GetProposerScheduleReply struct {
+	Height       avajson.Uint64 `json:"height"`
+	PChainHeight avajson.Uint64 `json:"pChainHeight"`
+	// AnyoneCanPropose is true if there are no validators at [PChainHeight],
+	// in which case [Proposers] and [Slots] are empty.
+	AnyoneCanPropose bool `json:"anyoneCanPropose"`
+	// Proposers is the ordered list of proposers under the pre-Durango
+	// windowing scheme.
+	Proposers []ids.NodeID `json:"proposers"`
+	// SlotDuration is the duration, in seconds, of each post-Durango slot.
+	SlotDuration avajson.Uint64 `json:"slotDuration"`
+	// Slots are the expected proposers of the first slots under the
+	// post-Durango windowing scheme. The proposer selection policy active
+	// after the last accepted block is used.
+	Slots []ProposerSlot `json:"slots"`
+	// NodeID is the ID of this node.
+	NodeID ids.NodeID `json:"nodeID"`
+	// NodeSlot is the first post-Durango slot this node may propose in. It is
+	// omitted if this node has no slot within [proposer.MaxLookAheadSlots].
+	NodeSlot *avajson.Uint64 `json:"nodeSlot,omitempty"`
+}
+
+// GetProposerSchedule returns the proposers that are expected to build the
+// block at the requested height.
+func (s *Service) GetProposerSchedule(r *http.Request, args *GetProposerScheduleArgs, reply *GetProposerScheduleReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "proposervm"),
+		zap.String("method", "getProposerSchedule"),
+		zap.Uint64("height", uint64(args.Height)),
+		zap.Uint64("pChainHeight", uint64(args.PChainHeight)),
+		zap.Uint64("numSlots", uint64(args.NumSlots)),
+	)
+
+	numSlots := uint64(args.NumSlots)
+	switch {
+	case numSlots == 0:
+		numSlots = proposer.MaxVerifyWindows
+	case numSlots > proposer.MaxLookAheadSlots:
+		return errTooManySlots
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	ctx := r.Context()
+	lastAcceptedID, err := s.vm.LastAccepted(ctx)
+	if err != nil {
+		return fmt.Errorf("couldn't get last accepted block ID: %w", err)
+	}
+	lastAccepted, err := s.vm.getBlock(ctx, lastAcceptedID)
+	if err != nil {
+		return fmt.Errorf("couldn't get last accepted block %s: %w", lastAcceptedID, err)
+	}
+
+	height := uint64(args.Height)
+	if height == 0 {
+		height = lastAccepted.Height() + 1
+	}
+	pChainHeight := uint64(args.PChainHeight)
+	if pChainHeight == 0 {
+		pChainHeight, err = lastAccepted.pChainHeight(ctx)
+		if err != nil {
+			return fmt.Errorf("couldn't get P-chain height of block %s: %w", lastAcceptedID, err)
+		}
+	}
+
+	reply.Height = avajson.Uint64(height)
+	reply.PChainHeight = avajson.Uint64(pChainHeight)
+	reply.NodeID = s.vm.ctx.NodeID
+
+	proposers, err := s.vm.Windower.Proposers(ctx, height, pChainHeight, proposer.MaxVerifyWindows)
+	if err != nil {
+		return fmt.Errorf("couldn't get proposers: %w", err)
+	}
+	reply.Proposers = proposers
+
+	windower := s.vm.postDurangoWindower(lastAccepted.Timestamp())
+	reply.SlotDuration = avajson.Uint64(windower.SlotDuration() / time.Second)
+	reply.Slots = make([]ProposerSlot, 0, numSlots)
+	for slot := uint64(0); slot < numSlots; slot++ {
+		nodeID, err := windower.ExpectedProposer(ctx, height, pChainHeight, slot)
+		if errors.Is(err, proposer.ErrAnyoneCanPropose) {
+			reply.AnyoneCanPropose = true
+			reply.Slots = nil
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("couldn't get expected proposer of slot %d: %w", slot, err)
+		}
+		reply.Slots = append(reply.Slots, ProposerSlot{
+			Slot:   avajson.Uint64(slot),
+			NodeID: nodeID,
+		})
+	}
+
+	delay, err := windower.MinDelayForProposer(ctx, height, pChainHeight, s.vm.ctx.NodeID, 0)
+	if err != nil {
+		return fmt.Errorf("couldn't get the delay of %s: %w", s.vm.ctx.NodeID, err)
+	}
+	if nodeSlot := uint64(delay / windower.SlotDuration()); nodeSlot < proposer.MaxLookAheadSlots {
+		reply.NodeSlot = (*avajson.Uint64)(&nodeSlot)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/vms/proposervm/proposer"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+func TestServiceGetProposerSchedule(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		activationTime = time.Unix(0, 0)
+		durangoTime    = activationTime
+	)
+	_, _, proVM, _ := initTestProposerVM(t, activationTime, durangoTime, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+
+	var (
+		ctx     = context.Background()
+		service = &Service{vm: proVM}
+		req     = httptest.NewRequest(http.MethodPost, "/", nil)
+		args    = &GetProposerScheduleArgs{
+			Height:       1,
+			PChainHeight: avajson.Uint64(defaultPChainHeight),
+			NumSlots:     10,
+		}
+		reply GetProposerScheduleReply
+	)
+	require.NoError(service.GetProposerSchedule(req, args, &reply))
+	require.Equal(args.Height, reply.Height)
+	require.Equal(args.PChainHeight, reply.PChainHeight)
+	require.Equal(proVM.ctx.NodeID, reply.NodeID)
+	require.False(reply.AnyoneCanPropose)
+
+	expectedProposers, err := proVM.Windower.Proposers(ctx, 1, defaultPChainHeight, proposer.MaxVerifyWindows)
+	require.NoError(err)
+	require.Equal(expectedProposers, reply.Proposers)
+
+	require.Len(reply.Slots, 10)
+	for i, slot := range reply.Slots {
+		require.Equal(avajson.Uint64(i), slot.Slot)
+
+		expectedProposer, err := proVM.Windower.ExpectedProposer(ctx, 1, defaultPChainHeight, uint64(i))
+		require.NoError(err)
+		require.Equal(expectedProposer, slot.NodeID)
+	}
+
+	delay, err := proVM.Windower.MinDelayForProposer(ctx, 1, defaultPChainHeight, proVM.ctx.NodeID, 0)
+	require.NoError(err)
+	require.NotNil(reply.NodeSlot)
+	require.Equal(avajson.Uint64(delay/proposer.WindowDuration), *reply.NodeSlot)
+
+	args.NumSlots = proposer.MaxLookAheadSlots + 1
+	err = service.GetProposerSchedule(req, args, &GetProposerScheduleReply{})
+	require.ErrorIs(err, errTooManySlots)
+}
+
+func TestServiceGetProposerScheduleDefaults(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		activationTime = time.Unix(0, 0)
+		durangoTime    = activationTime
+	)
+	_, _, proVM, _ := initTestProposerVM(t, activationTime, durangoTime, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+
+	var (
+		service = &Service{vm: proVM}
+		req     = httptest.NewRequest(http.MethodPost, "/", nil)
+		reply   GetProposerScheduleReply
+	)
+	require.NoError(service.GetProposerSchedule(req, &GetProposerScheduleArgs{}, &reply))
+
+	// The last accepted block is the pre-fork genesis block.
+	require.Equal(avajson.Uint64(1), reply.Height)
+	require.Zero(reply.PChainHeight)
+	require.Len(reply.Slots, proposer.MaxVerifyWindows)
+}
+
+func TestCreateHandlers(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		activationTime = time.Unix(0, 0)
+		durangoTime    = activationTime
+	)
+	coreVM, _, proVM, _ := initTestProposerVM(t, activationTime, durangoTime, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+
+	innerHandler := http.NotFoundHandler()
+	coreVM.CreateHandlersF = func(context.Context) (map[string]http.Handler, error) {
+		return map[string]http.Handler{
+			"": innerHandler,
+		}, nil
+	}
+
+	handlers, err := proVM.CreateHandlers(context.Background())
+	require.NoError(err)
+	require.Len(handlers, 2)
+	require.Contains(handlers, "")
+	require.Contains(handlers, "/proposervm")
+}
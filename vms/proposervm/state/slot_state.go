@@ -0,0 +1,65 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"github.com/ava-labs/avalanchego/database"
+)
+
+const (
+	slotWindowByte byte = iota
+)
+
+var (
+	slotWindowKey = []byte{slotWindowByte}
+
+	_ SlotState = (*slotState)(nil)
+)
+
+// SlotRecord is the outcome of a proposer slot that was assigned to the local
+// node.
+type SlotRecord struct {
+	// Height of the block that was proposed in, or after, the slot.
+	Height uint64 `serialize:"true"`
+	// Produced is true if the local node proposed the accepted block.
+	Produced bool `serialize:"true"`
+}
+
+type SlotState interface {
+	// GetSlotWindow returns the most recently recorded proposer slots, ordered
+	// from oldest to newest.
+	GetSlotWindow() ([]SlotRecord, error)
+	// SetSlotWindow replaces the recorded proposer slots with [records].
+	SetSlotWindow(records []SlotRecord) error
+}
+
+type slotState struct {
+	db database.Database
+}
+
+func NewSlotState(db database.Database) SlotState {
+	return &slotState{db: db}
+}
+
+func (s *slotState) GetSlotWindow() ([]SlotRecord, error) {
+	recordsBytes, err := s.db.Get(slotWindowKey)
+	if err == database.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var records []SlotRecord
+	_, err = Codec.Unmarshal(recordsBytes, &records)
+	return records, err
+}
+
+func (s *slotState) SetSlotWindow(records []SlotRecord) error {
+	recordsBytes, err := Codec.Marshal(CodecVersion, records)
+	if err != nil {
+		return err
+	}
+	return s.db.Put(slotWindowKey, recordsBytes)
+}
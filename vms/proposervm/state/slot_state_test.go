@@ -0,0 +1,45 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+)
+
+func TestSlotState(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	ss := NewSlotState(db)
+
+	records, err := ss.GetSlotWindow()
+	require.NoError(err)
+	require.Empty(records)
+
+	expectedRecords := []SlotRecord{
+		{
+			Height:   1,
+			Produced: true,
+		},
+		{
+			Height:   5,
+			Produced: false,
+		},
+	}
+	require.NoError(ss.SetSlotWindow(expectedRecords))
+
+	records, err = ss.GetSlotWindow()
+	require.NoError(err)
+	require.Equal(expectedRecords, records)
+
+	// The window should be persisted.
+	ss = NewSlotState(db)
+	records, err = ss.GetSlotWindow()
+	require.NoError(err)
+	require.Equal(expectedRecords, records)
+}
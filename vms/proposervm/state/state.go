@@ -14,29 +14,34 @@ var (
 	chainStatePrefix  = []byte("chain")
 	blockStatePrefix  = []byte("block")
 	heightIndexPrefix = []byte("height")
+	slotStatePrefix   = []byte("slot")
 )
 
 type State interface {
 	ChainState
 	BlockState
 	HeightIndex
+	SlotState
 }
 
 type state struct {
 	ChainState
 	BlockState
 	HeightIndex
+	SlotState
 }
 
 func New(db *versiondb.Database) State {
 	chainDB := prefixdb.New(chainStatePrefix, db)
 	blockDB := prefixdb.New(blockStatePrefix, db)
 	heightDB := prefixdb.New(heightIndexPrefix, db)
+	slotDB := prefixdb.New(slotStatePrefix, db)
 
 	return &state{
 		ChainState:  NewChainState(chainDB),
 		BlockState:  NewBlockState(blockDB),
 		HeightIndex: NewHeightIndex(heightDB, db),
+		SlotState:   NewSlotState(slotDB),
 	}
 }
 
@@ -44,6 +49,7 @@ func NewMetered(db *versiondb.Database, namespace string, metrics prometheus.Reg
 	chainDB := prefixdb.New(chainStatePrefix, db)
 	blockDB := prefixdb.New(blockStatePrefix, db)
 	heightDB := prefixdb.New(heightIndexPrefix, db)
+	slotDB := prefixdb.New(slotStatePrefix, db)
 
 	blockState, err := NewMeteredBlockState(blockDB, namespace, metrics)
 	if err != nil {
@@ -54,5 +60,6 @@ func NewMetered(db *versiondb.Database, namespace string, metrics prometheus.Reg
 		ChainState:  NewChainState(chainDB),
 		BlockState:  blockState,
 		HeightIndex: NewHeightIndex(heightDB, db),
+		SlotState:   NewSlotState(slotDB),
 	}, nil
 }
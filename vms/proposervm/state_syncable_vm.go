@@ -22,6 +22,15 @@ func (vm *VM) StateSyncEnabled(ctx context.Context) (bool, error) {
 	return vm.ssVM.StateSyncEnabled(ctx)
 }
 
+func (vm *VM) StateSyncProgress(ctx context.Context) (block.StateSyncProgress, error) {
+	progressVM, ok := vm.ChainVM.(block.StateSyncProgressVM)
+	if !ok {
+		return block.StateSyncProgress{}, block.ErrStateSyncProgressNotImplemented
+	}
+
+	return progressVM.StateSyncProgress(ctx)
+}
+
 func (vm *VM) GetOngoingSyncStateSummary(ctx context.Context) (block.StateSummary, error) {
 	if vm.ssVM == nil {
 		return nil, block.ErrStateSyncableVMNotImplemented
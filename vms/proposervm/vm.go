@@ -7,8 +7,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
+	"github.com/gorilla/rpc/v2"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 
@@ -23,6 +25,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/utils/units"
@@ -47,9 +50,10 @@ const (
 )
 
 var (
-	_ block.ChainVM         = (*VM)(nil)
-	_ block.BatchedChainVM  = (*VM)(nil)
-	_ block.StateSyncableVM = (*VM)(nil)
+	_ block.ChainVM             = (*VM)(nil)
+	_ block.BatchedChainVM      = (*VM)(nil)
+	_ block.StateSyncableVM     = (*VM)(nil)
+	_ block.StateSyncProgressVM = (*VM)(nil)
 
 	dbPrefix = []byte("proposervm")
 )
@@ -68,6 +72,9 @@ type VM struct {
 	state.State
 
 	proposer.Windower
+	// policyWindower, if non-nil, replaces [Windower] once
+	// [Config.ProposerPolicy] is activated.
+	policyWindower proposer.Windower
 	tree.Tree
 	scheduler.Scheduler
 	mockable.Clock
@@ -106,6 +113,24 @@ type VM struct {
 	// proposed in.
 	acceptedBlocksSlotHistogram prometheus.Histogram
 
+	// lastAcceptedSlotGauge reports the slot that the last accepted block was
+	// proposed in.
+	lastAcceptedSlotGauge prometheus.Gauge
+
+	// missedProposerWindowsCounter reports the number of accepted blocks that
+	// were proposed by another node after this node's slot had started.
+	missedProposerWindowsCounter prometheus.Counter
+
+	// assignedProposerSlotsCounter and producedProposerSlotsCounter report
+	// the number of proposer slots assigned to this node and the number of
+	// those slots in which this node produced the accepted block.
+	assignedProposerSlotsCounter prometheus.Counter
+	producedProposerSlotsCounter prometheus.Counter
+
+	// slotWindow is the rolling window of the most recently assigned proposer
+	// slots, ordered from oldest to newest.
+	slotWindow []state.SlotRecord
+
 	// lastAcceptedTimestampGaugeVec reports timestamps for the last-accepted
 	// [postForkBlock] and its inner block.
 	lastAcceptedTimestampGaugeVec *prometheus.GaugeVec
@@ -147,7 +172,21 @@ func (vm *VM) Initialize(
 		return err
 	}
 	vm.State = baseState
+	vm.slotWindow, err = vm.State.GetSlotWindow()
+	if err != nil {
+		return err
+	}
 	vm.Windower = proposer.New(chainCtx.ValidatorState, chainCtx.SubnetID, chainCtx.ChainID)
+	if vm.ProposerPolicy != nil {
+		vm.policyWindower, err = vm.ProposerPolicy.NewWindower(
+			chainCtx.ValidatorState,
+			chainCtx.SubnetID,
+			chainCtx.ChainID,
+		)
+		if err != nil {
+			return err
+		}
+	}
 	vm.Tree = tree.New()
 	innerBlkCache, err := metercacher.New(
 		"inner_block_cache",
@@ -235,6 +274,22 @@ func (vm *VM) Initialize(
 		// of comparing floating point of the same numerical value.
 		Buckets: []float64{0.5, 1.5, 2.5},
 	})
+	vm.lastAcceptedSlotGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "last_accepted_slot",
+		Help: "the slot the last accepted block was proposed in",
+	})
+	vm.missedProposerWindowsCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "missed_proposer_windows",
+		Help: "number of accepted blocks proposed by another node after this node's slot had started",
+	})
+	vm.assignedProposerSlotsCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "assigned_proposer_slots",
+		Help: "number of proposer slots assigned to this node",
+	})
+	vm.producedProposerSlotsCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "produced_proposer_slots",
+		Help: "number of assigned proposer slots in which this node produced the accepted block",
+	})
 	vm.lastAcceptedTimestampGaugeVec = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "last_accepted_timestamp",
@@ -246,10 +301,36 @@ func (vm *VM) Initialize(
 	return errors.Join(
 		vm.Config.Registerer.Register(vm.proposerBuildSlotGauge),
 		vm.Config.Registerer.Register(vm.acceptedBlocksSlotHistogram),
+		vm.Config.Registerer.Register(vm.lastAcceptedSlotGauge),
+		vm.Config.Registerer.Register(vm.missedProposerWindowsCounter),
+		vm.Config.Registerer.Register(vm.assignedProposerSlotsCounter),
+		vm.Config.Registerer.Register(vm.producedProposerSlotsCounter),
 		vm.Config.Registerer.Register(vm.lastAcceptedTimestampGaugeVec),
 	)
 }
 
+// CreateHandlers returns the handlers of the inner VM along with the
+// proposervm service.
+func (vm *VM) CreateHandlers(ctx context.Context) (map[string]http.Handler, error) {
+	handlers, err := vm.ChainVM.CreateHandlers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	server := rpc.NewServer()
+	server.RegisterCodec(json.NewCodec(), "application/json")
+	server.RegisterCodec(json.NewCodec(), "application/json;charset=UTF-8")
+	if err := server.RegisterService(&Service{vm: vm}, "proposervm"); err != nil {
+		return nil, err
+	}
+
+	if handlers == nil {
+		handlers = make(map[string]http.Handler, 1)
+	}
+	handlers["/proposervm"] = server
+	return handlers, nil
+}
+
 // shutdown ops then propagate shutdown to innerVM
 func (vm *VM) Shutdown(ctx context.Context) error {
 	vm.onShutdown()
@@ -341,15 +422,18 @@ func (vm *VM) SetPreference(ctx context.Context, preferred ids.ID) error {
 		nextStartTime    time.Time
 	)
 	if vm.Upgrades.IsDurangoActivated(parentTimestamp) {
-		currentTime := vm.Clock.Time().Truncate(time.Second)
+		var (
+			currentTime  = vm.Clock.Time().Truncate(time.Second)
+			slotDuration = vm.postDurangoWindower(parentTimestamp).SlotDuration()
+		)
 		if nextStartTime, err = vm.getPostDurangoSlotTime(
 			ctx,
 			childBlockHeight,
 			pChainHeight,
-			proposer.TimeToSlot(parentTimestamp, currentTime),
+			proposer.TimeToSlotWithDuration(parentTimestamp, currentTime, slotDuration),
 			parentTimestamp,
 		); err == nil {
-			vm.proposerBuildSlotGauge.Set(float64(proposer.TimeToSlot(parentTimestamp, nextStartTime)))
+			vm.proposerBuildSlotGauge.Set(float64(proposer.TimeToSlotWithDuration(parentTimestamp, nextStartTime, slotDuration)))
 		}
 	} else {
 		nextStartTime, err = vm.getPreDurangoSlotTime(
@@ -408,7 +492,7 @@ func (vm *VM) getPostDurangoSlotTime(
 	slot uint64,
 	parentTimestamp time.Time,
 ) (time.Time, error) {
-	delay, err := vm.Windower.MinDelayForProposer(
+	delay, err := vm.postDurangoWindower(parentTimestamp).MinDelayForProposer(
 		ctx,
 		blkHeight,
 		pChainHeight,
@@ -432,6 +516,16 @@ func (vm *VM) getPostDurangoSlotTime(
 	}
 }
 
+// postDurangoWindower returns the windower that selects the proposers of the
+// children of a block with [parentTimestamp] in the Post-Durango windowing
+// scheme.
+func (vm *VM) postDurangoWindower(parentTimestamp time.Time) proposer.Windower {
+	if vm.policyWindower != nil && vm.ProposerPolicy.IsActivated(parentTimestamp) {
+		return vm.policyWindower
+	}
+	return vm.Windower
+}
+
 func (vm *VM) LastAccepted(ctx context.Context) (ids.ID, error) {
 	lastAccepted, err := vm.State.GetLastAccepted()
 	if err == database.ErrNotFound {
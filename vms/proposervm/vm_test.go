@@ -2660,3 +2660,34 @@ func TestSelectChildPChainHeight(t *testing.T) {
 		})
 	}
 }
+
+func TestPostDurangoWindowerActivation(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		activationTime = time.Unix(0, 0)
+		durangoTime    = activationTime
+		policyTime     = snowmantest.GenesisTimestamp.Add(time.Hour)
+	)
+	_, _, proVM, _ := initTestProposerVM(t, activationTime, durangoTime, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+
+	proVM.ProposerPolicy = &proposer.PolicyConfig{
+		Policy:         proposer.RoundRobinPolicy,
+		SlotDuration:   2 * time.Second,
+		ActivationTime: policyTime,
+	}
+	policyWindower, err := proVM.ProposerPolicy.NewWindower(
+		proVM.ctx.ValidatorState,
+		proVM.ctx.SubnetID,
+		proVM.ctx.ChainID,
+	)
+	require.NoError(err)
+	proVM.policyWindower = policyWindower
+
+	require.Equal(proVM.Windower, proVM.postDurangoWindower(policyTime.Add(-time.Second)))
+	require.Equal(policyWindower, proVM.postDurangoWindower(policyTime))
+	require.Equal(2*time.Second, proVM.postDurangoWindower(policyTime).SlotDuration())
+}
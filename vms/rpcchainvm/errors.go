@@ -12,14 +12,18 @@ import (
 
 var (
 	errEnumToError = map[vmpb.Error]error{
-		vmpb.Error_ERROR_CLOSED:                     database.ErrClosed,
-		vmpb.Error_ERROR_NOT_FOUND:                  database.ErrNotFound,
-		vmpb.Error_ERROR_STATE_SYNC_NOT_IMPLEMENTED: block.ErrStateSyncableVMNotImplemented,
+		vmpb.Error_ERROR_CLOSED:                              database.ErrClosed,
+		vmpb.Error_ERROR_NOT_FOUND:                           database.ErrNotFound,
+		vmpb.Error_ERROR_STATE_SYNC_NOT_IMPLEMENTED:          block.ErrStateSyncableVMNotImplemented,
+		vmpb.Error_ERROR_PRE_VERIFY_NOT_IMPLEMENTED:          block.ErrPreVerifyNotImplemented,
+		vmpb.Error_ERROR_STATE_SYNC_PROGRESS_NOT_IMPLEMENTED: block.ErrStateSyncProgressNotImplemented,
 	}
 	errorToErrEnum = map[error]vmpb.Error{
-		database.ErrClosed:                     vmpb.Error_ERROR_CLOSED,
-		database.ErrNotFound:                   vmpb.Error_ERROR_NOT_FOUND,
-		block.ErrStateSyncableVMNotImplemented: vmpb.Error_ERROR_STATE_SYNC_NOT_IMPLEMENTED,
+		database.ErrClosed:                       vmpb.Error_ERROR_CLOSED,
+		database.ErrNotFound:                     vmpb.Error_ERROR_NOT_FOUND,
+		block.ErrStateSyncableVMNotImplemented:   vmpb.Error_ERROR_STATE_SYNC_NOT_IMPLEMENTED,
+		block.ErrPreVerifyNotImplemented:         vmpb.Error_ERROR_PRE_VERIFY_NOT_IMPLEMENTED,
+		block.ErrStateSyncProgressNotImplemented: vmpb.Error_ERROR_STATE_SYNC_PROGRESS_NOT_IMPLEMENTED,
 	}
 )
 
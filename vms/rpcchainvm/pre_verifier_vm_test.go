@@ -0,0 +1,130 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmanmock"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blockmock"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+)
+
+var errPreVerify = errors.New("pre-verification failed")
+
+type preVerifierMock struct {
+	*blockmock.ChainVM
+
+	validBlk snowman.Block
+}
+
+func (vm *preVerifierMock) PreVerify(_ context.Context, blk snowman.Block) error {
+	if blk != vm.validBlk {
+		return errPreVerify
+	}
+	return nil
+}
+
+func preVerifyTestPlugin(t *testing.T, loadExpectations bool) block.ChainVM {
+	// test key is "preVerifyTest"
+
+	// create mock
+	ctrl := gomock.NewController(t)
+	vm := &preVerifierMock{
+		ChainVM: blockmock.NewChainVM(ctrl),
+	}
+
+	if loadExpectations {
+		blk1 := snowmanmock.NewBlock(ctrl)
+		blk2 := snowmanmock.NewBlock(ctrl)
+		vm.validBlk = blk1
+		gomock.InOrder(
+			// Initialize
+			vm.ChainVM.EXPECT().Initialize(
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(),
+			).Return(nil).Times(1),
+			vm.ChainVM.EXPECT().LastAccepted(gomock.Any()).Return(preSummaryBlk.ID(), nil).Times(1),
+			vm.ChainVM.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(preSummaryBlk, nil).Times(1),
+
+			// PreVerify Block 1
+			vm.ChainVM.EXPECT().ParseBlock(gomock.Any(), blkBytes1).Return(blk1, nil).Times(1),
+
+			// PreVerify Block 2
+			vm.ChainVM.EXPECT().ParseBlock(gomock.Any(), blkBytes2).Return(blk2, nil).Times(1),
+		)
+	}
+
+	return vm
+}
+
+func preVerifyNotImplementedTestPlugin(t *testing.T, loadExpectations bool) block.ChainVM {
+	// test key is "preVerifyNotImplementedTest"
+
+	// create mock
+	ctrl := gomock.NewController(t)
+	vm := blockmock.NewChainVM(ctrl)
+
+	if loadExpectations {
+		gomock.InOrder(
+			// Initialize
+			vm.EXPECT().Initialize(
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(),
+			).Return(nil).Times(1),
+			vm.EXPECT().LastAccepted(gomock.Any()).Return(preSummaryBlk.ID(), nil).Times(1),
+			vm.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(preSummaryBlk, nil).Times(1),
+		)
+	}
+
+	return vm
+}
+
+func TestPreVerify(t *testing.T) {
+	require := require.New(t)
+	testKey := preVerifyTestKey
+
+	// Create and start the plugin
+	vm := buildClientHelper(require, testKey)
+	defer vm.runtime.Stop(context.Background())
+
+	ctx := snowtest.Context(t, snowtest.CChainID)
+
+	require.NoError(vm.Initialize(context.Background(), ctx, memdb.New(), nil, nil, nil, nil, nil, nil))
+
+	blk1 := &snowmantest.Block{BytesV: blkBytes1}
+	require.NoError(vm.PreVerify(context.Background(), blk1))
+
+	blk2 := &snowmantest.Block{BytesV: blkBytes2}
+	err := vm.PreVerify(context.Background(), blk2)
+	require.ErrorContains(err, errPreVerify.Error())
+}
+
+func TestPreVerifyNotImplemented(t *testing.T) {
+	require := require.New(t)
+	testKey := preVerifyNotImplementedTestKey
+
+	// Create and start the plugin
+	vm := buildClientHelper(require, testKey)
+	defer vm.runtime.Stop(context.Background())
+
+	ctx := snowtest.Context(t, snowtest.CChainID)
+
+	require.NoError(vm.Initialize(context.Background(), ctx, memdb.New(), nil, nil, nil, nil, nil, nil))
+
+	blk := &snowmantest.Block{BytesV: blkBytes1}
+	err := vm.PreVerify(context.Background(), blk)
+	require.ErrorIs(err, block.ErrPreVerifyNotImplemented)
+}
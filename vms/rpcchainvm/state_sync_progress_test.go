@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blockmock"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+)
+
+var stateSyncProgress = block.StateSyncProgress{
+	RangesCompleted:        3,
+	KeysFetched:            100,
+	BytesFetched:           1000,
+	FractionCompleted:      .25,
+	EstimatedRemainingKeys: 300,
+}
+
+type stateSyncProgressMock struct {
+	*blockmock.ChainVM
+}
+
+func (*stateSyncProgressMock) StateSyncProgress(context.Context) (block.StateSyncProgress, error) {
+	return stateSyncProgress, nil
+}
+
+func stateSyncProgressTestPlugin(t *testing.T, loadExpectations bool) block.ChainVM {
+	// test key is "stateSyncProgressTest"
+
+	// create mock
+	ctrl := gomock.NewController(t)
+	vm := &stateSyncProgressMock{
+		ChainVM: blockmock.NewChainVM(ctrl),
+	}
+
+	if loadExpectations {
+		gomock.InOrder(
+			// Initialize
+			vm.ChainVM.EXPECT().Initialize(
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(),
+			).Return(nil).Times(1),
+			vm.ChainVM.EXPECT().LastAccepted(gomock.Any()).Return(preSummaryBlk.ID(), nil).Times(1),
+			vm.ChainVM.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(preSummaryBlk, nil).Times(1),
+		)
+	}
+
+	return vm
+}
+
+func stateSyncProgressNotImplementedTestPlugin(t *testing.T, loadExpectations bool) block.ChainVM {
+	// test key is "stateSyncProgressNotImplementedTest"
+
+	// create mock
+	ctrl := gomock.NewController(t)
+	vm := blockmock.NewChainVM(ctrl)
+
+	if loadExpectations {
+		gomock.InOrder(
+			// Initialize
+			vm.EXPECT().Initialize(
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(),
+			).Return(nil).Times(1),
+			vm.EXPECT().LastAccepted(gomock.Any()).Return(preSummaryBlk.ID(), nil).Times(1),
+			vm.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(preSummaryBlk, nil).Times(1),
+		)
+	}
+
+	return vm
+}
+
+func TestStateSyncProgress(t *testing.T) {
+	require := require.New(t)
+	testKey := stateSyncProgressTestKey
+
+	// Create and start the plugin
+	vm := buildClientHelper(require, testKey)
+	defer vm.runtime.Stop(context.Background())
+
+	ctx := snowtest.Context(t, snowtest.CChainID)
+
+	require.NoError(vm.Initialize(context.Background(), ctx, memdb.New(), nil, nil, nil, nil, nil, nil))
+
+	progress, err := vm.StateSyncProgress(context.Background())
+	require.NoError(err)
+	require.Equal(stateSyncProgress, progress)
+}
+
+func TestStateSyncProgressNotImplemented(t *testing.T) {
+	require := require.New(t)
+	testKey := stateSyncProgressNotImplementedTestKey
+
+	// Create and start the plugin
+	vm := buildClientHelper(require, testKey)
+	defer vm.runtime.Stop(context.Background())
+
+	ctx := snowtest.Context(t, snowtest.CChainID)
+
+	require.NoError(vm.Initialize(context.Background(), ctx, memdb.New(), nil, nil, nil, nil, nil, nil))
+
+	_, err := vm.StateSyncProgress(context.Background())
+	require.ErrorIs(err, block.ErrStateSyncProgressNotImplemented)
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/ava-labs/avalanchego/api/metrics"
@@ -71,6 +73,8 @@ var (
 	_ block.BuildBlockWithContextChainVM = (*VMClient)(nil)
 	_ block.BatchedChainVM               = (*VMClient)(nil)
 	_ block.StateSyncableVM              = (*VMClient)(nil)
+	_ block.PreVerifierChainVM           = (*VMClient)(nil)
+	_ block.StateSyncProgressVM          = (*VMClient)(nil)
 	_ prometheus.Gatherer                = (*VMClient)(nil)
 
 	_ snowman.Block           = (*blockClient)(nil)
@@ -644,6 +648,20 @@ func (vm *VMClient) GetBlockIDAtHeight(ctx context.Context, height uint64) (ids.
 	return ids.ToID(resp.BlkId)
 }
 
+func (vm *VMClient) PreVerify(ctx context.Context, blk snowman.Block) error {
+	resp, err := vm.client.BlockPreVerify(ctx, &vmpb.BlockPreVerifyRequest{
+		Bytes: blk.Bytes(),
+	})
+	if status.Code(err) == codes.Unimplemented {
+		// The plugin was built before pre-verification was supported.
+		return block.ErrPreVerifyNotImplemented
+	}
+	if err != nil {
+		return err
+	}
+	return errEnumToError[resp.Err]
+}
+
 func (vm *VMClient) StateSyncEnabled(ctx context.Context) (bool, error) {
 	resp, err := vm.client.StateSyncEnabled(ctx, &emptypb.Empty{})
 	if err != nil {
@@ -738,6 +756,28 @@ func (vm *VMClient) GetStateSummary(ctx context.Context, summaryHeight uint64) (
 	}, err
 }
 
+func (vm *VMClient) StateSyncProgress(ctx context.Context) (block.StateSyncProgress, error) {
+	resp, err := vm.client.StateSyncProgress(ctx, &emptypb.Empty{})
+	if status.Code(err) == codes.Unimplemented {
+		// The plugin was built before state sync progress was supported.
+		return block.StateSyncProgress{}, block.ErrStateSyncProgressNotImplemented
+	}
+	if err != nil {
+		return block.StateSyncProgress{}, err
+	}
+	if errEnum := resp.Err; errEnum != vmpb.Error_ERROR_UNSPECIFIED {
+		return block.StateSyncProgress{}, errEnumToError[errEnum]
+	}
+
+	return block.StateSyncProgress{
+		RangesCompleted:        resp.RangesCompleted,
+		KeysFetched:            resp.KeysFetched,
+		BytesFetched:           resp.BytesFetched,
+		FractionCompleted:      resp.FractionCompleted,
+		EstimatedRemainingKeys: resp.EstimatedRemainingKeys,
+	}, nil
+}
+
 func (vm *VMClient) newBlockFromBuildBlock(resp *vmpb.BuildBlockResponse) (*blockClient, error) {
 	id, err := ids.ToID(resp.Id)
 	if err != nil {
@@ -68,6 +68,10 @@ type VMServer struct {
 	bVM block.BuildBlockWithContextChainVM
 	// If nil, the underlying VM doesn't implement the interface.
 	ssVM block.StateSyncableVM
+	// If nil, the underlying VM doesn't implement the interface.
+	preVerifyVM block.PreVerifierChainVM
+	// If nil, the underlying VM doesn't implement the interface.
+	ssProgressVM block.StateSyncProgressVM
 
 	allowShutdown *utils.Atomic[bool]
 
@@ -86,11 +90,15 @@ type VMServer struct {
 func NewServer(vm block.ChainVM, allowShutdown *utils.Atomic[bool]) *VMServer {
 	bVM, _ := vm.(block.BuildBlockWithContextChainVM)
 	ssVM, _ := vm.(block.StateSyncableVM)
+	preVerifyVM, _ := vm.(block.PreVerifierChainVM)
+	ssProgressVM, _ := vm.(block.StateSyncProgressVM)
 	vmSrv := &VMServer{
 		metrics:       metrics.NewPrefixGatherer(),
 		vm:            vm,
 		bVM:           bVM,
 		ssVM:          ssVM,
+		preVerifyVM:   preVerifyVM,
+		ssProgressVM:  ssProgressVM,
 		allowShutdown: allowShutdown,
 	}
 	return vmSrv
@@ -733,6 +741,32 @@ func (vm *VMServer) GetStateSummary(
 	}, nil
 }
 
+func (vm *VMServer) StateSyncProgress(ctx context.Context, _ *emptypb.Empty) (*vmpb.StateSyncProgressResponse, error) {
+	var (
+		progress block.StateSyncProgress
+		err      error
+	)
+	if vm.ssProgressVM != nil {
+		progress, err = vm.ssProgressVM.StateSyncProgress(ctx)
+	} else {
+		err = block.ErrStateSyncProgressNotImplemented
+	}
+
+	if err != nil {
+		return &vmpb.StateSyncProgressResponse{
+			Err: errorToErrEnum[err],
+		}, errorToRPCError(err)
+	}
+
+	return &vmpb.StateSyncProgressResponse{
+		RangesCompleted:        progress.RangesCompleted,
+		KeysFetched:            progress.KeysFetched,
+		BytesFetched:           progress.BytesFetched,
+		FractionCompleted:      progress.FractionCompleted,
+		EstimatedRemainingKeys: progress.EstimatedRemainingKeys,
+	}, nil
+}
+
 func (vm *VMServer) BlockVerify(ctx context.Context, req *vmpb.BlockVerifyRequest) (*vmpb.BlockVerifyResponse, error) {
 	blk, err := vm.vm.ParseBlock(ctx, req.Bytes)
 	if err != nil {
@@ -760,6 +794,24 @@ func (vm *VMServer) BlockVerify(ctx context.Context, req *vmpb.BlockVerifyReques
 	}, nil
 }
 
+func (vm *VMServer) BlockPreVerify(ctx context.Context, req *vmpb.BlockPreVerifyRequest) (*vmpb.BlockPreVerifyResponse, error) {
+	var err error
+	if vm.preVerifyVM != nil {
+		var blk snowman.Block
+		blk, err = vm.vm.ParseBlock(ctx, req.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		err = vm.preVerifyVM.PreVerify(ctx, blk)
+	} else {
+		err = block.ErrPreVerifyNotImplemented
+	}
+
+	return &vmpb.BlockPreVerifyResponse{
+		Err: errorToErrEnum[err],
+	}, errorToRPCError(err)
+}
+
 func (vm *VMServer) BlockAccept(ctx context.Context, req *vmpb.BlockAcceptRequest) (*emptypb.Empty, error) {
 	id, err := ids.ToID(req.Id)
 	if err != nil {
@@ -37,6 +37,10 @@ const (
 	lastAcceptedBlockPostStateSummaryAcceptTestKey = "lastAcceptedBlockPostStateSummaryAcceptTest"
 	contextTestKey                                 = "contextTest"
 	batchedParseBlockCachingTestKey                = "batchedParseBlockCachingTest"
+	preVerifyTestKey                               = "preVerifyTest"
+	preVerifyNotImplementedTestKey                 = "preVerifyNotImplementedTest"
+	stateSyncProgressTestKey                       = "stateSyncProgressTest"
+	stateSyncProgressNotImplementedTestKey         = "stateSyncProgressNotImplementedTest"
 )
 
 var TestServerPluginMap = map[string]func(*testing.T, bool) block.ChainVM{
@@ -49,6 +53,10 @@ var TestServerPluginMap = map[string]func(*testing.T, bool) block.ChainVM{
 	lastAcceptedBlockPostStateSummaryAcceptTestKey: lastAcceptedBlockPostStateSummaryAcceptTestPlugin,
 	contextTestKey:                                 contextEnabledTestPlugin,
 	batchedParseBlockCachingTestKey:                batchedParseBlockCachingTestPlugin,
+	preVerifyTestKey:                               preVerifyTestPlugin,
+	preVerifyNotImplementedTestKey:                 preVerifyNotImplementedTestPlugin,
+	stateSyncProgressTestKey:                       stateSyncProgressTestPlugin,
+	stateSyncProgressNotImplementedTestKey:         stateSyncProgressNotImplementedTestPlugin,
 }
 
 // helperProcess helps with creating the subnet binary for testing.
@@ -0,0 +1,68 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: credential.go
+
+package secp256k1fx
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*Credential)(nil)
+)
+
+func (t *Credential) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += wrappers.IntLen
+	size += len(t.Sigs) * 65
+	return size, nil
+}
+
+func (t *Credential) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if len(t.Sigs) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Sigs)))
+	for i0 := range t.Sigs {
+		start1 := p.Offset
+		p.PackFixedBytes(t.Sigs[i0][:])
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start1 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	return p.Err
+}
+
+func (t *Credential) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Sigs = make([][65]byte, 0, min(int(n0), 16))
+	for i1 := 0; i1 < int(n0); i1++ {
+		var elem3 [65]byte
+		t.Sigs = append(t.Sigs, elem3)
+		start2 := p.Offset
+		copy(t.Sigs[i1][:], p.UnpackFixedBytes(65))
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start2 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	return nil
+}
+
+func (t *Credential) CodecValue() interface{} {
+	return t
+}
@@ -0,0 +1,68 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: input.go
+
+package secp256k1fx
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*Input)(nil)
+)
+
+func (t *Input) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += wrappers.IntLen
+	size += len(t.SigIndices) * 4
+	return size, nil
+}
+
+func (t *Input) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if len(t.SigIndices) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.SigIndices)))
+	for i0 := range t.SigIndices {
+		start1 := p.Offset
+		p.PackInt(uint32(t.SigIndices[i0]))
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start1 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	return p.Err
+}
+
+func (t *Input) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.SigIndices = make([]uint32, 0, min(int(n0), 16))
+	for i1 := 0; i1 < int(n0); i1++ {
+		var elem3 uint32
+		t.SigIndices = append(t.SigIndices, elem3)
+		start2 := p.Offset
+		t.SigIndices[i1] = uint32(p.UnpackInt())
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start2 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	return nil
+}
+
+func (t *Input) CodecValue() interface{} {
+	return t
+}
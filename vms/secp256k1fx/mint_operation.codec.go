@@ -0,0 +1,69 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: mint_operation.go
+
+package secp256k1fx
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*MintOperation)(nil)
+)
+
+func (t *MintOperation) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.MintInput)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	{
+		n, err := c.Size(&t.MintOutput)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	{
+		n, err := c.Size(&t.TransferOutput)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *MintOperation) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.MintInput, p); err != nil {
+		return err
+	}
+	if err := c.MarshalInto(&t.MintOutput, p); err != nil {
+		return err
+	}
+	if err := c.MarshalInto(&t.TransferOutput, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *MintOperation) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.MintInput); err != nil {
+		return err
+	}
+	if err := c.UnmarshalFrom(p, &t.MintOutput); err != nil {
+		return err
+	}
+	if err := c.UnmarshalFrom(p, &t.TransferOutput); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *MintOperation) CodecValue() interface{} {
+	return t
+}
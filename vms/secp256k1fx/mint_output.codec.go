@@ -0,0 +1,43 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: mint_output.go
+
+package secp256k1fx
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*MintOutput)(nil)
+)
+
+func (t *MintOutput) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	{
+		n, err := c.Size(&t.OutputOwners)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *MintOutput) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.MarshalInto(&t.OutputOwners, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *MintOutput) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	if err := c.UnmarshalFrom(p, &t.OutputOwners); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *MintOutput) CodecValue() interface{} {
+	return t
+}
@@ -0,0 +1,81 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: output_owners.go
+
+package secp256k1fx
+
+import (
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*OutputOwners)(nil)
+)
+
+func (t *OutputOwners) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += 8
+	size += 4
+	size += wrappers.IntLen
+	size += len(t.Addrs) * 20
+	return size, nil
+}
+
+func (t *OutputOwners) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	p.PackLong(uint64(t.Locktime))
+	p.PackInt(uint32(t.Threshold))
+	if len(t.Addrs) > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	p.PackInt(uint32(len(t.Addrs)))
+	for i0 := range t.Addrs {
+		start1 := p.Offset
+		p.PackFixedBytes(t.Addrs[i0][:])
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start1 {
+			return codec.ErrMarshalZeroLength
+		}
+	}
+	return p.Err
+}
+
+func (t *OutputOwners) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	t.Locktime = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	t.Threshold = uint32(p.UnpackInt())
+	if p.Err != nil {
+		return p.Err
+	}
+	n0 := p.UnpackInt()
+	if p.Err != nil {
+		return p.Err
+	}
+	if n0 > math.MaxInt32 {
+		return codec.ErrMaxSliceLenExceeded
+	}
+	t.Addrs = make([]ids.ShortID, 0, min(int(n0), 16))
+	for i1 := 0; i1 < int(n0); i1++ {
+		var elem3 ids.ShortID
+		t.Addrs = append(t.Addrs, elem3)
+		start2 := p.Offset
+		copy(t.Addrs[i1][:], p.UnpackFixedBytes(20))
+		if p.Err != nil {
+			return p.Err
+		}
+		if p.Offset == start2 {
+			return codec.ErrUnmarshalZeroLength
+		}
+	}
+	return nil
+}
+
+func (t *OutputOwners) CodecValue() interface{} {
+	return t
+}
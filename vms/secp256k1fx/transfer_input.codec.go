@@ -0,0 +1,49 @@
+// Code generated by codecgen. DO NOT EDIT.
+// source: transfer_input.go
+
+package secp256k1fx
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	_ codec.Marshaler = (*TransferInput)(nil)
+)
+
+func (t *TransferInput) CodecSize(c codec.Codec) (int, error) {
+	size := 0
+	size += 8
+	{
+		n, err := c.Size(&t.Input)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (t *TransferInput) CodecMarshalInto(c codec.Codec, p *wrappers.Packer) error {
+	p.PackLong(uint64(t.Amt))
+	if err := c.MarshalInto(&t.Input, p); err != nil {
+		return err
+	}
+	return p.Err
+}
+
+func (t *TransferInput) CodecUnmarshalFrom(c codec.Codec, p *wrappers.Packer) error {
+	t.Amt = uint64(p.UnpackLong())
+	if p.Err != nil {
+		return p.Err
+	}
+	if err := c.UnmarshalFrom(p, &t.Input); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *TransferInput) CodecValue() interface{} {
+	return t
+}
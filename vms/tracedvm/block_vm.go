@@ -24,6 +24,8 @@ var (
 	_ block.BuildBlockWithContextChainVM = (*blockVM)(nil)
 	_ block.BatchedChainVM               = (*blockVM)(nil)
 	_ block.StateSyncableVM              = (*blockVM)(nil)
+	_ block.StateSyncProgressVM          = (*blockVM)(nil)
+	_ block.PreVerifierChainVM           = (*blockVM)(nil)
 )
 
 type blockVM struct {
@@ -31,6 +33,7 @@ type blockVM struct {
 	buildBlockVM block.BuildBlockWithContextChainVM
 	batchedVM    block.BatchedChainVM
 	ssVM         block.StateSyncableVM
+	preVerifyVM  block.PreVerifierChainVM
 	// ChainVM tags
 	initializeTag              string
 	buildBlockTag              string
@@ -57,18 +60,22 @@ type blockVM struct {
 	getLastStateSummaryTag        string
 	parseStateSummaryTag          string
 	getStateSummaryTag            string
-	tracer                        trace.Tracer
+	// PreVerifierChainVM tags
+	preVerifyTag string
+	tracer       trace.Tracer
 }
 
 func NewBlockVM(vm block.ChainVM, name string, tracer trace.Tracer) block.ChainVM {
 	buildBlockVM, _ := vm.(block.BuildBlockWithContextChainVM)
 	batchedVM, _ := vm.(block.BatchedChainVM)
 	ssVM, _ := vm.(block.StateSyncableVM)
+	preVerifyVM, _ := vm.(block.PreVerifierChainVM)
 	return &blockVM{
 		ChainVM:                       vm,
 		buildBlockVM:                  buildBlockVM,
 		batchedVM:                     batchedVM,
 		ssVM:                          ssVM,
+		preVerifyVM:                   preVerifyVM,
 		initializeTag:                 name + ".initialize",
 		buildBlockTag:                 name + ".buildBlock",
 		parseBlockTag:                 name + ".parseBlock",
@@ -90,6 +97,7 @@ func NewBlockVM(vm block.ChainVM, name string, tracer trace.Tracer) block.ChainV
 		getLastStateSummaryTag:        name + ".getLastStateSummary",
 		parseStateSummaryTag:          name + ".parseStateSummary",
 		getStateSummaryTag:            name + ".getStateSummary",
+		preVerifyTag:                  name + ".preVerify",
 		tracer:                        tracer,
 	}
 }
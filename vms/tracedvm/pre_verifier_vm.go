@@ -0,0 +1,34 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tracedvm
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func (vm *blockVM) PreVerify(ctx context.Context, blk snowman.Block) error {
+	if vm.preVerifyVM == nil {
+		return block.ErrPreVerifyNotImplemented
+	}
+
+	// Blocks may have been parsed by this VM or by the VM it wraps.
+	if tracedBlk, ok := blk.(*tracedBlock); ok {
+		blk = tracedBlk.Block
+	}
+
+	ctx, span := vm.tracer.Start(ctx, vm.preVerifyTag, oteltrace.WithAttributes(
+		attribute.Stringer("blkID", blk.ID()),
+		attribute.Int64("height", int64(blk.Height())),
+	))
+	defer span.End()
+
+	return vm.preVerifyVM.PreVerify(ctx, blk)
+}
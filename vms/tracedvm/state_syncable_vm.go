@@ -71,3 +71,12 @@ func (vm *blockVM) GetStateSummary(ctx context.Context, height uint64) (block.St
 
 	return vm.ssVM.GetStateSummary(ctx, height)
 }
+
+func (vm *blockVM) StateSyncProgress(ctx context.Context) (block.StateSyncProgress, error) {
+	progressVM, ok := vm.ChainVM.(block.StateSyncProgressVM)
+	if !ok {
+		return block.StateSyncProgress{}, block.ErrStateSyncProgressNotImplemented
+	}
+
+	return progressVM.StateSyncProgress(ctx)
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package waiter allows API calls to block until transactions are decided
+// without polling.
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+)
+
+const (
+	// DefaultTimeout is used when a wait doesn't specify a timeout.
+	DefaultTimeout = 10 * time.Second
+	// MaxTimeout is the maximum amount of time a single wait can block for.
+	//
+	// It is below the node's default --http-write-timeout of 30 seconds, so
+	// that waits are answered with the last observed status rather than being
+	// cut off by the HTTP server.
+	MaxTimeout = 25 * time.Second
+	// MaxConfirmations is the maximum number of blocks that a wait can require
+	// to be accepted on top of the block that decided a transaction.
+	MaxConfirmations = 1024
+)
+
+var (
+	_ snow.Acceptor = (*Waiter)(nil)
+
+	ErrTooManyConfirmations = errors.New("too many confirmations requested")
+	ErrTimeoutTooLarge      = errors.New("timeout too large")
+)
+
+// VM is implemented by VMs whose API calls wait for transactions. The chain
+// manager registers the returned waiter with the block acceptor group of the
+// chain.
+type VM interface {
+	TxWaiter() *Waiter
+}
+
+// Waiter wakes up API calls that are waiting for transactions whenever the
+// chain accepts a block.
+type Waiter struct {
+	lock sync.Mutex
+	// accepted is closed, and replaced, every time a container is accepted.
+	accepted chan struct{}
+}
+
+func New() *Waiter {
+	return &Waiter{
+		accepted: make(chan struct{}),
+	}
+}
+
+// Accept wakes up all the current waiters.
+//
+// The acceptor group calls Accept while the context lock is held, before the
+// container is committed. Waiters re-evaluate whether their transaction was
+// decided only after grabbing the context lock, so they observe the state after
+// the container was committed.
+func (w *Waiter) Accept(*snow.ConsensusContext, ids.ID, []byte) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	close(w.accepted)
+	w.accepted = make(chan struct{})
+	return nil
+}
+
+// Wait blocks until [decided] returns true or an error, or until [ctx] is
+// done.
+//
+// [decided] is called once immediately and then once after every call to
+// Accept. The last value returned by [decided] is returned, including when
+// [ctx] is done, so that callers can report the last observed state.
+func Wait[T any](ctx context.Context, w *Waiter, decided func() (T, bool, error)) (T, error) {
+	for {
+		// The channel must be read before calling [decided] to avoid missing
+		// an acceptance that happens concurrently.
+		w.lock.Lock()
+		accepted := w.accepted
+		w.lock.Unlock()
+
+		value, done, err := decided()
+		if err != nil || done {
+			return value, err
+		}
+
+		select {
+		case <-accepted:
+		case <-ctx.Done():
+			return value, ctx.Err()
+		}
+	}
+}
+
+// Timeout returns the duration a wait with the requested [timeoutMillis] and
+// [confirmations] is allowed to block for.
+func Timeout(timeoutMillis uint64, confirmations uint64) (time.Duration, error) {
+	if confirmations > MaxConfirmations {
+		return 0, fmt.Errorf("%w: %d > %d", ErrTooManyConfirmations, confirmations, MaxConfirmations)
+	}
+	if timeoutMillis == 0 {
+		return DefaultTimeout, nil
+	}
+	if maxTimeoutMillis := uint64(MaxTimeout.Milliseconds()); timeoutMillis > maxTimeoutMillis {
+		return 0, fmt.Errorf("%w: %dms > %dms", ErrTimeoutTooLarge, timeoutMillis, maxTimeoutMillis)
+	}
+	return time.Duration(timeoutMillis) * time.Millisecond, nil
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package waiter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+var errTest = errors.New("non-nil error")
+
+func TestWaitDecided(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		w    = New()
+		lock sync.Mutex
+		// numAccepted is the number of containers accepted
+		numAccepted int
+		numChecks   int
+	)
+	decided := func() (int, bool, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		numChecks++
+		return numAccepted, numAccepted >= 3, nil
+	}
+
+	done := make(chan error)
+	go func() {
+		_, err := Wait(context.Background(), w, decided)
+		done <- err
+	}()
+
+	for {
+		select {
+		case err := <-done:
+			require.NoError(err)
+
+			lock.Lock()
+			defer lock.Unlock()
+			require.GreaterOrEqual(numAccepted, 3)
+			require.LessOrEqual(numChecks, numAccepted+1)
+			return
+		default:
+		}
+
+		lock.Lock()
+		numAccepted++
+		lock.Unlock()
+		require.NoError(w.Accept(nil, ids.GenerateTestID(), nil))
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestWaitImmediatelyDecided(t *testing.T) {
+	require := require.New(t)
+
+	w := New()
+	value, err := Wait(context.Background(), w, func() (int, bool, error) {
+		return 1, true, nil
+	})
+	require.NoError(err)
+	require.Equal(1, value)
+
+	value, err = Wait(context.Background(), w, func() (int, bool, error) {
+		return 2, false, errTest
+	})
+	require.ErrorIs(err, errTest)
+	require.Equal(2, value)
+}
+
+func TestWaitTimeout(t *testing.T) {
+	require := require.New(t)
+
+	w := New()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	numChecks := 0
+	value, err := Wait(ctx, w, func() (int, bool, error) {
+		numChecks++
+		return numChecks, false, nil
+	})
+	require.ErrorIs(err, context.DeadlineExceeded)
+	require.Equal(numChecks, value)
+}
+
+func TestTimeout(t *testing.T) {
+	tests := []struct {
+		name            string
+		timeoutMillis   uint64
+		confirmations   uint64
+		expectedTimeout time.Duration
+		expectedErr     error
+	}{
+		{
+			name:            "default",
+			expectedTimeout: DefaultTimeout,
+		},
+		{
+			name:            "specified",
+			timeoutMillis:   1500,
+			confirmations:   MaxConfirmations,
+			expectedTimeout: 1500 * time.Millisecond,
+		},
+		{
+			name:            "max timeout",
+			timeoutMillis:   uint64(MaxTimeout.Milliseconds()),
+			expectedTimeout: MaxTimeout,
+		},
+		{
+			name:          "timeout too large",
+			timeoutMillis: uint64(MaxTimeout.Milliseconds()) + 1,
+			expectedErr:   ErrTimeoutTooLarge,
+		},
+		{
+			name:          "too many confirmations",
+			confirmations: MaxConfirmations + 1,
+			expectedErr:   ErrTooManyConfirmations,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			timeout, err := Timeout(test.timeoutMillis, test.confirmations)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedTimeout, timeout)
+		})
+	}
+}
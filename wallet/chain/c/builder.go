@@ -191,6 +191,7 @@ func (b *builder) NewImportTx(
 	if err != nil {
 		return nil, err
 	}
+	utxos = ops.UTXOs(utxos)
 
 	var (
 		addrs           = ops.Addresses(b.avaxAddrs)
@@ -14,6 +14,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
 
@@ -101,16 +102,9 @@ func (w *wallet) IssueImportTx(
 	to ethcommon.Address,
 	options ...common.Option,
 ) (*atomic.Tx, error) {
-	baseFee, err := w.baseFee(options)
-	if err != nil {
-		return nil, err
-	}
-
-	utx, err := w.builder.NewImportTx(chainID, to, baseFee, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedAtomicTx(utx, options...)
+	return w.issue(func(baseFee *big.Int, bumpOptions ...common.Option) (atomic.UnsignedAtomicTx, error) {
+		return w.builder.NewImportTx(chainID, to, baseFee, common.UnionOptions(options, bumpOptions)...)
+	}, options)
 }
 
 func (w *wallet) IssueExportTx(
@@ -118,16 +112,9 @@ func (w *wallet) IssueExportTx(
 	outputs []*secp256k1fx.TransferOutput,
 	options ...common.Option,
 ) (*atomic.Tx, error) {
-	baseFee, err := w.baseFee(options)
-	if err != nil {
-		return nil, err
-	}
-
-	utx, err := w.builder.NewExportTx(chainID, outputs, baseFee, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedAtomicTx(utx, options...)
+	return w.issue(func(baseFee *big.Int, bumpOptions ...common.Option) (atomic.UnsignedAtomicTx, error) {
+		return w.builder.NewExportTx(chainID, outputs, baseFee, common.UnionOptions(options, bumpOptions)...)
+	}, options)
 }
 
 func (w *wallet) IssueUnsignedAtomicTx(
@@ -178,7 +165,71 @@ func (w *wallet) baseFee(options []common.Option) (*big.Int, error) {
 	}
 
 	ctx := ops.Context()
-	return w.ethClient.EstimateBaseFee(ctx)
+	baseFee, err := w.ethClient.EstimateBaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return common.ScaleBigFeeRate(baseFee, common.PercentDenominator+ops.FeeMargin()), nil
+}
+
+// issue builds, signs, and issues the tx built by [build]. If fee bumping is
+// enabled, the tx is re-built with an increased base fee until it is accepted.
+func (w *wallet) issue(
+	build func(baseFee *big.Int, options ...common.Option) (atomic.UnsignedAtomicTx, error),
+	options []common.Option,
+) (*atomic.Tx, error) {
+	baseFee, err := w.baseFee(options)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := common.NewOptions(options)
+	if ops.FeeBump() == nil || ops.AssumeDecided() {
+		utx, err := build(baseFee)
+		if err != nil {
+			return nil, err
+		}
+		return w.IssueUnsignedAtomicTx(utx, options...)
+	}
+
+	ctx := ops.Context()
+	tx, err := common.IssueWithFeeBump(
+		ops,
+		func(feeRatePercent uint64, bumpOptions ...common.Option) (*atomic.Tx, uint64, error) {
+			bumpedBaseFee := common.ScaleBigFeeRate(baseFee, feeRatePercent)
+			utx, err := build(bumpedBaseFee, bumpOptions...)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			gasUsed, err := utx.GasUsed(true /*=IsApricotPhase5*/)
+			if err != nil {
+				return nil, 0, err
+			}
+			txFee, err := atomic.CalculateDynamicFee(gasUsed, bumpedBaseFee)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			tx, err := SignUnsignedAtomic(ctx, w.signer, utx)
+			return tx, txFee, err
+		},
+		func(tx *atomic.Tx) set.Set[ids.ID] {
+			return tx.InputUTXOs()
+		},
+		func(ctx context.Context, tx *atomic.Tx) error {
+			_, err := w.avaxClient.IssueTx(ctx, tx.SignedBytes())
+			return err
+		},
+		func(ctx context.Context, txID ids.ID) (bool, error) {
+			status, err := w.avaxClient.GetAtomicTxStatus(ctx, txID)
+			return status == atomic.Accepted, err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return tx, w.Backend.AcceptAtomicTx(ctx, tx)
 }
 
 // TODO: Upstream this function into coreth.
@@ -1116,6 +1116,7 @@ func (b *builder) NewImportTx(
 	if err != nil {
 		return nil, err
 	}
+	utxos = ops.UTXOs(utxos)
 
 	var (
 		addrs           = ops.Addresses(b.addrs)
@@ -1576,6 +1577,7 @@ func (b *builder) spend(
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	utxos = options.UTXOs(utxos)
 
 	addrs := options.Addresses(b.addrs)
 	minIssuanceTime := options.MinIssuanceTime()
@@ -1592,9 +1594,13 @@ func (b *builder) spend(
 		ownerOverride = changeOwner
 	}
 
+	gasPrice := options.GasPrice(common.ScaleFeeRate(
+		b.context.GasPrice,
+		common.PercentDenominator+options.FeeMargin(),
+	))
 	s := spendHelper{
 		weights:  b.context.ComplexityWeights,
-		gasPrice: b.context.GasPrice,
+		gasPrice: gasPrice,
 
 		toBurn:     toBurn,
 		toStake:    toStake,
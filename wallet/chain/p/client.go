@@ -4,7 +4,11 @@
 package p
 
 import (
+	"context"
+
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
@@ -52,3 +56,30 @@ func (c *Client) IssueTx(
 
 	return c.backend.AcceptTx(ctx, tx)
 }
+
+func (c *Client) IssueTxWithFeeBump(
+	build common.BuildFunc[*txs.Tx],
+	options ...common.Option,
+) (*txs.Tx, error) {
+	ops := common.NewOptions(options)
+	tx, err := common.IssueWithFeeBump(
+		ops,
+		build,
+		(*txs.Tx).InputIDs,
+		func(ctx context.Context, tx *txs.Tx) error {
+			_, err := c.client.IssueTx(ctx, tx.Bytes())
+			return err
+		},
+		func(ctx context.Context, txID ids.ID) (bool, error) {
+			res, err := c.client.GetTxStatus(ctx, txID)
+			if err != nil {
+				return false, err
+			}
+			return res.Status == status.Committed || res.Status == status.Aborted, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return tx, c.backend.AcceptTx(ops.Context(), tx)
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/builder"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/signer"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+// feeBumpClient accepts the [acceptedIndex]th issued tx.
+type feeBumpClient struct {
+	platformvm.Client
+
+	acceptedIndex int
+	issued        []*txs.Tx
+}
+
+func (c *feeBumpClient) IssueTx(_ context.Context, txBytes []byte, _ ...rpc.Option) (ids.ID, error) {
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return ids.Empty, err
+	}
+	c.issued = append(c.issued, tx)
+	return tx.ID(), nil
+}
+
+func (c *feeBumpClient) GetTxStatus(_ context.Context, txID ids.ID, _ ...rpc.Option) (*platformvm.GetTxStatusResponse, error) {
+	if len(c.issued) > c.acceptedIndex && c.issued[c.acceptedIndex].ID() == txID {
+		return &platformvm.GetTxStatusResponse{Status: status.Committed}, nil
+	}
+	return &platformvm.GetTxStatusResponse{Status: status.Processing}, nil
+}
+
+func TestIssueTxWithFeeBumpConsumesSameInputs(t *testing.T) {
+	require := require.New(t)
+
+	// Every UTXO is large enough to pay for the tx by itself, and the UTXOs
+	// are returned in a random order, so a re-built tx that isn't restricted
+	// to the inputs of the original tx would likely consume different UTXOs.
+	const numUTXOs = 16
+	utxos := common.NewUTXOs()
+	for i := 0; i < numUTXOs; i++ {
+		require.NoError(utxos.AddUTXO(
+			context.Background(),
+			constants.PlatformChainID,
+			constants.PlatformChainID,
+			&avax.UTXO{
+				UTXOID: avax.UTXOID{
+					TxID:        ids.GenerateTestID(),
+					OutputIndex: uint32(i),
+				},
+				Asset: avax.Asset{ID: avaxAssetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          10 * units.Avax,
+					OutputOwners: utxoOwner,
+				},
+			},
+		))
+	}
+
+	var (
+		backend = wallet.NewBackend(
+			testContextPostEtna,
+			common.NewChainUTXOs(constants.PlatformChainID, utxos),
+			nil,
+		)
+		client = &feeBumpClient{
+			acceptedIndex: 2,
+		}
+		w = wallet.New(
+			NewClient(client, backend),
+			builder.New(set.Of(utxoAddr), testContextPostEtna, backend),
+			signer.New(secp256k1fx.NewKeychain(utxoKey), backend),
+		)
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	tx, err := w.IssueBaseTx(
+		[]*avax.TransferableOutput{avaxOutput},
+		common.WithContext(ctx),
+		common.WithPollFrequency(time.Millisecond),
+		common.WithFeeBump(common.FeeBump{
+			Timeout:         10 * time.Millisecond,
+			IncreasePercent: 50,
+			MaxFee:          math.MaxUint64,
+		}),
+	)
+	require.NoError(err)
+	require.Len(client.issued, 3)
+	require.Equal(client.issued[2].ID(), tx.ID())
+
+	originalInputs := client.issued[0].InputIDs()
+	require.NotEmpty(originalInputs)
+	for _, bumpedTx := range client.issued[1:] {
+		require.NotEqual(client.issued[0].ID(), bumpedTx.ID())
+		require.Equal(originalInputs, bumpedTx.InputIDs())
+	}
+}
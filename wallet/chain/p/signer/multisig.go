@@ -0,0 +1,201 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package signer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	_ keychain.Keychain = addressKeychain{}
+	_ keychain.Signer   = addressSigner{}
+
+	ErrNoTxsToMerge          = errors.New("no txs to merge")
+	ErrMismatchedUnsignedTx  = errors.New("mismatched unsigned tx")
+	ErrMismatchedCredentials = errors.New("mismatched credentials")
+	ErrConflictingSignature  = errors.New("conflicting signature")
+
+	errAddressOnlySigner = errors.New("address only signer can't sign")
+)
+
+// MissingSignature identifies a signature slot of a transaction that has not
+// been populated yet.
+type MissingSignature struct {
+	// CredentialIndex is the index of the credential in the transaction.
+	CredentialIndex int
+	// SignatureIndex is the index of the signature in the credential.
+	SignatureIndex int
+	// Address is the address that is expected to populate the signature.
+	//
+	// If the UTXO being consumed isn't known by the backend, the address can't
+	// be determined and will be empty.
+	Address ids.ShortID
+}
+
+// Merge combines the signatures of several partially signed copies of the same
+// unsigned transaction into a single transaction.
+//
+// Transactions without any credentials are treated as unsigned copies and
+// don't contribute any signatures.
+func Merge(partialTxs ...*txs.Tx) (*txs.Tx, error) {
+	if len(partialTxs) == 0 {
+		return nil, ErrNoTxsToMerge
+	}
+
+	unsignedTx := partialTxs[0].Unsigned
+	unsignedBytes, err := txs.Codec.Marshal(txs.CodecVersion, &unsignedTx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't marshal unsigned tx: %w", err)
+	}
+
+	var creds []*secp256k1fx.Credential
+	for i, partialTx := range partialTxs {
+		partialUnsignedBytes, err := txs.Codec.Marshal(txs.CodecVersion, &partialTx.Unsigned)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't marshal unsigned tx %d: %w", i, err)
+		}
+		if !bytes.Equal(unsignedBytes, partialUnsignedBytes) {
+			return nil, fmt.Errorf("%w: tx %d", ErrMismatchedUnsignedTx, i)
+		}
+
+		if len(partialTx.Creds) == 0 {
+			continue
+		}
+		if creds == nil {
+			creds = make([]*secp256k1fx.Credential, len(partialTx.Creds))
+			for credIndex := range creds {
+				creds[credIndex] = &secp256k1fx.Credential{}
+			}
+		}
+		if len(creds) != len(partialTx.Creds) {
+			return nil, fmt.Errorf("%w: tx %d has %d credentials but expected %d",
+				ErrMismatchedCredentials,
+				i,
+				len(partialTx.Creds),
+				len(creds),
+			)
+		}
+
+		for credIndex, credIntf := range partialTx.Creds {
+			cred, ok := credIntf.(*secp256k1fx.Credential)
+			if !ok {
+				return nil, ErrUnknownCredentialType
+			}
+			if err := mergeSigs(creds[credIndex], cred); err != nil {
+				return nil, fmt.Errorf("tx %d credential %d: %w", i, credIndex, err)
+			}
+		}
+	}
+
+	tx := &txs.Tx{
+		Unsigned: unsignedTx,
+		Creds:    make([]verify.Verifiable, len(creds)),
+	}
+	for i, cred := range creds {
+		tx.Creds[i] = cred
+	}
+	return tx, tx.Initialize(txs.Codec)
+}
+
+// mergeSigs copies the populated signatures of [from] into [into].
+func mergeSigs(into, from *secp256k1fx.Credential) error {
+	if into.Sigs == nil {
+		into.Sigs = make([][secp256k1.SignatureLen]byte, len(from.Sigs))
+	}
+	if len(into.Sigs) != len(from.Sigs) {
+		return fmt.Errorf("%w: has %d signatures but expected %d",
+			ErrMismatchedCredentials,
+			len(from.Sigs),
+			len(into.Sigs),
+		)
+	}
+
+	for sigIndex, sig := range from.Sigs {
+		switch existingSig := into.Sigs[sigIndex]; {
+		case sig == emptySig:
+		case existingSig == emptySig:
+			into.Sigs[sigIndex] = sig
+		case existingSig != sig:
+			return fmt.Errorf("%w: signature %d", ErrConflictingSignature, sigIndex)
+		}
+	}
+	return nil
+}
+
+// missingSignatures returns the signature slots of [tx] that would not be
+// populated by [sign].
+func missingSignatures(tx *txs.Tx, txSigners [][]keychain.Signer) ([]MissingSignature, error) {
+	var missing []MissingSignature
+	for credIndex, inputSigners := range txSigners {
+		var sigs [][secp256k1.SignatureLen]byte
+		if len(tx.Creds) == len(txSigners) {
+			if credIntf := tx.Creds[credIndex]; credIntf != nil {
+				cred, ok := credIntf.(*secp256k1fx.Credential)
+				if !ok {
+					return nil, ErrUnknownCredentialType
+				}
+				sigs = cred.Sigs
+			}
+		}
+		if len(sigs) != len(inputSigners) {
+			// [sign] would reset the signatures of this credential.
+			sigs = nil
+		}
+
+		for sigIndex, signer := range inputSigners {
+			if sigIndex < len(sigs) && sigs[sigIndex] != emptySig {
+				continue
+			}
+
+			var addr ids.ShortID
+			if signer != nil {
+				addr = signer.Address()
+			}
+			missing = append(missing, MissingSignature{
+				CredentialIndex: credIndex,
+				SignatureIndex:  sigIndex,
+				Address:         addr,
+			})
+		}
+	}
+	return missing, nil
+}
+
+// addressKeychain reports that it can sign for every address. It is used to
+// determine the address that is expected to populate every signature slot.
+type addressKeychain struct{}
+
+func (addressKeychain) Get(addr ids.ShortID) (keychain.Signer, bool) {
+	return addressSigner{addr: addr}, true
+}
+
+func (addressKeychain) Addresses() set.Set[ids.ShortID] {
+	return nil
+}
+
+type addressSigner struct {
+	addr ids.ShortID
+}
+
+func (addressSigner) SignHash([]byte) ([]byte, error) {
+	return nil, errAddressOnlySigner
+}
+
+func (addressSigner) Sign([]byte) ([]byte, error) {
+	return nil, errAddressOnlySigner
+}
+
+func (s addressSigner) Address() ids.ShortID {
+	return s.addr
+}
@@ -0,0 +1,231 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package signer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var _ Backend = (*testBackend)(nil)
+
+type testBackend struct {
+	utxos  map[ids.ID]*avax.UTXO
+	owners map[ids.ID]fx.Owner
+}
+
+func (b *testBackend) GetUTXO(_ context.Context, _, utxoID ids.ID) (*avax.UTXO, error) {
+	utxo, ok := b.utxos[utxoID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return utxo, nil
+}
+
+func (b *testBackend) GetOwner(_ context.Context, ownerID ids.ID) (fx.Owner, error) {
+	owner, ok := b.owners[ownerID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return owner, nil
+}
+
+// newMultisigTx returns an unsigned tx that consumes a UTXO owned by keys[0]
+// and is authorized by keys[0] and keys[2] of a 2-of-3 subnet owner.
+func newMultisigTx(keys []*secp256k1.PrivateKey) (*testBackend, *txs.AddSubnetValidatorTx) {
+	var (
+		subnetID = ids.GenerateTestID()
+		assetID  = ids.GenerateTestID()
+		utxo     = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: avax.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{keys[0].Address()},
+				},
+			},
+		}
+	)
+	backend := &testBackend{
+		utxos: map[ids.ID]*avax.UTXO{
+			utxo.InputID(): utxo,
+		},
+		owners: map[ids.ID]fx.Owner{
+			subnetID: &secp256k1fx.OutputOwners{
+				Threshold: 2,
+				Addrs: []ids.ShortID{
+					keys[0].Address(),
+					keys[1].Address(),
+					keys[2].Address(),
+				},
+			},
+		},
+	}
+	utx := &txs.AddSubnetValidatorTx{
+		BaseTx: txs.BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    constants.UnitTestID,
+				BlockchainID: constants.PlatformChainID,
+				Ins: []*avax.TransferableInput{
+					{
+						UTXOID: utxo.UTXOID,
+						Asset:  utxo.Asset,
+						In: &secp256k1fx.TransferInput{
+							Amt: 1,
+							Input: secp256k1fx.Input{
+								SigIndices: []uint32{0},
+							},
+						},
+					},
+				},
+			},
+		},
+		SubnetValidator: txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: ids.GenerateTestNodeID(),
+				End:    1,
+				Wght:   1,
+			},
+			Subnet: subnetID,
+		},
+		SubnetAuth: &secp256k1fx.Input{
+			SigIndices: []uint32{0, 2},
+		},
+	}
+	return backend, utx
+}
+
+func TestMultisigSignAndMerge(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx  = context.Background()
+		keys = secp256k1.TestKeys()
+	)
+	backend, utx := newMultisigTx(keys)
+
+	signer0 := New(secp256k1fx.NewKeychain(keys[0]), backend)
+	signer2 := New(secp256k1fx.NewKeychain(keys[2]), backend)
+
+	reporter0, ok := signer0.(MissingSignaturesReporter)
+	require.True(ok)
+	reporter2, ok := signer2.(MissingSignaturesReporter)
+	require.True(ok)
+
+	unsignedTx := &txs.Tx{Unsigned: utx}
+	missing, err := reporter0.MissingSignatures(ctx, unsignedTx)
+	require.NoError(err)
+	require.Equal(
+		[]MissingSignature{
+			{CredentialIndex: 0, SignatureIndex: 0, Address: keys[0].Address()},
+			{CredentialIndex: 1, SignatureIndex: 0, Address: keys[0].Address()},
+			{CredentialIndex: 1, SignatureIndex: 1, Address: keys[2].Address()},
+		},
+		missing,
+	)
+
+	partialTx0, err := SignUnsigned(ctx, signer0, utx)
+	require.NoError(err)
+
+	// Round trip the partially signed tx to simulate passing it to another
+	// party.
+	parsedTx0, err := txs.Parse(txs.Codec, partialTx0.Bytes())
+	require.NoError(err)
+
+	missing, err = reporter2.MissingSignatures(ctx, parsedTx0)
+	require.NoError(err)
+	require.Equal(
+		[]MissingSignature{
+			{CredentialIndex: 1, SignatureIndex: 1, Address: keys[2].Address()},
+		},
+		missing,
+	)
+
+	partialTx2, err := SignUnsigned(ctx, signer2, parsedTx0.Unsigned)
+	require.NoError(err)
+
+	mergedTx, err := Merge(parsedTx0, unsignedTx, partialTx2)
+	require.NoError(err)
+
+	missing, err = reporter0.MissingSignatures(ctx, mergedTx)
+	require.NoError(err)
+	require.Empty(missing)
+
+	// Signing sequentially should produce the same tx as merging.
+	require.NoError(signer2.Sign(ctx, parsedTx0))
+	require.Equal(parsedTx0.Bytes(), mergedTx.Bytes())
+	require.Equal(parsedTx0.ID(), mergedTx.ID())
+}
+
+func TestMergeErrors(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		keys = secp256k1.TestKeys()
+	)
+	backend, utx := newMultisigTx(keys)
+	signer0 := New(secp256k1fx.NewKeychain(keys[0]), backend)
+
+	tx, err := SignUnsigned(ctx, signer0, utx)
+	require.NoError(t, err)
+
+	otherBackend, otherUTx := newMultisigTx(keys)
+	otherSigner0 := New(secp256k1fx.NewKeychain(keys[0]), otherBackend)
+	otherTx, err := SignUnsigned(ctx, otherSigner0, otherUTx)
+	require.NoError(t, err)
+
+	conflictingTx, err := txs.Parse(txs.Codec, tx.Bytes())
+	require.NoError(t, err)
+	conflictingTx.Creds[0].(*secp256k1fx.Credential).Sigs[0][0]++
+
+	truncatedTx, err := txs.Parse(txs.Codec, tx.Bytes())
+	require.NoError(t, err)
+	truncatedTx.Creds = truncatedTx.Creds[:1]
+
+	tests := []struct {
+		name        string
+		txs         []*txs.Tx
+		expectedErr error
+	}{
+		{
+			name:        "no txs",
+			expectedErr: ErrNoTxsToMerge,
+		},
+		{
+			name:        "mismatched unsigned tx",
+			txs:         []*txs.Tx{tx, otherTx},
+			expectedErr: ErrMismatchedUnsignedTx,
+		},
+		{
+			name:        "conflicting signature",
+			txs:         []*txs.Tx{tx, conflictingTx},
+			expectedErr: ErrConflictingSignature,
+		},
+		{
+			name:        "mismatched credentials",
+			txs:         []*txs.Tx{tx, truncatedTx},
+			expectedErr: ErrMismatchedCredentials,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Merge(test.txs...)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
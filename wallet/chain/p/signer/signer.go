@@ -13,7 +13,10 @@ import (
 	stdcontext "context"
 )
 
-var _ Signer = (*txSigner)(nil)
+var (
+	_ Signer                    = (*txSigner)(nil)
+	_ MissingSignaturesReporter = (*txSigner)(nil)
+)
 
 type Signer interface {
 	// Sign adds as many missing signatures as possible to the provided
@@ -27,6 +30,18 @@ type Signer interface {
 	Sign(ctx stdcontext.Context, tx *txs.Tx) error
 }
 
+// MissingSignaturesReporter is optionally implemented by a [Signer] to report
+// which signatures a partially signed transaction still requires.
+type MissingSignaturesReporter interface {
+	// MissingSignatures returns the signature slots of the provided
+	// transaction that have not been populated yet.
+	//
+	// This is independent of the keys held by the signer, so it can be used
+	// to determine which parties still need to sign a partially signed
+	// transaction.
+	MissingSignatures(ctx stdcontext.Context, tx *txs.Tx) ([]MissingSignature, error)
+}
+
 type Backend interface {
 	GetUTXO(ctx stdcontext.Context, chainID, utxoID ids.ID) (*avax.UTXO, error)
 	GetOwner(ctx stdcontext.Context, ownerID ids.ID) (fx.Owner, error)
@@ -53,6 +68,18 @@ func (s *txSigner) Sign(ctx stdcontext.Context, tx *txs.Tx) error {
 	})
 }
 
+func (s *txSigner) MissingSignatures(ctx stdcontext.Context, tx *txs.Tx) ([]MissingSignature, error) {
+	var missing []MissingSignature
+	err := tx.Unsigned.Visit(&visitor{
+		kc:      addressKeychain{},
+		backend: s.backend,
+		ctx:     ctx,
+		tx:      tx,
+		missing: &missing,
+	})
+	return missing, err
+}
+
 func SignUnsigned(
 	ctx stdcontext.Context,
 	signer Signer,
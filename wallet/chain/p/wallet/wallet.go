@@ -10,6 +10,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/wallet/chain/p/builder"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
@@ -26,6 +27,15 @@ type Client interface {
 		tx *txs.Tx,
 		options ...common.Option,
 	) error
+
+	// IssueTxWithFeeBump issues the tx built by [build] and re-builds and
+	// re-issues it with an increased fee rate until one of the issued txs is
+	// accepted. Re-built txs must consume the same inputs as the first issued
+	// tx. The accepted tx is returned.
+	IssueTxWithFeeBump(
+		build common.BuildFunc[*txs.Tx],
+		options ...common.Option,
+	) (*txs.Tx, error)
 }
 
 type Wallet interface {
@@ -345,11 +355,9 @@ func (w *wallet) IssueBaseTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewBaseTx(outputs, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewBaseTx(outputs, options...)
+	}, options)
 }
 
 func (w *wallet) IssueAddValidatorTx(
@@ -358,22 +366,18 @@ func (w *wallet) IssueAddValidatorTx(
 	shares uint32,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewAddValidatorTx(vdr, rewardsOwner, shares, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewAddValidatorTx(vdr, rewardsOwner, shares, options...)
+	}, options)
 }
 
 func (w *wallet) IssueAddSubnetValidatorTx(
 	vdr *txs.SubnetValidator,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewAddSubnetValidatorTx(vdr, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewAddSubnetValidatorTx(vdr, options...)
+	}, options)
 }
 
 func (w *wallet) IssueRemoveSubnetValidatorTx(
@@ -381,11 +385,9 @@ func (w *wallet) IssueRemoveSubnetValidatorTx(
 	subnetID ids.ID,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewRemoveSubnetValidatorTx(nodeID, subnetID, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewRemoveSubnetValidatorTx(nodeID, subnetID, options...)
+	}, options)
 }
 
 func (w *wallet) IssueAddDelegatorTx(
@@ -393,11 +395,9 @@ func (w *wallet) IssueAddDelegatorTx(
 	rewardsOwner *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewAddDelegatorTx(vdr, rewardsOwner, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewAddDelegatorTx(vdr, rewardsOwner, options...)
+	}, options)
 }
 
 func (w *wallet) IssueCreateChainTx(
@@ -408,22 +408,18 @@ func (w *wallet) IssueCreateChainTx(
 	chainName string,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewCreateChainTx(subnetID, genesis, vmID, fxIDs, chainName, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewCreateChainTx(subnetID, genesis, vmID, fxIDs, chainName, options...)
+	}, options)
 }
 
 func (w *wallet) IssueCreateSubnetTx(
 	owner *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewCreateSubnetTx(owner, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewCreateSubnetTx(owner, options...)
+	}, options)
 }
 
 func (w *wallet) IssueTransferSubnetOwnershipTx(
@@ -431,11 +427,9 @@ func (w *wallet) IssueTransferSubnetOwnershipTx(
 	owner *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewTransferSubnetOwnershipTx(subnetID, owner, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewTransferSubnetOwnershipTx(subnetID, owner, options...)
+	}, options)
 }
 
 func (w *wallet) IssueConvertSubnetToL1Tx(
@@ -445,11 +439,9 @@ func (w *wallet) IssueConvertSubnetToL1Tx(
 	validators []*txs.ConvertSubnetToL1Validator,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewConvertSubnetToL1Tx(subnetID, chainID, address, validators, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewConvertSubnetToL1Tx(subnetID, chainID, address, validators, options...)
+	}, options)
 }
 
 func (w *wallet) IssueRegisterL1ValidatorTx(
@@ -458,22 +450,18 @@ func (w *wallet) IssueRegisterL1ValidatorTx(
 	message []byte,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewRegisterL1ValidatorTx(balance, proofOfPossession, message, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewRegisterL1ValidatorTx(balance, proofOfPossession, message, options...)
+	}, options)
 }
 
 func (w *wallet) IssueSetL1ValidatorWeightTx(
 	message []byte,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewSetL1ValidatorWeightTx(message, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewSetL1ValidatorWeightTx(message, options...)
+	}, options)
 }
 
 func (w *wallet) IssueIncreaseL1ValidatorBalanceTx(
@@ -481,22 +469,18 @@ func (w *wallet) IssueIncreaseL1ValidatorBalanceTx(
 	balance uint64,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewIncreaseL1ValidatorBalanceTx(validationID, balance, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewIncreaseL1ValidatorBalanceTx(validationID, balance, options...)
+	}, options)
 }
 
 func (w *wallet) IssueDisableL1ValidatorTx(
 	validationID ids.ID,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewDisableL1ValidatorTx(validationID, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewDisableL1ValidatorTx(validationID, options...)
+	}, options)
 }
 
 func (w *wallet) IssueImportTx(
@@ -504,11 +488,9 @@ func (w *wallet) IssueImportTx(
 	to *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewImportTx(sourceChainID, to, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewImportTx(sourceChainID, to, options...)
+	}, options)
 }
 
 func (w *wallet) IssueExportTx(
@@ -516,11 +498,9 @@ func (w *wallet) IssueExportTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewExportTx(chainID, outputs, options...)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewExportTx(chainID, outputs, options...)
+	}, options)
 }
 
 func (w *wallet) IssueTransformSubnetTx(
@@ -540,27 +520,25 @@ func (w *wallet) IssueTransformSubnetTx(
 	uptimeRequirement uint32,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewTransformSubnetTx(
-		subnetID,
-		assetID,
-		initialSupply,
-		maxSupply,
-		minConsumptionRate,
-		maxConsumptionRate,
-		minValidatorStake,
-		maxValidatorStake,
-		minStakeDuration,
-		maxStakeDuration,
-		minDelegationFee,
-		minDelegatorStake,
-		maxValidatorWeightFactor,
-		uptimeRequirement,
-		options...,
-	)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewTransformSubnetTx(
+			subnetID,
+			assetID,
+			initialSupply,
+			maxSupply,
+			minConsumptionRate,
+			maxConsumptionRate,
+			minValidatorStake,
+			maxValidatorStake,
+			minStakeDuration,
+			maxStakeDuration,
+			minDelegationFee,
+			minDelegatorStake,
+			maxValidatorWeightFactor,
+			uptimeRequirement,
+			options...,
+		)
+	}, options)
 }
 
 func (w *wallet) IssueAddPermissionlessValidatorTx(
@@ -572,19 +550,17 @@ func (w *wallet) IssueAddPermissionlessValidatorTx(
 	shares uint32,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewAddPermissionlessValidatorTx(
-		vdr,
-		signer,
-		assetID,
-		validationRewardsOwner,
-		delegationRewardsOwner,
-		shares,
-		options...,
-	)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewAddPermissionlessValidatorTx(
+			vdr,
+			signer,
+			assetID,
+			validationRewardsOwner,
+			delegationRewardsOwner,
+			shares,
+			options...,
+		)
+	}, options)
 }
 
 func (w *wallet) IssueAddPermissionlessDelegatorTx(
@@ -593,16 +569,14 @@ func (w *wallet) IssueAddPermissionlessDelegatorTx(
 	rewardsOwner *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewAddPermissionlessDelegatorTx(
-		vdr,
-		assetID,
-		rewardsOwner,
-		options...,
-	)
-	if err != nil {
-		return nil, err
-	}
-	return w.IssueUnsignedTx(utx, options...)
+	return w.issue(func(options ...common.Option) (txs.UnsignedTx, error) {
+		return w.builder.NewAddPermissionlessDelegatorTx(
+			vdr,
+			assetID,
+			rewardsOwner,
+			options...,
+		)
+	}, options)
 }
 
 func (w *wallet) IssueUnsignedTx(
@@ -618,3 +592,51 @@ func (w *wallet) IssueUnsignedTx(
 
 	return tx, w.IssueTx(tx, options...)
 }
+
+// issue builds, signs, and issues the tx built by [build]. If fee bumping is
+// enabled, the tx is re-built with an increased gas price until it is
+// accepted.
+func (w *wallet) issue(
+	build func(options ...common.Option) (txs.UnsignedTx, error),
+	options []common.Option,
+) (*txs.Tx, error) {
+	ops := common.NewOptions(options)
+	if ops.FeeBump() == nil || ops.AssumeDecided() {
+		utx, err := build(options...)
+		if err != nil {
+			return nil, err
+		}
+		return w.IssueUnsignedTx(utx, options...)
+	}
+
+	var (
+		ctx            = ops.Context()
+		builderContext = w.builder.Context()
+		gasPrice       = ops.GasPrice(common.ScaleFeeRate(
+			builderContext.GasPrice,
+			common.PercentDenominator+ops.FeeMargin(),
+		))
+	)
+	return w.IssueTxWithFeeBump(
+		func(feeRatePercent uint64, bumpOptions ...common.Option) (*txs.Tx, uint64, error) {
+			bumpedGasPrice := common.ScaleFeeRate(gasPrice, feeRatePercent)
+			utx, err := build(common.UnionOptions(
+				common.UnionOptions(options, bumpOptions),
+				[]common.Option{common.WithGasPrice(bumpedGasPrice)},
+			)...)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			calculator := fee.NewDynamicCalculator(builderContext.ComplexityWeights, bumpedGasPrice)
+			txFee, err := calculator.CalculateFee(utx)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			tx, err := walletsigner.SignUnsigned(ctx, w.signer, utx)
+			return tx, txFee, err
+		},
+		options...,
+	)
+}
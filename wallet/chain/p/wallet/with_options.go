@@ -319,3 +319,13 @@ func (w *withOptions) IssueTx(
 		common.UnionOptions(w.options, options)...,
 	)
 }
+
+func (w *withOptions) IssueTxWithFeeBump(
+	build common.BuildFunc[*txs.Tx],
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueTxWithFeeBump(
+		build,
+		common.UnionOptions(w.options, options)...,
+	)
+}
@@ -371,6 +371,7 @@ func (b *builder) NewImportTx(
 	if err != nil {
 		return nil, err
 	}
+	utxos = ops.UTXOs(utxos)
 
 	var (
 		addrs           = ops.Addresses(b.addrs)
@@ -560,6 +561,7 @@ func (b *builder) spend(
 	if err != nil {
 		return nil, nil, err
 	}
+	utxos = options.UTXOs(utxos)
 
 	addrs := options.Addresses(b.addrs)
 	minIssuanceTime := options.MinIssuanceTime()
@@ -655,6 +657,7 @@ func (b *builder) mintFTs(
 	if err != nil {
 		return nil, err
 	}
+	utxos = options.UTXOs(utxos)
 
 	addrs := options.Addresses(b.addrs)
 	minIssuanceTime := options.MinIssuanceTime()
@@ -718,6 +721,7 @@ func (b *builder) mintNFTs(
 	if err != nil {
 		return nil, err
 	}
+	utxos = options.UTXOs(utxos)
 
 	addrs := options.Addresses(b.addrs)
 	minIssuanceTime := options.MinIssuanceTime()
@@ -775,6 +779,7 @@ func (b *builder) mintProperty(
 	if err != nil {
 		return nil, err
 	}
+	utxos = options.UTXOs(utxos)
 
 	addrs := options.Addresses(b.addrs)
 	minIssuanceTime := options.MinIssuanceTime()
@@ -832,6 +837,7 @@ func (b *builder) burnProperty(
 	if err != nil {
 		return nil, err
 	}
+	utxos = options.UTXOs(utxos)
 
 	addrs := options.Addresses(b.addrs)
 	minIssuanceTime := options.MinIssuanceTime()
@@ -0,0 +1,283 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package signer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/avm/fxs"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/nftfx"
+	"github.com/ava-labs/avalanchego/vms/propertyfx"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/x/builder"
+)
+
+var (
+	_ keychain.Keychain = addressKeychain{}
+	_ keychain.Signer   = addressSigner{}
+
+	ErrNoTxsToMerge          = errors.New("no txs to merge")
+	ErrMismatchedUnsignedTx  = errors.New("mismatched unsigned tx")
+	ErrMismatchedCredentials = errors.New("mismatched credentials")
+	ErrConflictingSignature  = errors.New("conflicting signature")
+
+	errAddressOnlySigner = errors.New("address only signer can't sign")
+)
+
+// MissingSignature identifies a signature slot of a transaction that has not
+// been populated yet.
+type MissingSignature struct {
+	// CredentialIndex is the index of the credential in the transaction.
+	CredentialIndex int
+	// SignatureIndex is the index of the signature in the credential.
+	SignatureIndex int
+	// Address is the address that is expected to populate the signature.
+	//
+	// If the UTXO being consumed isn't known by the backend, the address can't
+	// be determined and will be empty.
+	Address ids.ShortID
+}
+
+// Merge combines the signatures of several partially signed copies of the same
+// unsigned transaction into a single transaction.
+//
+// Transactions without any credentials are treated as unsigned copies and
+// don't contribute any signatures.
+func Merge(partialTxs ...*txs.Tx) (*txs.Tx, error) {
+	if len(partialTxs) == 0 {
+		return nil, ErrNoTxsToMerge
+	}
+
+	codec := builder.Parser.Codec()
+	unsignedTx := partialTxs[0].Unsigned
+	unsignedBytes, err := codec.Marshal(txs.CodecVersion, &unsignedTx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't marshal unsigned tx: %w", err)
+	}
+
+	var creds []*fxs.FxCredential
+	for i, partialTx := range partialTxs {
+		partialUnsignedBytes, err := codec.Marshal(txs.CodecVersion, &partialTx.Unsigned)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't marshal unsigned tx %d: %w", i, err)
+		}
+		if !bytes.Equal(unsignedBytes, partialUnsignedBytes) {
+			return nil, fmt.Errorf("%w: tx %d", ErrMismatchedUnsignedTx, i)
+		}
+
+		if len(partialTx.Creds) == 0 {
+			continue
+		}
+		if creds == nil {
+			creds = make([]*fxs.FxCredential, len(partialTx.Creds))
+			for credIndex, fxCred := range partialTx.Creds {
+				creds[credIndex], err = newEmptyCredential(fxCred)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		if len(creds) != len(partialTx.Creds) {
+			return nil, fmt.Errorf("%w: tx %d has %d credentials but expected %d",
+				ErrMismatchedCredentials,
+				i,
+				len(partialTx.Creds),
+				len(creds),
+			)
+		}
+
+		for credIndex, fxCred := range partialTx.Creds {
+			into, err := getCredential(creds[credIndex])
+			if err != nil {
+				return nil, err
+			}
+			from, err := getCredential(fxCred)
+			if err != nil {
+				return nil, err
+			}
+			// The fx ID isn't serialized, so it is determined by the type of
+			// the credential.
+			fxID, err := credentialFxID(fxCred)
+			if err != nil {
+				return nil, err
+			}
+			if creds[credIndex].FxID != fxID {
+				return nil, fmt.Errorf("%w: tx %d credential %d has fx %s but expected %s",
+					ErrMismatchedCredentials,
+					i,
+					credIndex,
+					fxID,
+					creds[credIndex].FxID,
+				)
+			}
+			if err := mergeSigs(into, from); err != nil {
+				return nil, fmt.Errorf("tx %d credential %d: %w", i, credIndex, err)
+			}
+		}
+	}
+
+	tx := &txs.Tx{
+		Unsigned: unsignedTx,
+		Creds:    creds,
+	}
+	return tx, tx.Initialize(codec)
+}
+
+// newEmptyCredential returns a credential of the same type as [fxCred] without
+// any signatures.
+func newEmptyCredential(fxCred *fxs.FxCredential) (*fxs.FxCredential, error) {
+	fxID, err := credentialFxID(fxCred)
+	if err != nil {
+		return nil, err
+	}
+
+	newCred := &fxs.FxCredential{
+		FxID: fxID,
+	}
+	switch fxID {
+	case secp256k1fx.ID:
+		newCred.Credential = &secp256k1fx.Credential{}
+	case nftfx.ID:
+		newCred.Credential = &nftfx.Credential{}
+	default:
+		newCred.Credential = &propertyfx.Credential{}
+	}
+	return newCred, nil
+}
+
+// credentialFxID returns the ID of the fx of the credential wrapped by
+// [fxCred].
+func credentialFxID(fxCred *fxs.FxCredential) (ids.ID, error) {
+	if fxCred == nil {
+		return ids.Empty, ErrUnknownCredentialType
+	}
+
+	switch fxCred.Credential.(type) {
+	case *secp256k1fx.Credential:
+		return secp256k1fx.ID, nil
+	case *nftfx.Credential:
+		return nftfx.ID, nil
+	case *propertyfx.Credential:
+		return propertyfx.ID, nil
+	default:
+		return ids.Empty, ErrUnknownCredentialType
+	}
+}
+
+// getCredential returns the secp256k1fx credential wrapped by [fxCred].
+func getCredential(fxCred *fxs.FxCredential) (*secp256k1fx.Credential, error) {
+	if fxCred == nil {
+		return nil, ErrUnknownCredentialType
+	}
+
+	switch cred := fxCred.Credential.(type) {
+	case *secp256k1fx.Credential:
+		return cred, nil
+	case *nftfx.Credential:
+		return &cred.Credential, nil
+	case *propertyfx.Credential:
+		return &cred.Credential, nil
+	default:
+		return nil, ErrUnknownCredentialType
+	}
+}
+
+// mergeSigs copies the populated signatures of [from] into [into].
+func mergeSigs(into, from *secp256k1fx.Credential) error {
+	if into.Sigs == nil {
+		into.Sigs = make([][secp256k1.SignatureLen]byte, len(from.Sigs))
+	}
+	if len(into.Sigs) != len(from.Sigs) {
+		return fmt.Errorf("%w: has %d signatures but expected %d",
+			ErrMismatchedCredentials,
+			len(from.Sigs),
+			len(into.Sigs),
+		)
+	}
+
+	for sigIndex, sig := range from.Sigs {
+		switch existingSig := into.Sigs[sigIndex]; {
+		case sig == emptySig:
+		case existingSig == emptySig:
+			into.Sigs[sigIndex] = sig
+		case existingSig != sig:
+			return fmt.Errorf("%w: signature %d", ErrConflictingSignature, sigIndex)
+		}
+	}
+	return nil
+}
+
+// missingSignatures returns the signature slots of [tx] that would not be
+// populated by [sign].
+func missingSignatures(tx *txs.Tx, txSigners [][]keychain.Signer) ([]MissingSignature, error) {
+	var missing []MissingSignature
+	for credIndex, inputSigners := range txSigners {
+		var sigs [][secp256k1.SignatureLen]byte
+		if len(tx.Creds) == len(txSigners) {
+			if fxCred := tx.Creds[credIndex]; fxCred != nil && fxCred.Credential != nil {
+				cred, err := getCredential(fxCred)
+				if err != nil {
+					return nil, err
+				}
+				sigs = cred.Sigs
+			}
+		}
+		if len(sigs) != len(inputSigners) {
+			// [sign] would reset the signatures of this credential.
+			sigs = nil
+		}
+
+		for sigIndex, signer := range inputSigners {
+			if sigIndex < len(sigs) && sigs[sigIndex] != emptySig {
+				continue
+			}
+
+			var addr ids.ShortID
+			if signer != nil {
+				addr = signer.Address()
+			}
+			missing = append(missing, MissingSignature{
+				CredentialIndex: credIndex,
+				SignatureIndex:  sigIndex,
+				Address:         addr,
+			})
+		}
+	}
+	return missing, nil
+}
+
+// addressKeychain reports that it can sign for every address. It is used to
+// determine the address that is expected to populate every signature slot.
+type addressKeychain struct{}
+
+func (addressKeychain) Get(addr ids.ShortID) (keychain.Signer, bool) {
+	return addressSigner{addr: addr}, true
+}
+
+func (addressKeychain) Addresses() set.Set[ids.ShortID] {
+	return nil
+}
+
+type addressSigner struct {
+	addr ids.ShortID
+}
+
+func (addressSigner) SignHash([]byte) ([]byte, error) {
+	return nil, errAddressOnlySigner
+}
+
+func (addressSigner) Sign([]byte) ([]byte, error) {
+	return nil, errAddressOnlySigner
+}
+
+func (s addressSigner) Address() ids.ShortID {
+	return s.addr
+}
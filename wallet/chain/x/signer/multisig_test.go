@@ -0,0 +1,298 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package signer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/vms/avm/fxs"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/nftfx"
+	"github.com/ava-labs/avalanchego/vms/propertyfx"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/x/builder"
+)
+
+var _ Backend = (*testBackend)(nil)
+
+type testBackend struct {
+	utxos map[ids.ID]*avax.UTXO
+}
+
+func (b *testBackend) GetUTXO(_ context.Context, _, utxoID ids.ID) (*avax.UTXO, error) {
+	utxo, ok := b.utxos[utxoID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return utxo, nil
+}
+
+// newMultisigTx returns an unsigned tx that consumes:
+//   - a secp256k1fx UTXO owned by keys[0]
+//   - an nftfx UTXO owned by a 2-of-3 of keys[0], keys[1], and keys[2], which
+//     is authorized by keys[0] and keys[2]
+//   - a propertyfx UTXO owned by a 2-of-2 of keys[0] and keys[2]
+func newMultisigTx(keys []*secp256k1.PrivateKey) (*testBackend, *txs.OperationTx) {
+	var (
+		avaxAssetID     = ids.GenerateTestID()
+		nftAssetID      = ids.GenerateTestID()
+		propertyAssetID = ids.GenerateTestID()
+		avaxUTXO        = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{keys[0].Address()},
+				},
+			},
+		}
+		nftUTXO = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: avax.Asset{ID: nftAssetID},
+			Out: &nftfx.TransferOutput{
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 2,
+					Addrs: []ids.ShortID{
+						keys[0].Address(),
+						keys[1].Address(),
+						keys[2].Address(),
+					},
+				},
+			},
+		}
+		propertyUTXO = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: avax.Asset{ID: propertyAssetID},
+			Out: &propertyfx.OwnedOutput{
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 2,
+					Addrs: []ids.ShortID{
+						keys[0].Address(),
+						keys[2].Address(),
+					},
+				},
+			},
+		}
+	)
+	backend := &testBackend{
+		utxos: map[ids.ID]*avax.UTXO{
+			avaxUTXO.InputID():     avaxUTXO,
+			nftUTXO.InputID():      nftUTXO,
+			propertyUTXO.InputID(): propertyUTXO,
+		},
+	}
+	utx := &txs.OperationTx{
+		BaseTx: txs.BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    constants.UnitTestID,
+				BlockchainID: ids.GenerateTestID(),
+				Ins: []*avax.TransferableInput{
+					{
+						UTXOID: avaxUTXO.UTXOID,
+						Asset:  avaxUTXO.Asset,
+						In: &secp256k1fx.TransferInput{
+							Amt: 1,
+							Input: secp256k1fx.Input{
+								SigIndices: []uint32{0},
+							},
+						},
+					},
+				},
+			},
+		},
+		Ops: []*txs.Operation{
+			{
+				Asset:   nftUTXO.Asset,
+				UTXOIDs: []*avax.UTXOID{&nftUTXO.UTXOID},
+				Op: &nftfx.TransferOperation{
+					Input: secp256k1fx.Input{
+						SigIndices: []uint32{0, 2},
+					},
+					Output: nftfx.TransferOutput{
+						OutputOwners: secp256k1fx.OutputOwners{
+							Threshold: 1,
+							Addrs:     []ids.ShortID{keys[1].Address()},
+						},
+					},
+				},
+			},
+			{
+				Asset:   propertyUTXO.Asset,
+				UTXOIDs: []*avax.UTXOID{&propertyUTXO.UTXOID},
+				Op: &propertyfx.BurnOperation{
+					Input: secp256k1fx.Input{
+						SigIndices: []uint32{0, 1},
+					},
+				},
+			},
+		},
+	}
+	return backend, utx
+}
+
+func TestMultisigSignAndMerge(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx  = context.Background()
+		keys = secp256k1.TestKeys()
+	)
+	backend, utx := newMultisigTx(keys)
+
+	signer0 := New(secp256k1fx.NewKeychain(keys[0]), backend)
+	signer2 := New(secp256k1fx.NewKeychain(keys[2]), backend)
+
+	reporter0, ok := signer0.(MissingSignaturesReporter)
+	require.True(ok)
+	reporter2, ok := signer2.(MissingSignaturesReporter)
+	require.True(ok)
+
+	unsignedTx := &txs.Tx{Unsigned: utx}
+	missing, err := reporter0.MissingSignatures(ctx, unsignedTx)
+	require.NoError(err)
+	require.Equal(
+		[]MissingSignature{
+			{CredentialIndex: 0, SignatureIndex: 0, Address: keys[0].Address()},
+			{CredentialIndex: 1, SignatureIndex: 0, Address: keys[0].Address()},
+			{CredentialIndex: 1, SignatureIndex: 1, Address: keys[2].Address()},
+			{CredentialIndex: 2, SignatureIndex: 0, Address: keys[0].Address()},
+			{CredentialIndex: 2, SignatureIndex: 1, Address: keys[2].Address()},
+		},
+		missing,
+	)
+
+	partialTx0, err := SignUnsigned(ctx, signer0, utx)
+	require.NoError(err)
+
+	// Round trip the partially signed tx to simulate passing it to another
+	// party.
+	parsedTx0, err := builder.Parser.ParseTx(partialTx0.Bytes())
+	require.NoError(err)
+	require.IsType(&secp256k1fx.Credential{}, parsedTx0.Creds[0].Credential)
+	require.IsType(&nftfx.Credential{}, parsedTx0.Creds[1].Credential)
+	require.IsType(&propertyfx.Credential{}, parsedTx0.Creds[2].Credential)
+
+	missing, err = reporter2.MissingSignatures(ctx, parsedTx0)
+	require.NoError(err)
+	require.Equal(
+		[]MissingSignature{
+			{CredentialIndex: 1, SignatureIndex: 1, Address: keys[2].Address()},
+			{CredentialIndex: 2, SignatureIndex: 1, Address: keys[2].Address()},
+		},
+		missing,
+	)
+
+	partialTx2, err := SignUnsigned(ctx, signer2, parsedTx0.Unsigned)
+	require.NoError(err)
+
+	mergedTx, err := Merge(parsedTx0, unsignedTx, partialTx2)
+	require.NoError(err)
+	require.Equal(
+		[]ids.ID{secp256k1fx.ID, nftfx.ID, propertyfx.ID},
+		[]ids.ID{mergedTx.Creds[0].FxID, mergedTx.Creds[1].FxID, mergedTx.Creds[2].FxID},
+	)
+
+	missing, err = reporter0.MissingSignatures(ctx, mergedTx)
+	require.NoError(err)
+	require.Empty(missing)
+
+	// Signing sequentially should produce the same tx as merging.
+	require.NoError(signer2.Sign(ctx, parsedTx0))
+	require.Equal(parsedTx0.Bytes(), mergedTx.Bytes())
+	require.Equal(parsedTx0.ID(), mergedTx.ID())
+}
+
+func TestMergeErrors(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		keys = secp256k1.TestKeys()
+	)
+	backend, utx := newMultisigTx(keys)
+	signer0 := New(secp256k1fx.NewKeychain(keys[0]), backend)
+
+	tx, err := SignUnsigned(ctx, signer0, utx)
+	require.NoError(t, err)
+
+	otherBackend, otherUTx := newMultisigTx(keys)
+	otherSigner0 := New(secp256k1fx.NewKeychain(keys[0]), otherBackend)
+	otherTx, err := SignUnsigned(ctx, otherSigner0, otherUTx)
+	require.NoError(t, err)
+
+	conflictingNFTTx, err := builder.Parser.ParseTx(tx.Bytes())
+	require.NoError(t, err)
+	conflictingNFTTx.Creds[1].Credential.(*nftfx.Credential).Sigs[0][0]++
+
+	conflictingPropertyTx, err := builder.Parser.ParseTx(tx.Bytes())
+	require.NoError(t, err)
+	conflictingPropertyTx.Creds[2].Credential.(*propertyfx.Credential).Sigs[0][0]++
+
+	truncatedTx, err := builder.Parser.ParseTx(tx.Bytes())
+	require.NoError(t, err)
+	truncatedTx.Creds = truncatedTx.Creds[:1]
+
+	mismatchedFxTx, err := builder.Parser.ParseTx(tx.Bytes())
+	require.NoError(t, err)
+	mismatchedFxTx.Creds[1] = &fxs.FxCredential{
+		FxID:       propertyfx.ID,
+		Credential: &propertyfx.Credential{Credential: mismatchedFxTx.Creds[1].Credential.(*nftfx.Credential).Credential},
+	}
+
+	tests := []struct {
+		name        string
+		txs         []*txs.Tx
+		expectedErr error
+	}{
+		{
+			name:        "no txs",
+			expectedErr: ErrNoTxsToMerge,
+		},
+		{
+			name:        "mismatched unsigned tx",
+			txs:         []*txs.Tx{tx, otherTx},
+			expectedErr: ErrMismatchedUnsignedTx,
+		},
+		{
+			name:        "conflicting nft signature",
+			txs:         []*txs.Tx{tx, conflictingNFTTx},
+			expectedErr: ErrConflictingSignature,
+		},
+		{
+			name:        "conflicting property signature",
+			txs:         []*txs.Tx{tx, conflictingPropertyTx},
+			expectedErr: ErrConflictingSignature,
+		},
+		{
+			name:        "mismatched credentials",
+			txs:         []*txs.Tx{tx, truncatedTx},
+			expectedErr: ErrMismatchedCredentials,
+		},
+		{
+			name:        "mismatched credential fx",
+			txs:         []*txs.Tx{tx, mismatchedFxTx},
+			expectedErr: ErrMismatchedCredentials,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Merge(test.txs...)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
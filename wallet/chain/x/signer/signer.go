@@ -12,7 +12,10 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 )
 
-var _ Signer = (*signer)(nil)
+var (
+	_ Signer                    = (*signer)(nil)
+	_ MissingSignaturesReporter = (*signer)(nil)
+)
 
 type Signer interface {
 	// Sign adds as many missing signatures as possible to the provided
@@ -26,6 +29,18 @@ type Signer interface {
 	Sign(ctx context.Context, tx *txs.Tx) error
 }
 
+// MissingSignaturesReporter is optionally implemented by a [Signer] to report
+// which signatures a partially signed transaction still requires.
+type MissingSignaturesReporter interface {
+	// MissingSignatures returns the signature slots of the provided
+	// transaction that have not been populated yet.
+	//
+	// This is independent of the keys held by the signer, so it can be used
+	// to determine which parties still need to sign a partially signed
+	// transaction.
+	MissingSignatures(ctx context.Context, tx *txs.Tx) ([]MissingSignature, error)
+}
+
 type Backend interface {
 	GetUTXO(ctx context.Context, chainID, utxoID ids.ID) (*avax.UTXO, error)
 }
@@ -51,6 +66,18 @@ func (s *signer) Sign(ctx context.Context, tx *txs.Tx) error {
 	})
 }
 
+func (s *signer) MissingSignatures(ctx context.Context, tx *txs.Tx) ([]MissingSignature, error) {
+	var missing []MissingSignature
+	err := tx.Unsigned.Visit(&visitor{
+		kc:      addressKeychain{},
+		backend: s.backend,
+		ctx:     ctx,
+		tx:      tx,
+		missing: &missing,
+	})
+	return missing, err
+}
+
 func SignUnsigned(
 	ctx context.Context,
 	signer Signer,
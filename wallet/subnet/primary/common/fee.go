@@ -0,0 +1,242 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+// PercentDenominator is the denominator of the percentages used to scale fee
+// rates.
+const PercentDenominator = 100
+
+var (
+	ErrInvalidFeeBump     = errors.New("invalid fee bump")
+	ErrMaxFeeExceeded     = errors.New("max fee exceeded")
+	ErrInputsChanged      = errors.New("re-built transaction consumes different inputs")
+	errMissingFeeBump     = errors.New("missing fee bump config")
+	errNoIssuedTxs        = errors.New("no issued txs")
+	bigPercentDenominator = big.NewInt(PercentDenominator)
+)
+
+// FeeBump configures re-issuing transactions with a higher fee if they are not
+// accepted in time.
+//
+// Fee bumping is only supported by chains with dynamic fees.
+type FeeBump struct {
+	// Timeout is how long to wait for an issued transaction to be accepted
+	// before re-building and re-issuing it with a higher fee.
+	Timeout time.Duration
+	// IncreasePercent is the percentage that the fee rate is increased by
+	// every time a transaction is re-built.
+	IncreasePercent uint64
+	// MaxFee is the maximum fee, denominated in nAVAX, that a transaction is
+	// allowed to pay. Once re-building a transaction would exceed this fee,
+	// the previously issued transactions are waited on indefinitely.
+	MaxFee uint64
+}
+
+func (f *FeeBump) Verify() error {
+	switch {
+	case f.Timeout <= 0:
+		return fmt.Errorf("%w: timeout must be positive", ErrInvalidFeeBump)
+	case f.IncreasePercent == 0:
+		return fmt.Errorf("%w: increase percent must be positive", ErrInvalidFeeBump)
+	default:
+		return nil
+	}
+}
+
+// ScaleFeeRate returns [rate] scaled by [percent] percent, rounding up. If the
+// result overflows, the maximum value is returned.
+func ScaleFeeRate[T ~uint64](rate T, percent uint64) T {
+	scaled, err := safemath.Mul(uint64(rate), percent)
+	if err != nil {
+		return T(safemath.MaxUint[uint64]())
+	}
+	scaled, err = safemath.Add(scaled, PercentDenominator-1)
+	if err != nil {
+		return T(safemath.MaxUint[uint64]())
+	}
+	return T(scaled / PercentDenominator)
+}
+
+// ScaleBigFeeRate returns [rate] scaled by [percent] percent, rounding up.
+func ScaleBigFeeRate(rate *big.Int, percent uint64) *big.Int {
+	scaled := new(big.Int).Mul(rate, new(big.Int).SetUint64(percent))
+	scaled.Add(scaled, big.NewInt(PercentDenominator-1))
+	return scaled.Div(scaled, bigPercentDenominator)
+}
+
+// FeeBumpTx is a transaction that can be issued with IssueWithFeeBump.
+type FeeBumpTx interface {
+	ID() ids.ID
+}
+
+// BuildFunc builds and signs a transaction with the estimated fee rate scaled
+// by [feeRatePercent] percent. [options] must be provided to the builder, as
+// they restrict the UTXOs that re-built transactions may consume. The returned
+// fee is the fee, denominated in nAVAX, paid by the transaction.
+type BuildFunc[T FeeBumpTx] func(feeRatePercent uint64, options ...Option) (tx T, fee uint64, err error)
+
+// IssueWithFeeBump issues the transaction built by [build] and waits for it to
+// be accepted.
+//
+// If the transaction isn't accepted before the fee bump timeout, it is
+// re-built with a fee rate increased by the configured percentage and
+// re-issued. Re-built transactions may only consume the inputs of the first
+// transaction, and are not issued unless [inputIDs] reports that they consume
+// exactly the same inputs. This guarantees that at most one of the issued
+// transactions can be accepted. The accepted transaction is returned.
+//
+// If re-issuing a transaction fails, for example because the mempool rejects
+// it as conflicting with a previously issued transaction, the previously
+// issued transactions continue to be waited on. If none of them are accepted,
+// the last re-issuance error is returned along with the error that ended the
+// wait.
+func IssueWithFeeBump[T FeeBumpTx](
+	ops *Options,
+	build BuildFunc[T],
+	inputIDs func(tx T) set.Set[ids.ID],
+	issue func(ctx context.Context, tx T) error,
+	isAccepted func(ctx context.Context, txID ids.ID) (bool, error),
+) (T, error) {
+	var (
+		ctx            = ops.Context()
+		config         = ops.FeeBump()
+		feeRatePercent = uint64(PercentDenominator)
+		canBump        = true
+		issued         []T
+		// consumed is the set of inputs consumed by the first issued
+		// transaction, which every re-built transaction must consume.
+		consumed   set.Set[ids.ID]
+		reissueErr error
+		zero       T
+	)
+	if config == nil {
+		return zero, errMissingFeeBump
+	}
+	if err := config.Verify(); err != nil {
+		return zero, err
+	}
+
+	for {
+		if canBump {
+			var buildOptions []Option
+			if len(issued) > 0 {
+				buildOptions = []Option{WithUTXOIDs(consumed)}
+			}
+			tx, fee, err := build(feeRatePercent, buildOptions...)
+			if err == nil && len(issued) > 0 && !inputIDs(tx).Equals(consumed) {
+				err = ErrInputsChanged
+			}
+			switch {
+			case err != nil && len(issued) == 0:
+				return zero, err
+			case err != nil:
+				// If the transaction can no longer be built, for example if the
+				// fee can't be paid, the previously issued transactions are
+				// waited on.
+				canBump = false
+				reissueErr = err
+			case fee > config.MaxFee && len(issued) == 0:
+				return zero, fmt.Errorf("%w: fee %d > max fee %d",
+					ErrMaxFeeExceeded,
+					fee,
+					config.MaxFee,
+				)
+			case fee > config.MaxFee:
+				canBump = false
+			default:
+				err := issue(ctx, tx)
+				switch {
+				case err != nil && len(issued) == 0:
+					return zero, err
+				case err != nil:
+					// A previously issued transaction is likely still being
+					// processed or was already accepted, so the re-built
+					// transaction conflicts with it.
+					reissueErr = err
+				default:
+					if len(issued) == 0 {
+						consumed = inputIDs(tx)
+					}
+					issued = append(issued, tx)
+					if f := ops.PostIssuanceFunc(); f != nil {
+						f(tx.ID())
+					}
+				}
+			}
+		}
+
+		var timeout time.Duration
+		if canBump {
+			timeout = config.Timeout
+		}
+		tx, accepted, err := awaitAnyAccepted(ctx, issued, isAccepted, ops.PollFrequency(), timeout)
+		switch {
+		case err != nil && reissueErr != nil:
+			return zero, fmt.Errorf("%w: last re-issuance failed: %w", err, reissueErr)
+		case err != nil || accepted:
+			return tx, err
+		}
+
+		feeRatePercent = ScaleFeeRate(feeRatePercent, PercentDenominator+config.IncreasePercent)
+	}
+}
+
+// awaitAnyAccepted waits until any of [txs] has been accepted. If [timeout] is
+// non-zero and none of the transactions were accepted within [timeout], false
+// is returned.
+func awaitAnyAccepted[T FeeBumpTx](
+	ctx context.Context,
+	txs []T,
+	isAccepted func(ctx context.Context, txID ids.ID) (bool, error),
+	freq time.Duration,
+	timeout time.Duration,
+) (T, bool, error) {
+	var zero T
+	if len(txs) == 0 {
+		return zero, false, errNoIssuedTxs
+	}
+
+	var timeoutC <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutC = timer.C
+	}
+
+	ticker := time.NewTicker(freq)
+	defer ticker.Stop()
+
+	for {
+		for _, tx := range txs {
+			accepted, err := isAccepted(ctx, tx.ID())
+			if err != nil {
+				return zero, false, err
+			}
+			if accepted {
+				return tx, true, nil
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-timeoutC:
+			return zero, false, nil
+		case <-ctx.Done():
+			return zero, false, ctx.Err()
+		}
+	}
+}
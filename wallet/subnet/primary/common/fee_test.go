@@ -0,0 +1,432 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+)
+
+var errTest = errors.New("non-nil error")
+
+type testTx struct {
+	id             ids.ID
+	feeRatePercent uint64
+	inputs         set.Set[ids.ID]
+}
+
+func (t *testTx) ID() ids.ID {
+	return t.id
+}
+
+func (t *testTx) inputIDs() set.Set[ids.ID] {
+	return t.inputs
+}
+
+func TestScaleFeeRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		rate     uint64
+		percent  uint64
+		expected uint64
+		// overflows is true if the result doesn't fit into a uint64, in which
+		// case only ScaleFeeRate saturates.
+		overflows bool
+	}{
+		{
+			name:     "unchanged",
+			rate:     1_000,
+			percent:  100,
+			expected: 1_000,
+		},
+		{
+			name:     "increase",
+			rate:     1_000,
+			percent:  125,
+			expected: 1_250,
+		},
+		{
+			name:     "round up",
+			rate:     1,
+			percent:  101,
+			expected: 2,
+		},
+		{
+			name:      "overflow",
+			rate:      math.MaxUint64,
+			percent:   200,
+			expected:  math.MaxUint64,
+			overflows: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			require.Equal(test.expected, ScaleFeeRate(test.rate, test.percent))
+			if test.overflows {
+				return
+			}
+			require.Equal(
+				new(big.Int).SetUint64(test.expected),
+				ScaleBigFeeRate(new(big.Int).SetUint64(test.rate), test.percent),
+			)
+		})
+	}
+}
+
+func TestIssueWithFeeBump(t *testing.T) {
+	tests := []struct {
+		name string
+		// fee is the fee of a tx built with the provided fee rate percent.
+		fee func(feeRatePercent uint64) uint64
+		// buildErr is returned when building the nth tx, if non-nil.
+		buildErr func(numBuilt int) error
+		// acceptedIndex is the index of the issued tx that will be accepted
+		// once acceptAfter txs have been issued.
+		acceptedIndex int
+		acceptAfter   int
+		maxFee        uint64
+
+		expectedErr             error
+		expectedFeeRatePercents []uint64
+	}{
+		{
+			name:                    "accepted without bump",
+			fee:                     func(p uint64) uint64 { return p },
+			acceptedIndex:           0,
+			acceptAfter:             1,
+			maxFee:                  1_000,
+			expectedFeeRatePercents: []uint64{100},
+		},
+		{
+			name:                    "accepted after bumps",
+			fee:                     func(p uint64) uint64 { return p },
+			acceptedIndex:           2,
+			acceptAfter:             3,
+			maxFee:                  1_000,
+			expectedFeeRatePercents: []uint64{100, 150, 225},
+		},
+		{
+			name:                    "max fee reached",
+			fee:                     func(p uint64) uint64 { return p },
+			acceptedIndex:           1,
+			acceptAfter:             2,
+			maxFee:                  200,
+			expectedFeeRatePercents: []uint64{100, 150},
+		},
+		{
+			name:        "initial fee exceeds max fee",
+			fee:         func(p uint64) uint64 { return p },
+			maxFee:      99,
+			expectedErr: ErrMaxFeeExceeded,
+		},
+		{
+			name: "initial build fails",
+			fee:  func(p uint64) uint64 { return p },
+			buildErr: func(int) error {
+				return errTest
+			},
+			maxFee:      1_000,
+			expectedErr: errTest,
+		},
+		{
+			name: "re-build fails",
+			fee:  func(p uint64) uint64 { return p },
+			buildErr: func(numBuilt int) error {
+				if numBuilt > 0 {
+					return errTest
+				}
+				return nil
+			},
+			acceptedIndex:           0,
+			acceptAfter:             1,
+			maxFee:                  1_000,
+			expectedFeeRatePercents: []uint64{100},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			var (
+				built    int
+				issued   []*testTx
+				notified []ids.ID
+				ops      = NewOptions([]Option{
+					WithPollFrequency(time.Millisecond),
+					WithFeeBump(FeeBump{
+						Timeout:         10 * time.Millisecond,
+						IncreasePercent: 50,
+						MaxFee:          test.maxFee,
+					}),
+					WithPostIssuanceFunc(func(txID ids.ID) {
+						notified = append(notified, txID)
+					}),
+				})
+			)
+			tx, err := IssueWithFeeBump(
+				ops,
+				func(feeRatePercent uint64, _ ...Option) (*testTx, uint64, error) {
+					if test.buildErr != nil {
+						if err := test.buildErr(built); err != nil {
+							return nil, 0, err
+						}
+					}
+					built++
+					return &testTx{
+						id:             ids.GenerateTestID(),
+						feeRatePercent: feeRatePercent,
+					}, test.fee(feeRatePercent), nil
+				},
+				(*testTx).inputIDs,
+				func(_ context.Context, tx *testTx) error {
+					issued = append(issued, tx)
+					return nil
+				},
+				func(_ context.Context, txID ids.ID) (bool, error) {
+					if len(issued) < test.acceptAfter {
+						return false, nil
+					}
+					return issued[test.acceptedIndex].id == txID, nil
+				},
+			)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				require.Empty(issued)
+				return
+			}
+
+			require.Equal(issued[test.acceptedIndex], tx)
+			feeRatePercents := make([]uint64, len(issued))
+			expectedNotified := make([]ids.ID, len(issued))
+			for i, tx := range issued {
+				feeRatePercents[i] = tx.feeRatePercent
+				expectedNotified[i] = tx.id
+			}
+			require.Equal(test.expectedFeeRatePercents, feeRatePercents)
+			require.Equal(expectedNotified, notified)
+		})
+	}
+}
+
+func TestIssueWithFeeBumpInvalidConfig(t *testing.T) {
+	ops := NewOptions([]Option{
+		WithFeeBump(FeeBump{}),
+	})
+	_, err := IssueWithFeeBump(
+		ops,
+		func(uint64, ...Option) (*testTx, uint64, error) {
+			return &testTx{}, 0, nil
+		},
+		(*testTx).inputIDs,
+		func(context.Context, *testTx) error {
+			return nil
+		},
+		func(context.Context, ids.ID) (bool, error) {
+			return true, nil
+		},
+	)
+	require.ErrorIs(t, err, ErrInvalidFeeBump)
+}
+
+func TestIssueWithFeeBumpMissingConfig(t *testing.T) {
+	_, err := IssueWithFeeBump(
+		NewOptions(nil),
+		func(uint64, ...Option) (*testTx, uint64, error) {
+			return &testTx{}, 0, nil
+		},
+		(*testTx).inputIDs,
+		func(context.Context, *testTx) error {
+			return nil
+		},
+		func(context.Context, ids.ID) (bool, error) {
+			return true, nil
+		},
+	)
+	require.ErrorIs(t, err, errMissingFeeBump)
+}
+
+func TestIssueWithFeeBumpConflictRejected(t *testing.T) {
+	tests := []struct {
+		name        string
+		acceptFirst bool
+		expectedErr error
+	}{
+		{
+			name:        "original accepted",
+			acceptFirst: true,
+		},
+		{
+			name:        "none accepted",
+			expectedErr: context.DeadlineExceeded,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			var (
+				built    int
+				issued   []*testTx
+				notified []ids.ID
+				ops      = NewOptions([]Option{
+					WithContext(ctx),
+					WithPollFrequency(time.Millisecond),
+					WithFeeBump(FeeBump{
+						Timeout:         5 * time.Millisecond,
+						IncreasePercent: 50,
+						MaxFee:          math.MaxUint64,
+					}),
+					WithPostIssuanceFunc(func(txID ids.ID) {
+						notified = append(notified, txID)
+					}),
+				})
+			)
+			tx, err := IssueWithFeeBump(
+				ops,
+				func(feeRatePercent uint64, _ ...Option) (*testTx, uint64, error) {
+					built++
+					return &testTx{
+						id:             ids.GenerateTestID(),
+						feeRatePercent: feeRatePercent,
+					}, feeRatePercent, nil
+				},
+				(*testTx).inputIDs,
+				func(_ context.Context, tx *testTx) error {
+					// The mempool rejects every tx that conflicts with the
+					// first issued tx.
+					if len(issued) > 0 {
+						return errTest
+					}
+					issued = append(issued, tx)
+					return nil
+				},
+				func(_ context.Context, txID ids.ID) (bool, error) {
+					// Accept the original tx once a conflicting tx has been
+					// rejected.
+					return test.acceptFirst && built > 2 && issued[0].id == txID, nil
+				},
+			)
+			require.ErrorIs(err, test.expectedErr)
+			require.Len(issued, 1)
+			require.Equal([]ids.ID{issued[0].id}, notified)
+			if test.expectedErr != nil {
+				// The rejection of the conflicting txs is reported.
+				require.ErrorIs(err, errTest)
+				return
+			}
+			require.Equal(issued[0], tx)
+		})
+	}
+}
+
+func TestIssueWithFeeBumpConsumesSameInputs(t *testing.T) {
+	var (
+		consumedUTXO = &avax.UTXO{
+			UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+		}
+		otherUTXO = &avax.UTXO{
+			UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+		}
+		consumedInputs = set.Of(consumedUTXO.InputID())
+	)
+	tests := []struct {
+		name string
+		// spend returns the inputs consumed by a re-built tx, given the UTXOs
+		// that it is allowed to consume.
+		spend          func(allowedUTXOs []*avax.UTXO) set.Set[ids.ID]
+		expectedIssued int
+		expectedErr    error
+	}{
+		{
+			name: "restricted to the same inputs",
+			spend: func(allowedUTXOs []*avax.UTXO) set.Set[ids.ID] {
+				inputs := set.NewSet[ids.ID](len(allowedUTXOs))
+				for _, utxo := range allowedUTXOs {
+					inputs.Add(utxo.InputID())
+				}
+				return inputs
+			},
+			expectedIssued: 2,
+		},
+		{
+			name: "different inputs",
+			spend: func([]*avax.UTXO) set.Set[ids.ID] {
+				return set.Of(consumedUTXO.InputID(), otherUTXO.InputID())
+			},
+			expectedIssued: 1,
+			expectedErr:    ErrInputsChanged,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			var (
+				built  []*testTx
+				issued []*testTx
+				ops    = NewOptions([]Option{
+					WithContext(ctx),
+					WithPollFrequency(time.Millisecond),
+					WithFeeBump(FeeBump{
+						Timeout:         5 * time.Millisecond,
+						IncreasePercent: 50,
+						MaxFee:          math.MaxUint64,
+					}),
+				})
+				allUTXOs = []*avax.UTXO{consumedUTXO, otherUTXO}
+			)
+			_, err := IssueWithFeeBump(
+				ops,
+				func(feeRatePercent uint64, options ...Option) (*testTx, uint64, error) {
+					inputs := consumedInputs
+					if len(built) > 0 {
+						// Re-built txs may only consume the inputs of the
+						// first tx.
+						allowedUTXOs := NewOptions(options).UTXOs(allUTXOs)
+						require.Equal([]*avax.UTXO{consumedUTXO}, allowedUTXOs)
+						inputs = test.spend(allowedUTXOs)
+					}
+					tx := &testTx{
+						id:             ids.GenerateTestID(),
+						feeRatePercent: feeRatePercent,
+						inputs:         inputs,
+					}
+					built = append(built, tx)
+					return tx, feeRatePercent, nil
+				},
+				(*testTx).inputIDs,
+				func(_ context.Context, tx *testTx) error {
+					issued = append(issued, tx)
+					return nil
+				},
+				func(_ context.Context, txID ids.ID) (bool, error) {
+					return len(issued) > 1 && issued[1].id == txID, nil
+				},
+			)
+			require.ErrorIs(err, test.expectedErr)
+			require.Len(issued, test.expectedIssued)
+			for _, tx := range issued {
+				require.Equal(consumedInputs, tx.inputs)
+			}
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -34,6 +36,16 @@ type Options struct {
 
 	baseFee *big.Int
 
+	gasPriceSet bool
+	gasPrice    gas.Price
+
+	feeMargin uint64
+
+	feeBump *FeeBump
+
+	utxoIDsSet bool
+	utxoIDs    set.Set[ids.ID]
+
 	minIssuanceTimeSet bool
 	minIssuanceTime    uint64
 
@@ -99,6 +111,37 @@ func (o *Options) BaseFee(defaultBaseFee *big.Int) *big.Int {
 	return defaultBaseFee
 }
 
+func (o *Options) GasPrice(defaultGasPrice gas.Price) gas.Price {
+	if o.gasPriceSet {
+		return o.gasPrice
+	}
+	return defaultGasPrice
+}
+
+func (o *Options) FeeMargin() uint64 {
+	return o.feeMargin
+}
+
+func (o *Options) FeeBump() *FeeBump {
+	return o.feeBump
+}
+
+// UTXOs returns the elements of [utxos] that may be consumed. If WithUTXOIDs
+// was provided, only the UTXOs with the provided IDs may be consumed.
+func (o *Options) UTXOs(utxos []*avax.UTXO) []*avax.UTXO {
+	if !o.utxoIDsSet {
+		return utxos
+	}
+
+	allowedUTXOs := make([]*avax.UTXO, 0, o.utxoIDs.Len())
+	for _, utxo := range utxos {
+		if o.utxoIDs.Contains(utxo.InputID()) {
+			allowedUTXOs = append(allowedUTXOs, utxo)
+		}
+	}
+	return allowedUTXOs
+}
+
 func (o *Options) MinIssuanceTime() uint64 {
 	if o.minIssuanceTimeSet {
 		return o.minIssuanceTime
@@ -162,6 +205,50 @@ func WithBaseFee(baseFee *big.Int) Option {
 	}
 }
 
+// WithGasPrice sets the P-chain gas price to use rather than the gas price
+// reported by the node.
+func WithGasPrice(gasPrice gas.Price) Option {
+	return func(o *Options) {
+		o.gasPriceSet = true
+		o.gasPrice = gasPrice
+	}
+}
+
+// WithFeeMargin increases estimated fee rates, the P-chain gas price and the
+// C-chain base fee, by [percent] percent. This reduces the likelihood of
+// transactions being dropped if the fee rate increases shortly after they are
+// issued.
+//
+// The margin is not applied to fee rates provided with WithGasPrice or
+// WithBaseFee.
+func WithFeeMargin(percent uint64) Option {
+	return func(o *Options) {
+		o.feeMargin = percent
+	}
+}
+
+// WithFeeBump enables re-building and re-issuing transactions with a higher
+// fee if they are not accepted in time. See FeeBump for details.
+//
+// Fee bumping is ignored by the X-chain and if WithAssumeDecided is provided.
+func WithFeeBump(feeBump FeeBump) Option {
+	return func(o *Options) {
+		o.feeBump = &feeBump
+	}
+}
+
+// WithUTXOIDs restricts the UTXOs that may be consumed when building a
+// transaction to the UTXOs with the provided IDs.
+//
+// This is used when re-building transactions with a higher fee, to guarantee
+// that the re-built transactions conflict with the previously issued ones.
+func WithUTXOIDs(utxoIDs set.Set[ids.ID]) Option {
+	return func(o *Options) {
+		o.utxoIDsSet = true
+		o.utxoIDs = utxoIDs
+	}
+}
+
 func WithMinIssuanceTime(minIssuanceTime uint64) Option {
 	return func(o *Options) {
 		o.minIssuanceTimeSet = true
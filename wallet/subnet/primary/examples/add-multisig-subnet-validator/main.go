@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/signer"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+// This example creates a subnet that is owned by a 2-of-3 multisig and then
+// adds a validator to it. The transaction adding the validator is built and
+// partially signed by the first owner, exported as hex, and then signed and
+// issued by the third owner.
+//
+// In practice, each owner would run on a separate machine and only the
+// exported transaction bytes would be shared between them.
+func main() {
+	fundedKey := genesis.EWOQKey
+	uri := primary.LocalAPIURI
+	startTime := time.Now().Add(time.Minute)
+	duration := 2 * 7 * 24 * time.Hour // 2 weeks
+	weight := units.Schmeckle
+
+	ownerKeys := []*secp256k1.PrivateKey{fundedKey}
+	for len(ownerKeys) < 3 {
+		key, err := secp256k1.NewPrivateKey()
+		if err != nil {
+			log.Fatalf("failed to generate key: %s\n", err)
+		}
+		ownerKeys = append(ownerKeys, key)
+	}
+
+	ctx := context.Background()
+	infoClient := info.NewClient(uri)
+
+	nodeInfoStartTime := time.Now()
+	nodeID, _, err := infoClient.GetNodeID(ctx)
+	if err != nil {
+		log.Fatalf("failed to fetch node IDs: %s\n", err)
+	}
+	log.Printf("fetched node ID %s in %s\n", nodeID, time.Since(nodeInfoStartTime))
+
+	// MakePWallet fetches the available UTXOs owned by [fundedKey] on the
+	// P-chain that [uri] is hosting.
+	walletSyncStartTime := time.Now()
+	fundedWallet, err := primary.MakePWallet(
+		ctx,
+		uri,
+		secp256k1fx.NewKeychain(fundedKey),
+		primary.WalletConfig{},
+	)
+	if err != nil {
+		log.Fatalf("failed to initialize wallet: %s\n", err)
+	}
+	log.Printf("synced wallet in %s\n", time.Since(walletSyncStartTime))
+
+	owner := &secp256k1fx.OutputOwners{
+		Threshold: 2,
+		Addrs: []ids.ShortID{
+			ownerKeys[0].Address(),
+			ownerKeys[1].Address(),
+			ownerKeys[2].Address(),
+		},
+	}
+
+	createSubnetStartTime := time.Now()
+	createSubnetTx, err := fundedWallet.IssueCreateSubnetTx(owner)
+	if err != nil {
+		log.Fatalf("failed to issue create subnet transaction: %s\n", err)
+	}
+	subnetID := createSubnetTx.ID()
+	log.Printf("created new 2-of-3 subnet %s in %s\n", subnetID, time.Since(createSubnetStartTime))
+
+	// The first owner builds the transaction. The subnet authorization must
+	// reference the owners that will sign it, so the first and third owners are
+	// selected here.
+	ownerWallet0, err := primary.MakePWallet(
+		ctx,
+		uri,
+		secp256k1fx.NewKeychain(ownerKeys[0]),
+		primary.WalletConfig{
+			SubnetIDs: []ids.ID{subnetID},
+		},
+	)
+	if err != nil {
+		log.Fatalf("failed to initialize wallet: %s\n", err)
+	}
+
+	addValidatorUTx, err := ownerWallet0.Builder().NewAddSubnetValidatorTx(
+		&txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				Start:  uint64(startTime.Unix()),
+				End:    uint64(startTime.Add(duration).Unix()),
+				Wght:   weight,
+			},
+			Subnet: subnetID,
+		},
+		common.WithCustomAddresses(set.Of(
+			ownerKeys[0].Address(),
+			ownerKeys[2].Address(),
+		)),
+	)
+	if err != nil {
+		log.Fatalf("failed to build add subnet validator transaction: %s\n", err)
+	}
+
+	partialTx0, err := signer.SignUnsigned(ctx, ownerWallet0.Signer(), addValidatorUTx)
+	if err != nil {
+		log.Fatalf("failed to sign add subnet validator transaction: %s\n", err)
+	}
+	exportedTx := hex.EncodeToString(partialTx0.Bytes())
+	log.Printf("exported partially signed transaction: 0x%s\n", exportedTx)
+
+	// The third owner imports the partially signed transaction, signs it, and
+	// issues it.
+	ownerWallet2, err := primary.MakePWallet(
+		ctx,
+		uri,
+		secp256k1fx.NewKeychain(ownerKeys[2]),
+		primary.WalletConfig{
+			SubnetIDs: []ids.ID{subnetID},
+		},
+	)
+	if err != nil {
+		log.Fatalf("failed to initialize wallet: %s\n", err)
+	}
+
+	importedTxBytes, err := hex.DecodeString(exportedTx)
+	if err != nil {
+		log.Fatalf("failed to decode transaction: %s\n", err)
+	}
+	importedTx, err := txs.Parse(txs.Codec, importedTxBytes)
+	if err != nil {
+		log.Fatalf("failed to parse transaction: %s\n", err)
+	}
+
+	reporter, ok := ownerWallet2.Signer().(signer.MissingSignaturesReporter)
+	if !ok {
+		log.Fatalf("signer can't report missing signatures\n")
+	}
+	missing, err := reporter.MissingSignatures(ctx, importedTx)
+	if err != nil {
+		log.Fatalf("failed to determine missing signatures: %s\n", err)
+	}
+	for _, m := range missing {
+		log.Printf("missing signature %d of credential %d from %s\n", m.SignatureIndex, m.CredentialIndex, m.Address)
+	}
+
+	// Each owner can sign an independent copy of the unsigned transaction.
+	// Merge combines all of the signatures into a single transaction.
+	partialTx2, err := signer.SignUnsigned(ctx, ownerWallet2.Signer(), importedTx.Unsigned)
+	if err != nil {
+		log.Fatalf("failed to sign add subnet validator transaction: %s\n", err)
+	}
+	addValidatorTx, err := signer.Merge(importedTx, partialTx2)
+	if err != nil {
+		log.Fatalf("failed to merge signatures: %s\n", err)
+	}
+
+	addValidatorStartTime := time.Now()
+	if err := ownerWallet2.IssueTx(addValidatorTx); err != nil {
+		log.Fatalf("failed to issue add subnet validator transaction: %s\n", err)
+	}
+	log.Printf("added new subnet validator %s to %s with %s in %s\n", nodeID, subnetID, addValidatorTx.ID(), time.Since(addValidatorStartTime))
+}
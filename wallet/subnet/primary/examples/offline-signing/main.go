@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+)
+
+// This example demonstrates air-gapped signing of a P-chain transaction:
+//
+//  1. "snapshot" runs on an online machine and writes the state required to
+//     build transactions to [snapshotFile].
+//  2. "sign" runs on an offline machine holding the key. It builds and signs a
+//     transaction from [snapshotFile] and writes it to [signedTxFile].
+//  3. "issue" runs on an online machine and issues the transaction in
+//     [signedTxFile].
+func main() {
+	var (
+		mode         = flag.String("mode", "", "one of snapshot, sign, or issue")
+		uri          = flag.String("uri", primary.LocalAPIURI, "URI of the node to fetch state from and issue to")
+		snapshotFile = flag.String("snapshot-file", "snapshot.json", "file containing the wallet snapshot")
+		signedTxFile = flag.String("signed-tx-file", "signed-tx.json", "file containing the signed transaction")
+	)
+	flag.Parse()
+
+	key := genesis.EWOQKey
+	kc := secp256k1fx.NewKeychain(key)
+	ctx := context.Background()
+
+	switch *mode {
+	case "snapshot":
+		// FetchSnapshot only requires the addresses, not the keys.
+		fetchStartTime := time.Now()
+		snapshot, err := primary.FetchSnapshot(
+			ctx,
+			*uri,
+			kc.Addresses(),
+			kc.EthAddresses(),
+			primary.WalletConfig{},
+		)
+		if err != nil {
+			log.Fatalf("failed to fetch snapshot: %s\n", err)
+		}
+		writeJSON(*snapshotFile, snapshot)
+		log.Printf("wrote snapshot to %s in %s\n", *snapshotFile, time.Since(fetchStartTime))
+	case "sign":
+		var snapshot primary.Snapshot
+		readJSON(*snapshotFile, &snapshot)
+
+		wallet := primary.MakeOfflineWallet(&snapshot, kc, kc)
+		utx, err := wallet.PBuilder.NewBaseTx([]*avax.TransferableOutput{
+			{
+				Asset: avax.Asset{ID: snapshot.PContext.AVAXAssetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: units.Avax,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{key.Address()},
+					},
+				},
+			},
+		})
+		if err != nil {
+			log.Fatalf("failed to build base transaction: %s\n", err)
+		}
+
+		tx := &txs.Tx{Unsigned: utx}
+		if err := wallet.PSigner.Sign(ctx, tx); err != nil {
+			log.Fatalf("failed to sign base transaction: %s\n", err)
+		}
+		writeJSON(*signedTxFile, primary.NewSignedPTx(tx))
+		log.Printf("wrote signed transaction %s to %s\n", tx.ID(), *signedTxFile)
+	case "issue":
+		var signedTx primary.SignedTx
+		readJSON(*signedTxFile, &signedTx)
+
+		walletSyncStartTime := time.Now()
+		wallet, err := primary.MakeWallet(ctx, *uri, kc, kc, primary.WalletConfig{})
+		if err != nil {
+			log.Fatalf("failed to initialize wallet: %s\n", err)
+		}
+		log.Printf("synced wallet in %s\n", time.Since(walletSyncStartTime))
+
+		issueStartTime := time.Now()
+		if err := primary.IssueSignedTx(wallet, &signedTx); err != nil {
+			log.Fatalf("failed to issue transaction: %s\n", err)
+		}
+		log.Printf("issued transaction to %s in %s\n", signedTx.ChainID, time.Since(issueStartTime))
+	default:
+		log.Fatalf("unknown mode %q\n", *mode)
+	}
+}
+
+func writeJSON(path string, v any) {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		log.Fatalf("failed to marshal %s: %s\n", path, err)
+	}
+	if err := os.WriteFile(path, b, 0o600); err != nil {
+		log.Fatalf("failed to write %s: %s\n", path, err)
+	}
+}
+
+func readJSON(path string, v any) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("failed to read %s: %s\n", path, err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		log.Fatalf("failed to unmarshal %s: %s\n", path, err)
+	}
+}
@@ -0,0 +1,340 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/coreth/plugin/evm/atomic"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/types"
+	"github.com/ava-labs/avalanchego/wallet/chain/c"
+	"github.com/ava-labs/avalanchego/wallet/chain/x"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+
+	avmtxs "github.com/ava-labs/avalanchego/vms/avm/txs"
+	pbuilder "github.com/ava-labs/avalanchego/wallet/chain/p/builder"
+	psigner "github.com/ava-labs/avalanchego/wallet/chain/p/signer"
+	pwallet "github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
+	xbuilder "github.com/ava-labs/avalanchego/wallet/chain/x/builder"
+	xsigner "github.com/ava-labs/avalanchego/wallet/chain/x/signer"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	_ json.Marshaler   = (*Snapshot)(nil)
+	_ json.Unmarshaler = (*Snapshot)(nil)
+
+	ErrUnknownChain = errors.New("unknown chain")
+
+	errMissingContext = errors.New("missing chain context")
+)
+
+// Snapshot contains all the state required to build and sign transactions on
+// the primary network without network access.
+//
+// A Snapshot is expected to be fetched on an online machine, with
+// FetchSnapshot, and then serialized to JSON and transferred to an offline
+// machine.
+type Snapshot struct {
+	PContext *pbuilder.Context
+	XContext *xbuilder.Context
+	CContext *c.Context
+	UTXOs    common.UTXOs
+	// P-chain owners of the subnets and L1 validators that transactions may
+	// be built for.
+	Owners map[ids.ID]fx.Owner
+	// C-chain accounts that export transactions may be built for.
+	EthAccounts map[ethcommon.Address]*c.Account
+}
+
+// FetchSnapshot fetches all UTXOs that reference any of the provided addresses,
+// the state of all the provided eth addresses, and all requested P-chain
+// owners from the node hosting [uri].
+func FetchSnapshot(
+	ctx context.Context,
+	uri string,
+	avaxAddrs set.Set[ids.ShortID],
+	ethAddrs set.Set[ethcommon.Address],
+	config WalletConfig,
+) (*Snapshot, error) {
+	avaxState, err := FetchState(ctx, uri, avaxAddrs)
+	if err != nil {
+		return nil, err
+	}
+
+	ethState, err := FetchEthState(ctx, uri, ethAddrs)
+	if err != nil {
+		return nil, err
+	}
+
+	owners, err := platformvm.GetOwners(avaxState.PClient, ctx, config.SubnetIDs, config.ValidationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Snapshot{
+		PContext:    avaxState.PCTX,
+		XContext:    avaxState.XCTX,
+		CContext:    avaxState.CCTX,
+		UTXOs:       avaxState.UTXOs,
+		Owners:      owners,
+		EthAccounts: ethState.Accounts,
+	}, nil
+}
+
+type snapshotUTXO struct {
+	SourceChainID      ids.ID              `json:"sourceChainID"`
+	DestinationChainID ids.ID              `json:"destinationChainID"`
+	UTXO               types.JSONByteSlice `json:"utxo"`
+}
+
+type snapshotJSON struct {
+	PContext    *pbuilder.Context                `json:"pContext"`
+	XContext    *xbuilder.Context                `json:"xContext"`
+	CContext    *c.Context                       `json:"cContext"`
+	UTXOs       []snapshotUTXO                   `json:"utxos"`
+	Owners      map[ids.ID]types.JSONByteSlice   `json:"owners"`
+	EthAccounts map[ethcommon.Address]*c.Account `json:"ethAccounts"`
+}
+
+func (s *Snapshot) MarshalJSON() ([]byte, error) {
+	if s.PContext == nil || s.XContext == nil || s.CContext == nil {
+		return nil, errMissingContext
+	}
+
+	ctx := context.Background()
+	chainIDs := s.chainIDs()
+	sj := snapshotJSON{
+		PContext:    s.PContext,
+		XContext:    s.XContext,
+		CContext:    s.CContext,
+		Owners:      make(map[ids.ID]types.JSONByteSlice, len(s.Owners)),
+		EthAccounts: s.EthAccounts,
+	}
+	for _, destinationChainID := range chainIDs {
+		codec, err := s.codec(destinationChainID)
+		if err != nil {
+			return nil, err
+		}
+		for _, sourceChainID := range chainIDs {
+			utxos, err := s.UTXOs.UTXOs(ctx, sourceChainID, destinationChainID)
+			if err != nil {
+				return nil, err
+			}
+			for _, utxo := range utxos {
+				utxoBytes, err := codec.Marshal(txs.CodecVersion, utxo)
+				if err != nil {
+					return nil, fmt.Errorf("couldn't marshal UTXO %s: %w", utxo.InputID(), err)
+				}
+				sj.UTXOs = append(sj.UTXOs, snapshotUTXO{
+					SourceChainID:      sourceChainID,
+					DestinationChainID: destinationChainID,
+					UTXO:               utxoBytes,
+				})
+			}
+		}
+	}
+	for ownerID, owner := range s.Owners {
+		ownerBytes, err := txs.Codec.Marshal(txs.CodecVersion, &owner)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't marshal owner %s: %w", ownerID, err)
+		}
+		sj.Owners[ownerID] = ownerBytes
+	}
+	return json.Marshal(sj)
+}
+
+func (s *Snapshot) UnmarshalJSON(b []byte) error {
+	var sj snapshotJSON
+	if err := json.Unmarshal(b, &sj); err != nil {
+		return err
+	}
+
+	*s = Snapshot{
+		PContext:    sj.PContext,
+		XContext:    sj.XContext,
+		CContext:    sj.CContext,
+		UTXOs:       common.NewUTXOs(),
+		Owners:      make(map[ids.ID]fx.Owner, len(sj.Owners)),
+		EthAccounts: sj.EthAccounts,
+	}
+	if s.PContext == nil || s.XContext == nil || s.CContext == nil {
+		return errMissingContext
+	}
+	if s.EthAccounts == nil {
+		s.EthAccounts = make(map[ethcommon.Address]*c.Account)
+	}
+
+	ctx := context.Background()
+	for _, utxoJSON := range sj.UTXOs {
+		codec, err := s.codec(utxoJSON.DestinationChainID)
+		if err != nil {
+			return err
+		}
+
+		utxo := &avax.UTXO{}
+		if _, err := codec.Unmarshal(utxoJSON.UTXO, utxo); err != nil {
+			return fmt.Errorf("couldn't unmarshal UTXO: %w", err)
+		}
+		if err := s.UTXOs.AddUTXO(ctx, utxoJSON.SourceChainID, utxoJSON.DestinationChainID, utxo); err != nil {
+			return err
+		}
+	}
+	for ownerID, ownerBytes := range sj.Owners {
+		var owner fx.Owner
+		if _, err := txs.Codec.Unmarshal(ownerBytes, &owner); err != nil {
+			return fmt.Errorf("couldn't unmarshal owner %s: %w", ownerID, err)
+		}
+		s.Owners[ownerID] = owner
+	}
+	return nil
+}
+
+func (s *Snapshot) chainIDs() []ids.ID {
+	return []ids.ID{
+		constants.PlatformChainID,
+		s.XContext.BlockchainID,
+		s.CContext.BlockchainID,
+	}
+}
+
+// codec returns the codec used to serialize UTXOs on [chainID].
+func (s *Snapshot) codec(chainID ids.ID) (codec.Manager, error) {
+	switch chainID {
+	case constants.PlatformChainID:
+		return txs.Codec, nil
+	case s.XContext.BlockchainID:
+		return xbuilder.Parser.Codec(), nil
+	case s.CContext.BlockchainID:
+		return atomic.Codec, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownChain, chainID)
+	}
+}
+
+// OfflineWallet builds and signs transactions for the chains living in the
+// primary network from a Snapshot, without network access.
+//
+// Built transactions are not tracked by the wallet. To build transactions that
+// depend on each other, the transactions must be marked as accepted with the
+// chain's backend.
+type OfflineWallet struct {
+	PBuilder pbuilder.Builder
+	PSigner  psigner.Signer
+	PBackend pwallet.Backend
+	XBuilder xbuilder.Builder
+	XSigner  xsigner.Signer
+	XBackend x.Backend
+	CBuilder c.Builder
+	CSigner  c.Signer
+	CBackend c.Backend
+}
+
+// MakeOfflineWallet returns a wallet that supports building and signing
+// transactions with the state contained in [snapshot].
+func MakeOfflineWallet(
+	snapshot *Snapshot,
+	avaxKeychain keychain.Keychain,
+	ethKeychain c.EthKeychain,
+) *OfflineWallet {
+	avaxAddrs := avaxKeychain.Addresses()
+	ethAddrs := ethKeychain.EthAddresses()
+
+	pUTXOs := common.NewChainUTXOs(constants.PlatformChainID, snapshot.UTXOs)
+	pBackend := pwallet.NewBackend(snapshot.PContext, pUTXOs, snapshot.Owners)
+
+	xUTXOs := common.NewChainUTXOs(snapshot.XContext.BlockchainID, snapshot.UTXOs)
+	xBackend := x.NewBackend(snapshot.XContext, xUTXOs)
+
+	cUTXOs := common.NewChainUTXOs(snapshot.CContext.BlockchainID, snapshot.UTXOs)
+	cBackend := c.NewBackend(cUTXOs, snapshot.EthAccounts)
+
+	return &OfflineWallet{
+		PBuilder: pbuilder.New(avaxAddrs, snapshot.PContext, pBackend),
+		PSigner:  psigner.New(avaxKeychain, pBackend),
+		PBackend: pBackend,
+		XBuilder: xbuilder.New(avaxAddrs, snapshot.XContext, xBackend),
+		XSigner:  xsigner.New(avaxKeychain, xBackend),
+		XBackend: xBackend,
+		CBuilder: c.NewBuilder(avaxAddrs, ethAddrs, snapshot.CContext, cBackend),
+		CSigner:  c.NewSigner(avaxKeychain, ethKeychain, cBackend),
+		CBackend: cBackend,
+	}
+}
+
+// SignedTx is a signed transaction that can be serialized to be issued later.
+type SignedTx struct {
+	// ChainID is the chain that the transaction should be issued to.
+	ChainID ids.ID `json:"chainID"`
+	// Tx is the signed transaction bytes.
+	Tx types.JSONByteSlice `json:"tx"`
+}
+
+// NewSignedPTx returns a SignedTx to be issued to the P-chain.
+func NewSignedPTx(tx *txs.Tx) *SignedTx {
+	return &SignedTx{
+		ChainID: constants.PlatformChainID,
+		Tx:      tx.Bytes(),
+	}
+}
+
+// NewSignedXTx returns a SignedTx to be issued to the X-chain.
+func NewSignedXTx(context *xbuilder.Context, tx *avmtxs.Tx) *SignedTx {
+	return &SignedTx{
+		ChainID: context.BlockchainID,
+		Tx:      tx.Bytes(),
+	}
+}
+
+// NewSignedCTx returns a SignedTx to be issued to the C-chain.
+func NewSignedCTx(context *c.Context, tx *atomic.Tx) *SignedTx {
+	return &SignedTx{
+		ChainID: context.BlockchainID,
+		Tx:      tx.SignedBytes(),
+	}
+}
+
+// IssueSignedTx parses [tx] and issues it to the chain it is destined for with
+// [wallet].
+func IssueSignedTx(
+	wallet *Wallet,
+	tx *SignedTx,
+	options ...common.Option,
+) error {
+	switch tx.ChainID {
+	case constants.PlatformChainID:
+		pTx, err := txs.Parse(txs.Codec, tx.Tx)
+		if err != nil {
+			return err
+		}
+		return wallet.P().IssueTx(pTx, options...)
+	case wallet.X().Builder().Context().BlockchainID:
+		xTx, err := xbuilder.Parser.ParseTx(tx.Tx)
+		if err != nil {
+			return err
+		}
+		return wallet.X().IssueTx(xTx, options...)
+	case wallet.C().Builder().Context().BlockchainID:
+		cTx, err := atomic.ExtractAtomicTx(tx.Tx, atomic.Codec)
+		if err != nil {
+			return err
+		}
+		return wallet.C().IssueAtomicTx(cTx, options...)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownChain, tx.ChainID)
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/c"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+
+	pbuilder "github.com/ava-labs/avalanchego/wallet/chain/p/builder"
+	psigner "github.com/ava-labs/avalanchego/wallet/chain/p/signer"
+	xbuilder "github.com/ava-labs/avalanchego/wallet/chain/x/builder"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func newTestSnapshot(t *testing.T, key *secp256k1.PrivateKey) *Snapshot {
+	require := require.New(t)
+
+	var (
+		ctx         = context.Background()
+		avaxAssetID = ids.GenerateTestID()
+		xChainID    = ids.GenerateTestID()
+		cChainID    = ids.GenerateTestID()
+		owner       = secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{key.Address()},
+		}
+		utxos = common.NewUTXOs()
+	)
+	require.NoError(utxos.AddUTXO(ctx, constants.PlatformChainID, constants.PlatformChainID, &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+		Asset:  avax.Asset{ID: avaxAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          10 * units.Avax,
+			OutputOwners: owner,
+		},
+	}))
+	require.NoError(utxos.AddUTXO(ctx, constants.PlatformChainID, constants.PlatformChainID, &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+		Asset:  avax.Asset{ID: avaxAssetID},
+		Out: &stakeable.LockOut{
+			Locktime: 1,
+			TransferableOut: &secp256k1fx.TransferOutput{
+				Amt:          units.Avax,
+				OutputOwners: owner,
+			},
+		},
+	}))
+	require.NoError(utxos.AddUTXO(ctx, xChainID, cChainID, &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+		Asset:  avax.Asset{ID: avaxAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          units.Avax,
+			OutputOwners: owner,
+		},
+	}))
+
+	return &Snapshot{
+		PContext: &pbuilder.Context{
+			NetworkID:         constants.UnitTestID,
+			AVAXAssetID:       avaxAssetID,
+			ComplexityWeights: gas.Dimensions{1, 1, 1, 1},
+			GasPrice:          1,
+		},
+		XContext: &xbuilder.Context{
+			NetworkID:        constants.UnitTestID,
+			BlockchainID:     xChainID,
+			AVAXAssetID:      avaxAssetID,
+			BaseTxFee:        units.MilliAvax,
+			CreateAssetTxFee: units.MilliAvax,
+		},
+		CContext: &c.Context{
+			NetworkID:    constants.UnitTestID,
+			BlockchainID: cChainID,
+			AVAXAssetID:  avaxAssetID,
+		},
+		UTXOs: utxos,
+		Owners: map[ids.ID]fx.Owner{
+			ids.GenerateTestID(): &owner,
+		},
+		EthAccounts: map[ethcommon.Address]*c.Account{
+			key.EthAddress(): {
+				Balance: big.NewInt(1),
+				Nonce:   2,
+			},
+		},
+	}
+}
+
+func TestSnapshotJSONRoundTrip(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	snapshot := newTestSnapshot(t, secp256k1.TestKeys()[0])
+
+	snapshotBytes, err := json.Marshal(snapshot)
+	require.NoError(err)
+
+	var parsedSnapshot Snapshot
+	require.NoError(json.Unmarshal(snapshotBytes, &parsedSnapshot))
+	require.Equal(snapshot.PContext, parsedSnapshot.PContext)
+	require.Equal(snapshot.XContext, parsedSnapshot.XContext)
+	require.Equal(snapshot.CContext, parsedSnapshot.CContext)
+	require.Equal(snapshot.Owners, parsedSnapshot.Owners)
+	require.Equal(snapshot.EthAccounts, parsedSnapshot.EthAccounts)
+
+	chainIDs := snapshot.chainIDs()
+	for _, sourceChainID := range chainIDs {
+		for _, destinationChainID := range chainIDs {
+			expectedUTXOs, err := snapshot.UTXOs.UTXOs(ctx, sourceChainID, destinationChainID)
+			require.NoError(err)
+			utxos, err := parsedSnapshot.UTXOs.UTXOs(ctx, sourceChainID, destinationChainID)
+			require.NoError(err)
+			require.ElementsMatch(expectedUTXOs, utxos)
+		}
+	}
+}
+
+func TestSnapshotMarshalJSONMissingContext(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Snapshot)
+	}{
+		{
+			name: "missing P-chain context",
+			modify: func(s *Snapshot) {
+				s.PContext = nil
+			},
+		},
+		{
+			name: "missing X-chain context",
+			modify: func(s *Snapshot) {
+				s.XContext = nil
+			},
+		},
+		{
+			name: "missing C-chain context",
+			modify: func(s *Snapshot) {
+				s.CContext = nil
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			snapshot := newTestSnapshot(t, secp256k1.TestKeys()[0])
+			test.modify(snapshot)
+
+			_, err := json.Marshal(snapshot)
+			require.ErrorIs(t, err, errMissingContext)
+		})
+	}
+}
+
+func TestOfflineWalletSignedTx(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+		key = secp256k1.TestKeys()[0]
+		kc  = secp256k1fx.NewKeychain(key)
+	)
+	snapshot := newTestSnapshot(t, key)
+	snapshotBytes, err := json.Marshal(snapshot)
+	require.NoError(err)
+
+	var parsedSnapshot Snapshot
+	require.NoError(json.Unmarshal(snapshotBytes, &parsedSnapshot))
+
+	wallet := MakeOfflineWallet(&parsedSnapshot, kc, kc)
+	utx, err := wallet.PBuilder.NewBaseTx([]*avax.TransferableOutput{
+		{
+			Asset: avax.Asset{ID: snapshot.PContext.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+				},
+			},
+		},
+	})
+	require.NoError(err)
+
+	tx := &txs.Tx{Unsigned: utx}
+	require.NoError(wallet.PSigner.Sign(ctx, tx))
+
+	reporter, ok := wallet.PSigner.(psigner.MissingSignaturesReporter)
+	require.True(ok)
+	missing, err := reporter.MissingSignatures(ctx, tx)
+	require.NoError(err)
+	require.Empty(missing)
+
+	signedTxBytes, err := json.Marshal(NewSignedPTx(tx))
+	require.NoError(err)
+
+	var signedTx SignedTx
+	require.NoError(json.Unmarshal(signedTxBytes, &signedTx))
+	require.Equal(constants.PlatformChainID, signedTx.ChainID)
+
+	parsedTx, err := txs.Parse(txs.Codec, signedTx.Tx)
+	require.NoError(err)
+	require.Equal(tx.ID(), parsedTx.ID())
+}
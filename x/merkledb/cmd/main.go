@@ -0,0 +1,184 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/leveldb"
+	"github.com/ava-labs/avalanchego/database/pebbledb"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/x/merkledb"
+)
+
+const (
+	commandName = "merkledb"
+
+	defaultBranchFactor = 16
+)
+
+var errUnhealthy = errors.New("database is unhealthy")
+
+func main() {
+	var (
+		dbDir             string
+		dbType            string
+		prefixes          []string
+		branchFactor      int
+		historyDiskLength uint
+		historyDiskSize   uint
+	)
+	rootCmd := &cobra.Command{
+		Use:          commandName,
+		Short:        commandName + " commands",
+		SilenceUsage: true,
+	}
+	rootCmd.PersistentFlags().StringVar(&dbDir, "db-dir", "", "The path of the database directory")
+	rootCmd.PersistentFlags().StringVar(&dbType, "db-type", leveldb.Name, fmt.Sprintf("The type of the database. Must be one of {%s, %s}", leveldb.Name, pebbledb.Name))
+	rootCmd.PersistentFlags().StringSliceVar(&prefixes, "prefix", nil, "Hex encoded prefix the trie is stored under. If specified multiple times, the prefixes are nested in order")
+	rootCmd.PersistentFlags().IntVar(&branchFactor, "branch-factor", defaultBranchFactor, "The branch factor of the trie")
+	rootCmd.PersistentFlags().UintVar(&historyDiskLength, "history-disk-length", 0, "The number of revisions of persisted history. Only used when repairing. If neither history flag is specified, the persisted history is kept")
+	rootCmd.PersistentFlags().UintVar(&historyDiskSize, "history-disk-size", 0, "The number of bytes of persisted history. Only used when repairing. If neither history flag is specified, the persisted history is kept")
+
+	checkCmd := &cobra.Command{
+		Use:   "check",
+		Short: "Check the integrity of the trie",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config, err := newConfig(branchFactor, historyDiskLength, historyDiskSize)
+			if err != nil {
+				return err
+			}
+			db, err := openDB(dbDir, dbType, prefixes)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			report, err := merkledb.CheckIntegrity(cmd.Context(), db, config)
+			if err != nil {
+				return err
+			}
+			printReport(report)
+			if !report.Healthy() {
+				return errUnhealthy
+			}
+			return nil
+		},
+	}
+	rootCmd.AddCommand(checkCmd)
+
+	repairCmd := &cobra.Command{
+		Use:   "repair",
+		Short: "Regenerate the intermediate nodes of the trie from its value nodes",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config, err := newConfig(branchFactor, historyDiskLength, historyDiskSize)
+			if err != nil {
+				return err
+			}
+			db, err := openDB(dbDir, dbType, prefixes)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			if err := merkledb.Repair(cmd.Context(), db, config); err != nil {
+				return err
+			}
+
+			report, err := merkledb.CheckIntegrity(cmd.Context(), db, config)
+			if err != nil {
+				return err
+			}
+			printReport(report)
+			if !report.Healthy() {
+				return errUnhealthy
+			}
+			return nil
+		},
+	}
+	rootCmd.AddCommand(repairCmd)
+
+	if err := rootCmd.ExecuteContext(context.Background()); err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func newConfig(branchFactor int, historyDiskLength uint, historyDiskSize uint) (merkledb.Config, error) {
+	config := merkledb.Config{
+		BranchFactor:      merkledb.BranchFactor(branchFactor),
+		Hasher:            merkledb.DefaultHasher,
+		HistoryLength:     1,
+		HistoryDiskLength: historyDiskLength,
+		HistoryDiskSize:   historyDiskSize,
+		TraceLevel:        merkledb.NoTrace,
+	}
+	return config, config.BranchFactor.Valid()
+}
+
+func openDB(dbDir string, dbType string, prefixes []string) (database.Database, error) {
+	if len(dbDir) == 0 {
+		return nil, errors.New("--db-dir is required")
+	}
+	// Don't create a new database if the path is wrong.
+	if _, err := os.Stat(dbDir); err != nil {
+		return nil, err
+	}
+
+	var (
+		db  database.Database
+		err error
+	)
+	switch dbType {
+	case leveldb.Name:
+		db, err = leveldb.New(dbDir, nil, logging.NoLog{}, prometheus.NewRegistry())
+	case pebbledb.Name:
+		db, err = pebbledb.New(dbDir, nil, logging.NoLog{}, prometheus.NewRegistry())
+	default:
+		return nil, fmt.Errorf("unknown --db-type %q", dbType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, prefix := range prefixes {
+		prefixBytes, err := hex.DecodeString(prefix)
+		if err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("invalid --prefix %q: %w", prefix, err)
+		}
+		db = prefixdb.New(prefixBytes, db)
+	}
+	return db, nil
+}
+
+func printReport(report *merkledb.IntegrityReport) {
+	fmt.Fprintf(os.Stdout, "clean shutdown: %t\n", report.CleanShutdown)
+	fmt.Fprintf(os.Stdout, "root: %s\n", report.RootID)
+	fmt.Fprintf(os.Stdout, "nodes checked: %d\n", report.NodesChecked)
+	for _, mismatch := range report.HashMismatches {
+		fmt.Fprintf(os.Stdout, "hash mismatch: key=%x expected=%s actual=%s\n", mismatch.Key.Bytes(), mismatch.Expected, mismatch.Actual)
+	}
+	for _, key := range report.MissingNodes {
+		fmt.Fprintf(os.Stdout, "missing node: key=%x\n", key.Bytes())
+	}
+	for _, key := range report.CorruptedNodes {
+		fmt.Fprintf(os.Stdout, "corrupted node: key=%x\n", key.Bytes())
+	}
+	for _, dbKey := range report.OrphanedNodes {
+		fmt.Fprintf(os.Stdout, "orphaned node: db key=%x\n", dbKey)
+	}
+	if report.Healthy() {
+		fmt.Fprintln(os.Stdout, "healthy")
+	}
+}
@@ -16,11 +16,13 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/exp/maps"
 
+	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/trace"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/maybe"
+	"github.com/ava-labs/avalanchego/utils/metric"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/units"
 
@@ -35,6 +37,8 @@ const (
 	rebuildIntermediateDeletionWriteSize = units.MiB
 	valueNodePrefixLen                   = 1
 	cacheEntryOverHead                   = 8
+	valueNodeCacheName                   = "value_node_cache"
+	intermediateNodeCacheName            = "intermediate_node_cache"
 )
 
 var (
@@ -43,6 +47,7 @@ var (
 	metadataPrefix         = []byte{0}
 	valueNodePrefix        = []byte{1}
 	intermediateNodePrefix = []byte{2}
+	historyPrefix          = []byte{3}
 
 	// cleanShutdownKey is used to flag that the database did (or did not)
 	// previously shutdown correctly.
@@ -129,6 +134,16 @@ type RangeProofer interface {
 	CommitRangeProof(ctx context.Context, start, end maybe.Maybe[[]byte], proof *RangeProof) error
 }
 
+type HistoricalViewer interface {
+	// NewViewAtRoot returns a read-only view of the trie when its root was
+	// [rootID].
+	// Returns [ErrInsufficientHistory] if this node has insufficient history
+	// to reconstruct the trie at [rootID].
+	// The returned view is invalidated once the database is modified, after
+	// which it returns [ErrInvalid].
+	NewViewAtRoot(ctx context.Context, rootID ids.ID) (Trie, error)
+}
+
 type Clearer interface {
 	// Deletes all key/value pairs from the database
 	// and clears the change history.
@@ -156,6 +171,7 @@ type MerkleDB interface {
 	ProofGetter
 	ChangeProofer
 	RangeProofer
+	HistoricalViewer
 	Prefetcher
 }
 
@@ -177,10 +193,26 @@ type Config struct {
 	// The number of changes to the database that we store in memory in order to
 	// serve change proofs.
 	HistoryLength uint
+	// The number of changes to the database that we persist on disk in order to
+	// serve change proofs for roots that are no longer in memory. The persisted
+	// history survives restarts.
+	//
+	// If both [HistoryDiskLength] and [HistoryDiskSize] are 0, history isn't
+	// persisted. Otherwise, a limit of 0 isn't enforced.
+	HistoryDiskLength uint
+	// The number of bytes of changes to the database that we persist on disk in
+	// order to serve change proofs.
+	HistoryDiskSize uint
 	// The number of bytes used to cache nodes with values.
 	ValueNodeCacheSize uint
 	// The number of bytes used to cache nodes without values.
 	IntermediateNodeCacheSize uint
+	// If [CacheBudget] is non-nil, the node caches are registered with it and
+	// sized by it, rather than by [ValueNodeCacheSize] and
+	// [IntermediateNodeCacheSize]. The caches are registered under
+	// [Namespace], which must then be unique among the databases sharing the
+	// budget.
+	CacheBudget *cache.Budget
 	// The number of bytes used to store nodes without values in memory before forcing them onto disk.
 	IntermediateWriteBufferSize uint
 	// The number of bytes to write to disk when intermediate nodes are evicted
@@ -217,6 +249,15 @@ type merkleDB struct {
 	// historical views of the trie.
 	history *trieHistory
 
+	// The budget that the node caches are registered with, if any.
+	cacheBudget        *cache.Budget
+	budgetedCacheNames []string
+
+	// Persists change lists to serve change proofs for roots that are no
+	// longer in [history].
+	// Nil if history isn't persisted.
+	diskHistory *diskHistory
+
 	// True iff the db has been closed.
 	closed bool
 
@@ -258,7 +299,7 @@ func newDatabase(
 	db database.Database,
 	config Config,
 	metrics metrics,
-) (*merkleDB, error) {
+) (_ *merkleDB, err error) {
 	if err := config.BranchFactor.Valid(); err != nil {
 		return nil, err
 	}
@@ -306,6 +347,17 @@ func newDatabase(
 		hasher:           hasher,
 	}
 
+	if config.CacheBudget != nil {
+		if err := trieDB.registerCaches(config.CacheBudget, config.Namespace); err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				trieDB.unregisterCaches()
+			}
+		}()
+	}
+
 	shutdownType, err := trieDB.baseDB.Get(cleanShutdownKey)
 	switch err {
 	case nil:
@@ -336,11 +388,52 @@ func newDatabase(
 		nodes:  map[Key]*change[*node]{},
 	})
 
+	trieDB.diskHistory, err = newDiskHistory(
+		db,
+		uint64(config.HistoryDiskLength),
+		uint64(config.HistoryDiskSize),
+		trieDB.rootID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// mark that the db has not yet been cleanly closed
 	err = trieDB.baseDB.Put(cleanShutdownKey, didNotHaveCleanShutdown)
 	return trieDB, err
 }
 
+// registerCaches replaces the node caches with caches sized by [budget].
+func (db *merkleDB) registerCaches(budget *cache.Budget, namespace string) error {
+	valueName := metric.AppendNamespace(namespace, valueNodeCacheName)
+	valueNodeCache, err := cache.NewBudgetedSizedLRU(budget, valueName, cacheEntrySize)
+	if err != nil {
+		return err
+	}
+
+	intermediateName := metric.AppendNamespace(namespace, intermediateNodeCacheName)
+	intermediateNodeCache, err := cache.NewBudgetedSizedLRU(budget, intermediateName, cacheEntrySize)
+	if err != nil {
+		budget.Unregister(valueName)
+		return err
+	}
+
+	db.valueNodeDB.nodeCache = valueNodeCache
+	db.intermediateNodeDB.nodeCache = intermediateNodeCache
+	db.cacheBudget = budget
+	db.budgetedCacheNames = []string{valueName, intermediateName}
+	return nil
+}
+
+// unregisterCaches returns the memory of the node caches to the cache budget,
+// if there is one.
+func (db *merkleDB) unregisterCaches() {
+	for _, name := range db.budgetedCacheNames {
+		db.cacheBudget.Unregister(name)
+	}
+	db.budgetedCacheNames = nil
+}
+
 // Deletes every intermediate node and rebuilds them by re-adding every key/value.
 // TODO: make this more efficient by only clearing out the stale portions of the trie.
 func (db *merkleDB) rebuild(ctx context.Context, cacheSize int) error {
@@ -483,6 +576,7 @@ func (db *merkleDB) Close() error {
 	db.invalidateChildrenExcept(nil)
 
 	db.closed = true
+	db.unregisterCaches()
 	db.valueNodeDB.Close()
 	// Flush intermediary nodes to disk.
 	if err := db.intermediateNodeDB.Flush(); err != nil {
@@ -657,6 +751,20 @@ func (db *merkleDB) GetProof(ctx context.Context, key []byte) (*Proof, error) {
 	return getProof(db, key)
 }
 
+func (db *merkleDB) GetMultiProof(ctx context.Context, keys [][]byte) (*MultiProof, error) {
+	db.commitLock.RLock()
+	defer db.commitLock.RUnlock()
+
+	_, span := db.infoTracer.Start(ctx, "MerkleDB.GetMultiProof")
+	defer span.End()
+
+	if db.closed {
+		return nil, database.ErrClosed
+	}
+
+	return getMultiProof(db, keys)
+}
+
 func (db *merkleDB) GetRangeProof(
 	ctx context.Context,
 	start maybe.Maybe[[]byte],
@@ -698,7 +806,7 @@ func (db *merkleDB) GetRangeProofAtRoot(
 		return nil, ErrEmptyProof
 	}
 
-	historicalTrie, err := db.getTrieAtRootForRange(rootID, start, end)
+	historicalTrie, err := db.getTrieAtRootForRange(ctx, rootID, start, end)
 	if err != nil {
 		return nil, err
 	}
@@ -733,6 +841,9 @@ func (db *merkleDB) GetChangeProof(
 	}
 
 	changes, err := db.history.getValueChanges(startRootID, endRootID, start, end, maxLength)
+	if errors.Is(err, ErrInsufficientHistory) && db.diskHistory != nil {
+		changes, err = db.diskHistory.getValueChanges(startRootID, endRootID, start, end, maxLength)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -764,7 +875,7 @@ func (db *merkleDB) GetChangeProof(
 
 	// Since we hold [db.commitlock] we must still have sufficient
 	// history to recreate the trie at [endRootID].
-	historicalTrie, err := db.getTrieAtRootForRange(endRootID, start, largestKey)
+	historicalTrie, err := db.getTrieAtRootForRange(ctx, endRootID, start, largestKey)
 	if err != nil {
 		return nil, err
 	}
@@ -852,6 +963,43 @@ func (db *merkleDB) Has(k []byte) (bool, error) {
 	return err == nil, err
 }
 
+func (db *merkleDB) NewViewAtRoot(ctx context.Context, rootID ids.ID) (Trie, error) {
+	// ensure the db doesn't change while creating the view
+	db.commitLock.RLock()
+	defer db.commitLock.RUnlock()
+
+	ctx, span := db.infoTracer.Start(ctx, "MerkleDB.NewViewAtRoot")
+	defer span.End()
+
+	if db.closed {
+		return nil, database.ErrClosed
+	}
+
+	var (
+		historicalView *view
+		err            error
+	)
+	if rootID == db.getMerkleRoot() {
+		historicalView, err = newView(db, db, ViewChanges{})
+		if err == nil {
+			err = historicalView.applyValueChanges(ctx)
+		}
+	} else {
+		historicalView, err = db.getViewAtRoot(ctx, rootID, maybe.Nothing[[]byte](), maybe.Nothing[[]byte]())
+	}
+	if err != nil {
+		return nil, err
+	}
+	historicalView.readOnly = true
+
+	// ensure access to childViews is protected
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	db.childViews = append(db.childViews, historicalView)
+	return historicalView, nil
+}
+
 func (db *merkleDB) HealthCheck(ctx context.Context) (interface{}, error) {
 	db.lock.RLock()
 	defer db.lock.RUnlock()
@@ -963,6 +1111,8 @@ func (db *merkleDB) commitView(ctx context.Context, trieToCommit *view) error {
 		return ErrInvalid
 	case trieToCommit.committed:
 		return ErrCommitted
+	case trieToCommit.readOnly:
+		return ErrReadOnly
 	case trieToCommit.db != trieToCommit.getParentTrie():
 		return ErrParentNotDatabase
 	}
@@ -989,6 +1139,12 @@ func (db *merkleDB) commitView(ctx context.Context, trieToCommit *view) error {
 		return err
 	}
 
+	if db.diskHistory != nil {
+		if err := db.diskHistory.record(valueNodeBatch, changes.rootID, changes.values); err != nil {
+			return err
+		}
+	}
+
 	if err := db.commitValueChanges(ctx, valueNodeBatch); err != nil {
 		return err
 	}
@@ -1268,6 +1424,7 @@ func (db *merkleDB) initializeRoot() error {
 // If [end] is Nothing, there's no upper bound on the range.
 // Assumes [db.commitLock] is read locked.
 func (db *merkleDB) getTrieAtRootForRange(
+	ctx context.Context,
 	rootID ids.ID,
 	start maybe.Maybe[[]byte],
 	end maybe.Maybe[[]byte],
@@ -1276,14 +1433,54 @@ func (db *merkleDB) getTrieAtRootForRange(
 	if rootID == db.getMerkleRoot() {
 		return db, nil
 	}
+	return db.getViewAtRoot(ctx, rootID, start, end)
+}
 
+// getViewAtRoot returns a view of the trie when its root was [rootID]. Only
+// the values in [start, end] are guaranteed to be correct.
+// The view isn't tracked by [db].
+// Assumes [db.commitLock] is read locked and [rootID] isn't the current root.
+func (db *merkleDB) getViewAtRoot(
+	ctx context.Context,
+	rootID ids.ID,
+	start maybe.Maybe[[]byte],
+	end maybe.Maybe[[]byte],
+) (*view, error) {
 	changeHistory, err := db.history.getChangesToGetToRoot(rootID, start, end)
+	if errors.Is(err, ErrInsufficientHistory) && db.diskHistory != nil {
+		return db.getViewAtRootFromDisk(ctx, rootID)
+	}
 	if err != nil {
 		return nil, err
 	}
+	// The history doesn't track the ID of the trie after reverting the
+	// changes, which is [rootID] by definition.
+	changeHistory.rootID = rootID
 	return newViewWithChanges(db, changeHistory)
 }
 
+func (db *merkleDB) getViewAtRootFromDisk(ctx context.Context, rootID ids.ID) (*view, error) {
+	ops, err := db.diskHistory.getChangesToGetToRoot(rootID)
+	if err != nil {
+		return nil, err
+	}
+
+	historicalView, err := newView(db, db, ViewChanges{
+		BatchOps:     ops,
+		ConsumeBytes: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := historicalView.applyValueChanges(ctx); err != nil {
+		return nil, err
+	}
+	if historicalView.changes.rootID != rootID {
+		return nil, fmt.Errorf("%w: expected %s but got %s", errUnexpectedHistoryRoot, rootID, historicalView.changes.rootID)
+	}
+	return historicalView, nil
+}
+
 // Returns all keys in range [start, end] that aren't in [keySet].
 // If [start] is Nothing, then the range has no lower bound.
 // If [end] is Nothing, then the range has no upper bound.
@@ -1372,6 +1569,9 @@ func (db *merkleDB) Clear() error {
 		values: map[Key]*change[maybe.Maybe[[]byte]]{},
 		nodes:  map[Key]*change[*node]{},
 	})
+	if db.diskHistory != nil {
+		return db.diskHistory.reset(db.rootID)
+	}
 	return nil
 }
 
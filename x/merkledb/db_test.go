@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"maps"
 	"math/rand"
 	"slices"
 	"strconv"
@@ -17,6 +18,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/dbtest"
 	"github.com/ava-labs/avalanchego/database/memdb"
@@ -1405,3 +1407,127 @@ func BenchmarkIteration(b *testing.B) {
 		}
 	})
 }
+
+func TestNewViewAtRoot(t *testing.T) {
+	require := require.New(t)
+
+	const numRevisions = 6
+
+	config := newDefaultConfig()
+	config.HistoryLength = numRevisions
+	db, err := newDB(context.Background(), memdb.New(), config)
+	require.NoError(err)
+
+	// Record the contents of the trie at each root.
+	var (
+		roots    = make([]ids.ID, numRevisions)
+		contents = make([]map[string][]byte, numRevisions)
+		current  = map[string][]byte{}
+	)
+	for i := range roots {
+		batch := db.NewBatch()
+		for j := 0; j < 4; j++ {
+			key := []byte{'k', byte((i + j) % 8)}
+			if j == 3 {
+				require.NoError(batch.Delete(key))
+				delete(current, string(key))
+				continue
+			}
+			value := []byte{byte(i)}
+			require.NoError(batch.Put(key, value))
+			current[string(key)] = value
+		}
+		require.NoError(batch.Write())
+		roots[i] = db.getMerkleRoot()
+		contents[i] = maps.Clone(current)
+	}
+
+	for i, rootID := range roots {
+		trie, err := db.NewViewAtRoot(context.Background(), rootID)
+		require.NoError(err)
+
+		root, err := trie.GetMerkleRoot(context.Background())
+		require.NoError(err)
+		require.Equal(rootID, root)
+
+		for key, value := range contents[i] {
+			gotValue, err := trie.GetValue(context.Background(), []byte(key))
+			require.NoError(err)
+			require.Equal(value, gotValue)
+		}
+
+		it := trie.NewIterator()
+		numKeys := 0
+		for it.Next() {
+			require.Equal(contents[i][string(it.Key())], it.Value())
+			numKeys++
+		}
+		require.NoError(it.Error())
+		it.Release()
+		require.Len(contents[i], numKeys)
+	}
+
+	_, err = db.NewViewAtRoot(context.Background(), ids.GenerateTestID())
+	require.ErrorIs(err, ErrInsufficientHistory)
+
+	// Historical views can't be committed.
+	trie, err := db.NewViewAtRoot(context.Background(), roots[2])
+	require.NoError(err)
+	view, ok := trie.(View)
+	require.True(ok)
+	require.ErrorIs(view.CommitToDB(context.Background()), ErrReadOnly)
+
+	// Modifying the database invalidates historical views.
+	require.NoError(db.Put([]byte("other"), []byte("value")))
+	_, err = trie.GetValue(context.Background(), []byte{'k', 0})
+	require.ErrorIs(err, ErrInvalid)
+
+	require.NoError(db.Close())
+	_, err = db.NewViewAtRoot(context.Background(), roots[2])
+	require.ErrorIs(err, database.ErrClosed)
+}
+
+func TestCacheBudget(t *testing.T) {
+	require := require.New(t)
+
+	budget := cache.NewBudget(units.MiB, 0)
+	newDB := func(namespace string) (*merkleDB, error) {
+		config := newDefaultConfig()
+		config.Namespace = namespace
+		config.CacheBudget = budget
+		return newDatabase(context.Background(), memdb.New(), config, &mockMetrics{})
+	}
+
+	db1, err := newDB("db1")
+	require.NoError(err)
+	db2, err := newDB("db2")
+	require.NoError(err)
+
+	_, err = newDB("db1")
+	require.ErrorIs(err, cache.ErrDuplicateCache)
+
+	require.NoError(db1.Put([]byte("key"), []byte("value")))
+	value, err := db1.Get([]byte("key"))
+	require.NoError(err)
+	require.Equal([]byte("value"), value)
+
+	stats := budget.Stats()
+	require.Len(stats, 4)
+	var totalMaxSize int
+	for _, s := range stats {
+		totalMaxSize += s.MaxSize
+	}
+	require.Equal(budget.MaxSize(), totalMaxSize)
+	require.Equal("db1_intermediate_node_cache", stats[0].Name)
+	require.Equal("db1_value_node_cache", stats[1].Name)
+	require.Positive(stats[1].Size)
+
+	// Closing a database gives its portion of the budget to the others.
+	require.NoError(db1.Close())
+	stats = budget.Stats()
+	require.Len(stats, 2)
+	require.Equal(budget.MaxSize()/2, stats[0].MaxSize)
+
+	require.NoError(db2.Close())
+	require.Empty(budget.Stats())
+}
@@ -0,0 +1,420 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merkledb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/maybe"
+)
+
+const seqNumLen = 8
+
+var (
+	// Revisions are stored under [historyRevisionPrefix] followed by their
+	// sequence number.
+	historyRevisionPrefix = []byte(string(historyPrefix) + "\x00")
+	// For each revision, an empty value is stored under [historyRootPrefix]
+	// followed by the revision's root ID and sequence number.
+	historyRootPrefix  = []byte(string(historyPrefix) + "\x01")
+	historyMetadataKey = []byte(string(historyPrefix) + "\x02")
+
+	errUnexpectedHistoryRoot = errors.New("unexpected root after applying history")
+)
+
+// diskHistory persists the value changes of each revision of the trie so
+// that change proofs can be served for roots that are no longer in
+// [trieHistory].
+//
+// Revision i contains the value changes that transitioned the trie from the
+// root of revision i-1 to the root of revision i. The oldest revision's
+// changes are never used, so it only serves as a starting root.
+//
+// Revisions are written in the same batch as the value nodes they change, so
+// the most recent revision always has the root of the trie on disk.
+type diskHistory struct {
+	baseDB database.Database
+
+	// Maximum number of revisions to store. 0 means unbounded.
+	maxRevisions uint64
+	// Maximum number of bytes of revisions to store. 0 means unbounded.
+	// The most recent revision is always kept, even if it exceeds this limit.
+	maxSize uint64
+
+	// Sequence number of the oldest stored revision.
+	oldest uint64
+	// Sequence number the next revision will be stored with.
+	next uint64
+	// Number of bytes of the stored revisions.
+	size uint64
+}
+
+// Returns the persisted history of [db], whose current root is [rootID].
+// Returns nil if neither [maxRevisions] nor [maxSize] is set, in which case
+// any previously persisted history is deleted.
+func newDiskHistory(
+	db database.Database,
+	maxRevisions uint64,
+	maxSize uint64,
+	rootID ids.ID,
+) (*diskHistory, error) {
+	if maxRevisions == 0 && maxSize == 0 {
+		return nil, database.AtomicClearPrefix(db, db, historyPrefix)
+	}
+
+	h := &diskHistory{
+		baseDB:       db,
+		maxRevisions: maxRevisions,
+		maxSize:      maxSize,
+	}
+	metadata, err := db.Get(historyMetadataKey)
+	switch err {
+	case nil:
+		if err := h.unmarshalMetadata(metadata); err != nil {
+			return nil, err
+		}
+	case database.ErrNotFound:
+	default:
+		return nil, err
+	}
+
+	// If the history was disabled while the trie changed, the history no
+	// longer ends at the current root, so it must be discarded.
+	if h.next > h.oldest {
+		latestRootID, _, err := h.getRevision(h.next - 1)
+		if err != nil {
+			return nil, err
+		}
+		if latestRootID == rootID {
+			// The limits may have been reduced since the history was last
+			// written to.
+			batch := db.NewBatch()
+			if err := h.prune(batch); err != nil {
+				return nil, err
+			}
+			return h, batch.Write()
+		}
+	}
+	return h, h.reset(rootID)
+}
+
+// reset deletes all revisions and records a revision with no changes that
+// results in [rootID].
+func (h *diskHistory) reset(rootID ids.ID) error {
+	if err := database.AtomicClearPrefix(h.baseDB, h.baseDB, historyPrefix); err != nil {
+		return err
+	}
+	h.oldest = 0
+	h.next = 0
+	h.size = 0
+
+	batch := h.baseDB.NewBatch()
+	if err := h.record(batch, rootID, nil); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// record writes a revision to [batch] resulting in [rootID] from the provided
+// value changes, and evicts the oldest revisions if the limits are exceeded.
+func (h *diskHistory) record(
+	batch database.KeyValueWriterDeleter,
+	rootID ids.ID,
+	values map[Key]*change[maybe.Maybe[[]byte]],
+) error {
+	w := codecWriter{}
+	w.ID(rootID)
+	w.Uvarint(uint64(len(values)))
+	for key, valueChange := range values {
+		w.Key(key)
+		w.MaybeBytes(valueChange.before)
+		w.MaybeBytes(valueChange.after)
+	}
+
+	if err := batch.Put(historyRevisionKey(h.next), w.b); err != nil {
+		return err
+	}
+	if err := batch.Put(historyRootKey(rootID, h.next), nil); err != nil {
+		return err
+	}
+	h.next++
+	h.size += uint64(len(w.b))
+	return h.prune(batch)
+}
+
+// prune deletes the oldest revisions until the limits are satisfied and
+// writes the updated metadata to [batch].
+//
+// Revisions being evicted must have already been written to [h.baseDB].
+func (h *diskHistory) prune(batch database.KeyValueWriterDeleter) error {
+	for h.next-h.oldest > 1 &&
+		((h.maxRevisions != 0 && h.next-h.oldest > h.maxRevisions) ||
+			(h.maxSize != 0 && h.size > h.maxSize)) {
+		revisionKey := historyRevisionKey(h.oldest)
+		revisionBytes, err := h.baseDB.Get(revisionKey)
+		if err != nil {
+			return err
+		}
+		r := codecReader{b: revisionBytes}
+		rootID, err := r.ID()
+		if err != nil {
+			return err
+		}
+
+		if err := batch.Delete(revisionKey); err != nil {
+			return err
+		}
+		if err := batch.Delete(historyRootKey(rootID, h.oldest)); err != nil {
+			return err
+		}
+		h.oldest++
+		h.size -= uint64(len(revisionBytes))
+	}
+	return batch.Put(historyMetadataKey, h.marshalMetadata())
+}
+
+// Returns the sequence number of the most recent revision resulting in
+// [rootID] that occurred before the revision with sequence number [before].
+// Returns [ErrInsufficientHistory] if there is no such revision.
+func (h *diskHistory) getLastRevision(rootID ids.ID, before uint64) (uint64, error) {
+	prefix := historyRootKey(rootID, 0)[:len(historyRootPrefix)+ids.IDLen]
+	it := h.baseDB.NewIteratorWithPrefix(prefix)
+	defer it.Release()
+
+	var (
+		seqNum uint64
+		found  bool
+	)
+	for it.Next() {
+		s := binary.BigEndian.Uint64(it.Key()[len(prefix):])
+		if s >= before {
+			break
+		}
+		seqNum = s
+		found = true
+	}
+	if err := it.Error(); err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("%w: root %s not found in persisted history", ErrInsufficientHistory, rootID)
+	}
+	return seqNum, nil
+}
+
+// Returns the root ID and value changes of the revision with sequence number
+// [seqNum].
+func (h *diskHistory) getRevision(seqNum uint64) (ids.ID, map[Key]*change[maybe.Maybe[[]byte]], error) {
+	revisionBytes, err := h.baseDB.Get(historyRevisionKey(seqNum))
+	if err != nil {
+		return ids.Empty, nil, err
+	}
+	return unmarshalRevision(revisionBytes)
+}
+
+// Returns up to [maxLength] key-value pair changes with keys in
+// [start, end] that occurred between [startRoot] and [endRoot].
+// If [start] is Nothing, there's no lower bound on the range.
+// If [end] is Nothing, there's no upper bound on the range.
+// Returns [ErrInsufficientHistory] if the history is insufficient
+// to generate the proof.
+// Returns [ErrNoEndRoot], which wraps [ErrInsufficientHistory], if
+// the [endRoot] isn't in the history.
+func (h *diskHistory) getValueChanges(
+	startRoot ids.ID,
+	endRoot ids.ID,
+	start maybe.Maybe[[]byte],
+	end maybe.Maybe[[]byte],
+	maxLength int,
+) (*changeSummary, error) {
+	if maxLength <= 0 {
+		return nil, fmt.Errorf("%w but was %d", ErrInvalidMaxLength, maxLength)
+	}
+
+	if startRoot == endRoot {
+		return newChangeSummary(maxLength), nil
+	}
+
+	endSeqNum, err := h.getLastRevision(endRoot, h.next)
+	if errors.Is(err, ErrInsufficientHistory) {
+		return nil, fmt.Errorf("%w: %s", ErrNoEndRoot, endRoot)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	startSeqNum, err := h.getLastRevision(startRoot, endSeqNum)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		startKey        = maybe.Bind(start, ToKey)
+		endKey          = maybe.Bind(end, ToKey)
+		combinedChanges = newChangeSummary(maxLength)
+	)
+	for seqNum := startSeqNum + 1; seqNum <= endSeqNum; seqNum++ {
+		_, values, err := h.getRevision(seqNum)
+		if err != nil {
+			return nil, err
+		}
+
+		for key, valueChange := range values {
+			if (startKey.HasValue() && key.Less(startKey.Value())) ||
+				(endKey.HasValue() && key.Greater(endKey.Value())) {
+				continue
+			}
+
+			existing, ok := combinedChanges.values[key]
+			if !ok {
+				combinedChanges.values[key] = valueChange
+				continue
+			}
+
+			existing.after = valueChange.after
+			if maybe.Equal(existing.before, existing.after, bytes.Equal) {
+				// The change to this key is a no-op.
+				delete(combinedChanges.values, key)
+			}
+		}
+	}
+
+	if len(combinedChanges.values) <= maxLength {
+		return combinedChanges, nil
+	}
+
+	// Keep only the smallest [maxLength] keys.
+	sortedChangedKeys := maps.Keys(combinedChanges.values)
+	utils.Sort(sortedChangedKeys)
+	for _, key := range sortedChangedKeys[maxLength:] {
+		delete(combinedChanges.values, key)
+	}
+	return combinedChanges, nil
+}
+
+// Returns the operations that revert the trie from the root of the most
+// recent revision to [rootID].
+// Returns [ErrInsufficientHistory] if [rootID] isn't in the history.
+func (h *diskHistory) getChangesToGetToRoot(rootID ids.ID) ([]database.BatchOp, error) {
+	seqNum, err := h.getLastRevision(rootID, h.next)
+	if err != nil {
+		return nil, err
+	}
+
+	// Go backward from the most recent revision so that the earliest before
+	// value of each key is kept.
+	values := make(map[Key]maybe.Maybe[[]byte])
+	for i := h.next - 1; i > seqNum; i-- {
+		_, changes, err := h.getRevision(i)
+		if err != nil {
+			return nil, err
+		}
+		for key, valueChange := range changes {
+			values[key] = valueChange.before
+		}
+	}
+
+	ops := make([]database.BatchOp, 0, len(values))
+	for key, value := range values {
+		ops = append(ops, database.BatchOp{
+			Key:    key.Bytes(),
+			Value:  value.Value(),
+			Delete: value.IsNothing(),
+		})
+	}
+	return ops, nil
+}
+
+func (h *diskHistory) marshalMetadata() []byte {
+	w := codecWriter{}
+	w.Uvarint(h.oldest)
+	w.Uvarint(h.next)
+	w.Uvarint(h.size)
+	return w.b
+}
+
+func (h *diskHistory) unmarshalMetadata(b []byte) error {
+	r := codecReader{b: b}
+
+	var err error
+	if h.oldest, err = r.Uvarint(); err != nil {
+		return err
+	}
+	if h.next, err = r.Uvarint(); err != nil {
+		return err
+	}
+	if h.size, err = r.Uvarint(); err != nil {
+		return err
+	}
+	if len(r.b) != 0 {
+		return errExtraSpace
+	}
+	return nil
+}
+
+func unmarshalRevision(b []byte) (ids.ID, map[Key]*change[maybe.Maybe[[]byte]], error) {
+	r := codecReader{
+		b:    b,
+		copy: true,
+	}
+	rootID, err := r.ID()
+	if err != nil {
+		return ids.Empty, nil, err
+	}
+	numValues, err := r.Uvarint()
+	if err != nil {
+		return ids.Empty, nil, err
+	}
+	// Each value change is at least 3 bytes long.
+	if numValues > uint64(len(r.b)) {
+		return ids.Empty, nil, errTooManyChildren
+	}
+
+	values := make(map[Key]*change[maybe.Maybe[[]byte]], numValues)
+	for i := uint64(0); i < numValues; i++ {
+		key, err := r.Key()
+		if err != nil {
+			return ids.Empty, nil, err
+		}
+		before, err := r.MaybeBytes()
+		if err != nil {
+			return ids.Empty, nil, err
+		}
+		after, err := r.MaybeBytes()
+		if err != nil {
+			return ids.Empty, nil, err
+		}
+		values[key] = &change[maybe.Maybe[[]byte]]{
+			before: before,
+			after:  after,
+		}
+	}
+	if len(r.b) != 0 {
+		return ids.Empty, nil, errExtraSpace
+	}
+	return rootID, values, nil
+}
+
+func historyRevisionKey(seqNum uint64) []byte {
+	key := make([]byte, len(historyRevisionPrefix)+seqNumLen)
+	copy(key, historyRevisionPrefix)
+	binary.BigEndian.PutUint64(key[len(historyRevisionPrefix):], seqNum)
+	return key
+}
+
+func historyRootKey(rootID ids.ID, seqNum uint64) []byte {
+	key := make([]byte, len(historyRootPrefix)+ids.IDLen+seqNumLen)
+	copy(key, historyRootPrefix)
+	copy(key[len(historyRootPrefix):], rootID[:])
+	binary.BigEndian.PutUint64(key[len(historyRootPrefix)+ids.IDLen:], seqNum)
+	return key
+}
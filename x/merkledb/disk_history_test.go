@@ -0,0 +1,267 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merkledb
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/maybe"
+)
+
+func newDiskHistoryConfig(historyDiskLength uint, historyDiskSize uint) Config {
+	config := newDefaultConfig()
+	config.HistoryLength = 2
+	config.HistoryDiskLength = historyDiskLength
+	config.HistoryDiskSize = historyDiskSize
+	return config
+}
+
+// writeRevisions commits [numRevisions] batches to [db] and returns the root
+// after each of them.
+func writeRevisions(t *testing.T, db *merkleDB, numRevisions int) []ids.ID {
+	require := require.New(t)
+
+	roots := make([]ids.ID, numRevisions)
+	for i := range roots {
+		batch := db.NewBatch()
+		for j := 0; j < 5; j++ {
+			key := []byte(fmt.Sprintf("key%d", (i+j)%16))
+			if j == 4 {
+				require.NoError(batch.Delete(key))
+				continue
+			}
+			require.NoError(batch.Put(key, []byte(fmt.Sprintf("value%d", i))))
+		}
+		require.NoError(batch.Write())
+		roots[i] = db.getMerkleRoot()
+	}
+	return roots
+}
+
+// newRestartedDiskHistoryDB returns a database that was restarted after
+// writing [numRevisions] revisions, and a database with the same revisions in
+// memory.
+func newRestartedDiskHistoryDB(t *testing.T, numRevisions int) (*merkleDB, *merkleDB, []ids.ID) {
+	require := require.New(t)
+
+	baseDB := memdb.New()
+	db, err := newDB(context.Background(), baseDB, newDiskHistoryConfig(uint(numRevisions+1), 0))
+	require.NoError(err)
+	roots := writeRevisions(t, db, numRevisions)
+
+	expectedDB, err := getBasicDB()
+	require.NoError(err)
+	require.Equal(roots, writeRevisions(t, expectedDB, numRevisions))
+
+	require.NoError(db.Close())
+	db, err = newDB(context.Background(), baseDB, newDiskHistoryConfig(uint(numRevisions+1), 0))
+	require.NoError(err)
+	require.Len(db.history.lastChanges, 1)
+	return db, expectedDB, roots
+}
+
+func TestDiskHistoryChangeProof(t *testing.T) {
+	const numRevisions = 10
+
+	// The persisted history is available after a restart.
+	db, expectedDB, roots := newRestartedDiskHistoryDB(t, numRevisions)
+
+	tests := []struct {
+		name      string
+		startRoot ids.ID
+		endRoot   ids.ID
+		start     maybe.Maybe[[]byte]
+		end       maybe.Maybe[[]byte]
+		maxLength int
+	}{
+		{
+			name:      "to current root",
+			startRoot: roots[1],
+			endRoot:   roots[numRevisions-1],
+			maxLength: 100,
+		},
+		{
+			name:      "to historical root",
+			startRoot: roots[0],
+			endRoot:   roots[5],
+			maxLength: 100,
+		},
+		{
+			name:      "bounded range",
+			startRoot: roots[2],
+			endRoot:   roots[7],
+			start:     maybe.Some([]byte("key10")),
+			end:       maybe.Some([]byte("key5")),
+			maxLength: 100,
+		},
+		{
+			name:      "max length",
+			startRoot: roots[0],
+			endRoot:   roots[8],
+			maxLength: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			proof, err := db.GetChangeProof(context.Background(), test.startRoot, test.endRoot, test.start, test.end, test.maxLength)
+			require.NoError(err)
+
+			expectedProof, err := expectedDB.GetChangeProof(context.Background(), test.startRoot, test.endRoot, test.start, test.end, test.maxLength)
+			require.NoError(err)
+			require.Equal(expectedProof, proof)
+		})
+	}
+}
+
+func TestDiskHistoryRangeProofAtRoot(t *testing.T) {
+	require := require.New(t)
+
+	db, _, roots := newRestartedDiskHistoryDB(t, 10)
+
+	proof, err := db.GetRangeProofAtRoot(context.Background(), roots[3], maybe.Nothing[[]byte](), maybe.Nothing[[]byte](), 100)
+	require.NoError(err)
+	require.NoError(proof.Verify(context.Background(), maybe.Nothing[[]byte](), maybe.Nothing[[]byte](), roots[3], db.tokenSize, db.hasher))
+}
+
+func TestDiskHistoryPrune(t *testing.T) {
+	tests := []struct {
+		name              string
+		historyDiskLength uint
+		historyDiskSize   uint
+		expectedOldest    int
+	}{
+		{
+			name:              "length",
+			historyDiskLength: 4,
+			expectedOldest:    6,
+		},
+		{
+			// Each revision is larger than 50 bytes, so only the current
+			// revision is kept.
+			name:            "size",
+			historyDiskSize: 50,
+			expectedOldest:  9,
+		},
+		{
+			name:              "length and size",
+			historyDiskLength: 4,
+			historyDiskSize:   50,
+			expectedOldest:    9,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			baseDB := memdb.New()
+			db, err := newDB(context.Background(), baseDB, newDiskHistoryConfig(test.historyDiskLength, test.historyDiskSize))
+			require.NoError(err)
+			roots := writeRevisions(t, db, 10)
+			require.Equal(uint64(10-test.expectedOldest), db.diskHistory.next-db.diskHistory.oldest)
+
+			// Revisions with the same root as the current root may still be
+			// in the history, so only check older revisions.
+			for i := 0; i < test.expectedOldest-1; i++ {
+				_, err := db.GetChangeProof(context.Background(), roots[i], roots[9], maybe.Nothing[[]byte](), maybe.Nothing[[]byte](), 100)
+				require.ErrorIs(err, ErrInsufficientHistory)
+			}
+			if test.expectedOldest < 8 {
+				_, err := db.GetChangeProof(context.Background(), roots[test.expectedOldest], roots[9], maybe.Nothing[[]byte](), maybe.Nothing[[]byte](), 100)
+				require.NoError(err)
+			}
+
+			// Only the retained revisions are on disk.
+			var size uint64
+			it := baseDB.NewIteratorWithPrefix(historyRevisionPrefix)
+			defer it.Release()
+			for it.Next() {
+				size += uint64(len(it.Value()))
+			}
+			require.NoError(it.Error())
+			require.Equal(db.diskHistory.size, size)
+		})
+	}
+}
+
+func TestDiskHistoryReopen(t *testing.T) {
+	require := require.New(t)
+
+	baseDB := memdb.New()
+	db, err := newDB(context.Background(), baseDB, newDiskHistoryConfig(10, 0))
+	require.NoError(err)
+	roots := writeRevisions(t, db, 5)
+	require.NoError(db.Close())
+
+	// Reducing the limit prunes the oldest revisions.
+	db, err = newDB(context.Background(), baseDB, newDiskHistoryConfig(3, 0))
+	require.NoError(err)
+	require.Equal(uint64(3), db.diskHistory.next-db.diskHistory.oldest)
+	_, err = db.GetChangeProof(context.Background(), roots[0], roots[4], maybe.Nothing[[]byte](), maybe.Nothing[[]byte](), 100)
+	require.ErrorIs(err, ErrInsufficientHistory)
+	_, err = db.GetChangeProof(context.Background(), roots[2], roots[4], maybe.Nothing[[]byte](), maybe.Nothing[[]byte](), 100)
+	require.NoError(err)
+	require.NoError(db.Close())
+
+	// Disabling the persisted history deletes it.
+	db, err = newDB(context.Background(), baseDB, newDiskHistoryConfig(0, 0))
+	require.NoError(err)
+	require.Nil(db.diskHistory)
+	requirePrefixEmpty(t, baseDB, historyPrefix)
+	roots = writeRevisions(t, db, 3)
+	require.NoError(db.Close())
+
+	// Re-enabling the persisted history starts from the current root.
+	db, err = newDB(context.Background(), baseDB, newDiskHistoryConfig(10, 0))
+	require.NoError(err)
+	require.Equal(uint64(1), db.diskHistory.next-db.diskHistory.oldest)
+	_, err = db.GetChangeProof(context.Background(), roots[0], roots[2], maybe.Nothing[[]byte](), maybe.Nothing[[]byte](), 100)
+	require.ErrorIs(err, ErrInsufficientHistory)
+
+	// Clearing the database resets the persisted history.
+	roots = writeRevisions(t, db, 3)
+	require.NoError(db.Clear())
+	require.Equal(uint64(1), db.diskHistory.next-db.diskHistory.oldest)
+	_, err = db.GetChangeProof(context.Background(), roots[0], roots[2], maybe.Nothing[[]byte](), maybe.Nothing[[]byte](), 100)
+	require.ErrorIs(err, ErrInsufficientHistory)
+}
+
+func requirePrefixEmpty(t *testing.T, db database.Iteratee, prefix []byte) {
+	it := db.NewIteratorWithPrefix(prefix)
+	defer it.Release()
+
+	require.False(t, it.Next())
+	require.NoError(t, it.Error())
+}
+
+func TestDiskHistoryNewViewAtRoot(t *testing.T) {
+	require := require.New(t)
+
+	db, expectedDB, roots := newRestartedDiskHistoryDB(t, 10)
+
+	trie, err := db.NewViewAtRoot(context.Background(), roots[3])
+	require.NoError(err)
+	expectedTrie, err := expectedDB.NewViewAtRoot(context.Background(), roots[3])
+	require.NoError(err)
+
+	root, err := trie.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(roots[3], root)
+
+	for i := 0; i < 16; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		expectedValue, expectedErr := expectedTrie.GetValue(context.Background(), key)
+		value, err := trie.GetValue(context.Background(), key)
+		require.ErrorIs(err, expectedErr)
+		require.Equal(expectedValue, value)
+	}
+}
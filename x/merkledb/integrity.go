@@ -0,0 +1,261 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merkledb
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+// HashMismatch is a node whose ID, as recorded by its parent, doesn't match
+// the hash of the node on disk.
+type HashMismatch struct {
+	Key Key
+	// The ID recorded by the node's parent.
+	Expected ids.ID
+	// The hash of the node on disk.
+	Actual ids.ID
+}
+
+// IntegrityReport describes the inconsistencies found by [CheckIntegrity].
+type IntegrityReport struct {
+	// False if the database wasn't closed cleanly, in which case the
+	// intermediate nodes are expected to be inconsistent until they're rebuilt
+	// on the next startup.
+	CleanShutdown bool
+	// The root ID calculated from the nodes on disk.
+	RootID ids.ID
+	// The number of nodes reachable from the root.
+	NodesChecked int
+	// Nodes whose hash doesn't match the ID recorded by their parent.
+	HashMismatches []HashMismatch
+	// Keys of nodes referenced by their parent that aren't on disk.
+	MissingNodes []Key
+	// Keys of nodes that are on disk but can't be parsed.
+	CorruptedNodes []Key
+	// Database keys of nodes on disk that aren't reachable from the root.
+	OrphanedNodes [][]byte
+}
+
+// Healthy returns true iff no inconsistencies were found.
+func (r *IntegrityReport) Healthy() bool {
+	return len(r.HashMismatches) == 0 &&
+		len(r.MissingNodes) == 0 &&
+		len(r.CorruptedNodes) == 0 &&
+		len(r.OrphanedNodes) == 0
+}
+
+type integrityChecker struct {
+	baseDB database.Database
+	// Only used to calculate the database keys of intermediate nodes.
+	intermediateNodeDB *intermediateNodeDB
+	hasher             Hasher
+	tokenSize          int
+
+	// Database keys of the nodes reachable from the root.
+	reachable set.Set[string]
+	report    *IntegrityReport
+}
+
+// CheckIntegrity walks the trie stored in [db] from its root, recalculates
+// the ID of each node with [config.Hasher] and reports the nodes whose ID
+// doesn't match the ID recorded by their parent. Nodes on disk that aren't
+// reachable from the root are reported as orphaned.
+//
+// [db] must not be in use by a merkledb instance.
+// The database keys of all reachable nodes are held in memory.
+func CheckIntegrity(ctx context.Context, db database.Database, config Config) (*IntegrityReport, error) {
+	if err := config.BranchFactor.Valid(); err != nil {
+		return nil, err
+	}
+
+	hasher := config.Hasher
+	if hasher == nil {
+		hasher = DefaultHasher
+	}
+	tokenSize := BranchFactorToTokenSize[config.BranchFactor]
+
+	shutdownType, err := db.Get(cleanShutdownKey)
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+		shutdownType = hadCleanShutdown
+	case err != nil:
+		return nil, err
+	}
+
+	c := &integrityChecker{
+		baseDB:             db,
+		intermediateNodeDB: newIntermediateNodeDB(db, utils.NewBytesPool(), &mockMetrics{}, 0, 0, 0, tokenSize, hasher),
+		hasher:             hasher,
+		tokenSize:          tokenSize,
+		reachable:          set.Set[string]{},
+		report: &IntegrityReport{
+			CleanShutdown: !bytes.Equal(shutdownType, didNotHaveCleanShutdown),
+		},
+	}
+
+	rootKeyBytes, err := db.Get(rootDBKey)
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+		// The trie is empty.
+	case err != nil:
+		return nil, err
+	default:
+		if err := c.checkRoot(ctx, rootKeyBytes); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, prefix := range [][]byte{valueNodePrefix, intermediateNodePrefix} {
+		if err := c.findOrphanedNodes(prefix); err != nil {
+			return nil, err
+		}
+	}
+	return c.report, nil
+}
+
+func (c *integrityChecker) checkRoot(ctx context.Context, rootKeyBytes []byte) error {
+	rootKey, err := decodeKey(rootKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	// Like when the database is opened, the root may be either an
+	// intermediate node or a value node.
+	hasValue := false
+	if !rootKey.hasPartialByte() {
+		_, err := c.baseDB.Get(c.dbKey(rootKey, false /* hasValue */))
+		switch {
+		case errors.Is(err, database.ErrNotFound):
+			hasValue = true
+		case err != nil:
+			return err
+		}
+	}
+
+	rootID, ok, err := c.checkNode(ctx, rootKey, hasValue)
+	if err != nil {
+		return err
+	}
+	if ok {
+		c.report.RootID = rootID
+	}
+	return nil
+}
+
+// checkNode checks the node with [key] and its descendants, and returns the
+// hash of the node.
+// Returns false if the node is missing or corrupted.
+func (c *integrityChecker) checkNode(ctx context.Context, key Key, hasValue bool) (ids.ID, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return ids.Empty, false, err
+	}
+
+	dbKey := c.dbKey(key, hasValue)
+	nodeBytes, err := c.baseDB.Get(dbKey)
+	if errors.Is(err, database.ErrNotFound) {
+		c.report.MissingNodes = append(c.report.MissingNodes, key)
+		return ids.Empty, false, nil
+	}
+	if err != nil {
+		return ids.Empty, false, err
+	}
+	c.reachable.Add(string(dbKey))
+
+	n, err := parseNode(c.hasher, key, nodeBytes)
+	if err != nil || n.hasValue() != hasValue {
+		c.report.CorruptedNodes = append(c.report.CorruptedNodes, key)
+		return ids.Empty, false, nil
+	}
+	c.report.NodesChecked++
+
+	childIndices := maps.Keys(n.children)
+	slices.Sort(childIndices)
+	for _, index := range childIndices {
+		entry := n.children[index]
+		childKey := key.Extend(ToToken(index, c.tokenSize), entry.compressedKey)
+		childID, ok, err := c.checkNode(ctx, childKey, entry.hasValue)
+		if err != nil {
+			return ids.Empty, false, err
+		}
+		if ok && childID != entry.id {
+			c.report.HashMismatches = append(c.report.HashMismatches, HashMismatch{
+				Key:      childKey,
+				Expected: entry.id,
+				Actual:   childID,
+			})
+		}
+	}
+	return c.hasher.HashNode(n), true, nil
+}
+
+func (c *integrityChecker) findOrphanedNodes(prefix []byte) error {
+	it := c.baseDB.NewIteratorWithPrefix(prefix)
+	defer it.Release()
+
+	for it.Next() {
+		if dbKey := it.Key(); !c.reachable.Contains(string(dbKey)) {
+			c.report.OrphanedNodes = append(c.report.OrphanedNodes, slices.Clone(dbKey))
+		}
+	}
+	return it.Error()
+}
+
+// Returns the key that the node with [key] is stored under in [c.baseDB].
+func (c *integrityChecker) dbKey(key Key, hasValue bool) []byte {
+	if hasValue {
+		return slices.Concat(valueNodePrefix, key.Bytes())
+	}
+
+	dbKey := c.intermediateNodeDB.constructDBKey(key)
+	defer c.intermediateNodeDB.bufferPool.Put(dbKey)
+	return slices.Clone(*dbKey)
+}
+
+// Repair regenerates the intermediate nodes of the trie stored in [db] from
+// its value nodes, which fixes all inconsistencies reported by
+// [CheckIntegrity] other than corrupted value nodes.
+// Orphaned value nodes are added back into the trie.
+//
+// [config] should match the configuration the database is normally opened
+// with, as the database is opened with it. If [config] doesn't persist
+// history, the persisted history is kept rather than deleted.
+//
+// [db] must not be in use by a merkledb instance.
+func Repair(ctx context.Context, db database.Database, config Config) error {
+	if config.HistoryDiskLength == 0 && config.HistoryDiskSize == 0 {
+		// Opening the database without persisting history would delete the
+		// persisted history, so all of its revisions are kept instead.
+		h := &diskHistory{}
+		metadata, err := db.Get(historyMetadataKey)
+		switch {
+		case err == nil:
+			if err := h.unmarshalMetadata(metadata); err != nil {
+				return err
+			}
+		case !errors.Is(err, database.ErrNotFound):
+			return err
+		}
+		config.HistoryDiskLength = uint(h.next - h.oldest)
+	}
+
+	// Opening a database that wasn't closed cleanly rebuilds the trie.
+	if err := db.Put(cleanShutdownKey, didNotHaveCleanShutdown); err != nil {
+		return err
+	}
+	trieDB, err := New(ctx, db, config)
+	if err != nil {
+		return err
+	}
+	return trieDB.Close()
+}
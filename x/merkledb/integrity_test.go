@@ -0,0 +1,243 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merkledb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/maybe"
+)
+
+// newIntegrityTestDB returns a closed database with 100 keys and its root.
+func newIntegrityTestDB(t *testing.T, config Config) (database.Database, ids.ID) {
+	require := require.New(t)
+
+	baseDB := memdb.New()
+	db, err := newDB(context.Background(), baseDB, config)
+	require.NoError(err)
+
+	batch := db.NewBatch()
+	for i := 0; i < 100; i++ {
+		require.NoError(batch.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.NoError(batch.Write())
+	root := db.getMerkleRoot()
+	require.NoError(db.Close())
+	return baseDB, root
+}
+
+// firstKeyWithPrefix returns the first database key with [prefix].
+func firstKeyWithPrefix(t *testing.T, db database.Iteratee, prefix []byte) []byte {
+	it := db.NewIteratorWithPrefix(prefix)
+	defer it.Release()
+
+	require.True(t, it.Next())
+	return slices.Clone(it.Key())
+}
+
+func TestCheckIntegrity(t *testing.T) {
+	tests := []struct {
+		name    string
+		corrupt func(*testing.T, database.Database)
+		check   func(*require.Assertions, *IntegrityReport)
+	}{
+		{
+			name:    "healthy",
+			corrupt: func(*testing.T, database.Database) {},
+			check: func(require *require.Assertions, report *IntegrityReport) {
+				require.True(report.Healthy())
+				require.True(report.CleanShutdown)
+			},
+		},
+		{
+			name: "modified value node",
+			corrupt: func(t *testing.T, db database.Database) {
+				dbKey := firstKeyWithPrefix(t, db, valueNodePrefix)
+				nodeBytes, err := db.Get(dbKey)
+				require.NoError(t, err)
+
+				var n dbNode
+				require.NoError(t, decodeDBNode(nodeBytes, &n))
+				n.value = maybe.Some(append(n.value.Value(), 0))
+				require.NoError(t, db.Put(dbKey, encodeDBNode(&n)))
+			},
+			check: func(require *require.Assertions, report *IntegrityReport) {
+				require.Len(report.HashMismatches, 1)
+				require.Empty(report.MissingNodes)
+				require.Empty(report.CorruptedNodes)
+				require.Empty(report.OrphanedNodes)
+			},
+		},
+		{
+			name: "unparsable intermediate node",
+			corrupt: func(t *testing.T, db database.Database) {
+				dbKey := firstKeyWithPrefix(t, db, intermediateNodePrefix)
+				require.NoError(t, db.Put(dbKey, []byte{0xff}))
+			},
+			check: func(require *require.Assertions, report *IntegrityReport) {
+				require.Len(report.CorruptedNodes, 1)
+				require.Empty(report.MissingNodes)
+				// The descendants of the corrupted node are unreachable.
+				require.NotEmpty(report.OrphanedNodes)
+			},
+		},
+		{
+			name: "missing value node",
+			corrupt: func(t *testing.T, db database.Database) {
+				require.NoError(t, db.Delete(firstKeyWithPrefix(t, db, valueNodePrefix)))
+			},
+			check: func(require *require.Assertions, report *IntegrityReport) {
+				require.Len(report.MissingNodes, 1)
+				require.Empty(report.CorruptedNodes)
+				require.Empty(report.OrphanedNodes)
+			},
+		},
+		{
+			name: "orphaned value node",
+			corrupt: func(t *testing.T, db database.Database) {
+				n := &dbNode{
+					value:    maybe.Some([]byte("orphan")),
+					children: map[byte]*child{},
+				}
+				require.NoError(t, db.Put(slices.Concat(valueNodePrefix, []byte("orphan")), encodeDBNode(n)))
+			},
+			check: func(require *require.Assertions, report *IntegrityReport) {
+				require.Equal([][]byte{slices.Concat(valueNodePrefix, []byte("orphan"))}, report.OrphanedNodes)
+				require.Empty(report.HashMismatches)
+				require.Empty(report.MissingNodes)
+				require.Empty(report.CorruptedNodes)
+			},
+		},
+		{
+			name: "unclean shutdown",
+			corrupt: func(t *testing.T, db database.Database) {
+				require.NoError(t, db.Put(cleanShutdownKey, didNotHaveCleanShutdown))
+			},
+			check: func(require *require.Assertions, report *IntegrityReport) {
+				require.True(report.Healthy())
+				require.False(report.CleanShutdown)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			config := newDefaultConfig()
+			db, root := newIntegrityTestDB(t, config)
+			test.corrupt(t, db)
+
+			report, err := CheckIntegrity(context.Background(), db, config)
+			require.NoError(err)
+			test.check(require, report)
+			if report.Healthy() {
+				require.Equal(root, report.RootID)
+			}
+		})
+	}
+}
+
+func TestCheckIntegrityBranchFactors(t *testing.T) {
+	for _, bf := range validBranchFactors {
+		t.Run(fmt.Sprintf("branch factor %d", bf), func(t *testing.T) {
+			require := require.New(t)
+
+			config := newDefaultConfig()
+			config.BranchFactor = bf
+			db, root := newIntegrityTestDB(t, config)
+
+			report, err := CheckIntegrity(context.Background(), db, config)
+			require.NoError(err)
+			require.True(report.Healthy())
+			require.Equal(root, report.RootID)
+			require.Positive(report.NodesChecked)
+		})
+	}
+}
+
+func TestCheckIntegrityEmpty(t *testing.T) {
+	require := require.New(t)
+
+	report, err := CheckIntegrity(context.Background(), memdb.New(), newDefaultConfig())
+	require.NoError(err)
+	require.True(report.Healthy())
+	require.Equal(ids.Empty, report.RootID)
+	require.Zero(report.NodesChecked)
+}
+
+func TestRepair(t *testing.T) {
+	require := require.New(t)
+
+	config := newDefaultConfig()
+	db, root := newIntegrityTestDB(t, config)
+
+	// Corrupt an intermediate node and add an unreachable intermediate node.
+	dbKey := firstKeyWithPrefix(t, db, intermediateNodePrefix)
+	require.NoError(db.Put(dbKey, []byte{0xff}))
+	require.NoError(db.Put(slices.Concat(intermediateNodePrefix, []byte("orphan")), []byte{0xff}))
+
+	report, err := CheckIntegrity(context.Background(), db, config)
+	require.NoError(err)
+	require.False(report.Healthy())
+
+	// Use a new metrics registry, as the database was already opened with
+	// [config].
+	require.NoError(Repair(context.Background(), db, newDefaultConfig()))
+
+	report, err = CheckIntegrity(context.Background(), db, config)
+	require.NoError(err)
+	require.True(report.Healthy())
+	require.True(report.CleanShutdown)
+	require.Equal(root, report.RootID)
+}
+
+func TestRepairKeepsHistory(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		baseDB = memdb.New()
+		config = newDiskHistoryConfig(3, 0)
+	)
+	db, err := newDB(context.Background(), baseDB, config)
+	require.NoError(err)
+	roots := writeRevisions(t, db, 5)
+	require.NoError(db.Close())
+
+	numHistoryKeys := countKeysWithPrefix(t, baseDB, historyPrefix)
+	require.NotZero(numHistoryKeys)
+
+	// Repairing without specifying the history limits doesn't delete the
+	// persisted history.
+	require.NoError(Repair(context.Background(), baseDB, newDefaultConfig()))
+	require.Equal(numHistoryKeys, countKeysWithPrefix(t, baseDB, historyPrefix))
+
+	db, err = newDB(context.Background(), baseDB, newDiskHistoryConfig(3, 0))
+	require.NoError(err)
+	defer func() {
+		require.NoError(db.Close())
+	}()
+
+	_, err = db.GetChangeProof(context.Background(), roots[2], roots[4], maybe.Nothing[[]byte](), maybe.Nothing[[]byte](), 100)
+	require.NoError(err)
+}
+
+func countKeysWithPrefix(t *testing.T, db database.Iteratee, prefix []byte) int {
+	it := db.NewIteratorWithPrefix(prefix)
+	defer it.Release()
+
+	var numKeys int
+	for it.Next() {
+		numKeys++
+	}
+	require.NoError(t, it.Error())
+	return numKeys
+}
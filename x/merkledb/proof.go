@@ -48,6 +48,11 @@ var (
 	ErrNilProof                    = errors.New("proof is nil")
 	ErrNilValue                    = errors.New("value is nil")
 	ErrUnexpectedEndProof          = errors.New("end proof should be empty")
+	ErrNoKeys                      = errors.New("no keys to prove")
+	ErrKeysValuesLengthMismatch    = errors.New("number of keys doesn't match number of values")
+	ErrUnsortedProofNodes          = errors.New("proof nodes are not sorted by strictly increasing key")
+	ErrMissingPathToKey            = errors.New("proof doesn't contain the path to a proven key")
+	ErrNilMultiProof               = errors.New("multi proof is nil")
 )
 
 type ProofNode struct {
@@ -238,6 +243,171 @@ func (proof *Proof) UnmarshalProto(pbProof *pb.Proof) error {
 	return nil
 }
 
+// MultiProof represents an inclusion/exclusion proof of multiple keys.
+type MultiProof struct {
+	// The nodes on the paths from the root to each of [Keys], sorted by
+	// increasing key. Nodes shared by multiple paths are only included once.
+	// Always contains at least the root.
+	Path []ProofNode
+	// The keys that this is a proof of existence/non-existence of.
+	// Sorted by increasing key.
+	Keys []Key
+	// Values[i] is Nothing if Keys[i] isn't in the trie.
+	// Otherwise, it is the value corresponding to Keys[i].
+	Values []maybe.Maybe[[]byte]
+}
+
+// Verify returns nil if the trie given in [proof] has root [expectedRootID]
+// and contains the claimed value, or absence of a value, for each of
+// [proof.Keys].
+func (proof *MultiProof) Verify(
+	ctx context.Context,
+	expectedRootID ids.ID,
+	tokenSize int,
+	hasher Hasher,
+) error {
+	// Make sure the proof is well-formed.
+	switch {
+	case len(proof.Path) == 0:
+		return ErrEmptyProof
+	case len(proof.Keys) == 0:
+		return ErrNoKeys
+	case len(proof.Keys) != len(proof.Values):
+		return fmt.Errorf("%w: %d keys but %d values", ErrKeysValuesLengthMismatch, len(proof.Keys), len(proof.Values))
+	}
+	for i := 1; i < len(proof.Keys); i++ {
+		if !proof.Keys[i-1].Less(proof.Keys[i]) {
+			return ErrNonIncreasingValues
+		}
+	}
+	for i, proofNode := range proof.Path {
+		if i > 0 && !proof.Path[i-1].Key.Less(proofNode.Key) {
+			return ErrUnsortedProofNodes
+		}
+		if proofNode.Key.hasPartialByte() && !proofNode.ValueOrHash.IsNothing() {
+			return ErrPartialByteLengthWithValue
+		}
+	}
+
+	// Don't bother locking [view] -- nobody else has a reference to it.
+	view, err := getStandaloneView(ctx, nil, tokenSize)
+	if err != nil {
+		return err
+	}
+
+	// Insert the proof nodes from the largest key to the smallest, so that
+	// every node is inserted after its descendants.
+	for i := len(proof.Path) - 1; i >= 0; i-- {
+		proofNode := proof.Path[i]
+		n, err := view.insert(proofNode.Key, maybe.Nothing[[]byte]())
+		if err != nil {
+			return err
+		}
+		// We overwrite the valueDigest to be the hash provided in the proof
+		// node because we may not know the pre-image of the valueDigest.
+		n.valueDigest = proofNode.ValueOrHash
+
+		// Children that are in the proof were already inserted. The IDs of
+		// the other children are taken from the proof.
+		for index, childID := range proofNode.Children {
+			if _, ok := n.children[index]; ok {
+				continue
+			}
+			n.setChildEntry(index, &child{
+				id: childID,
+			})
+		}
+	}
+
+	gotRootID, err := view.GetMerkleRoot(ctx)
+	if err != nil {
+		return err
+	}
+	if expectedRootID != gotRootID {
+		return fmt.Errorf("%w:[%s], expected:[%s]", ErrInvalidProof, gotRootID, expectedRootID)
+	}
+
+	// The reconstructed trie is now known to be correct, so the claimed values
+	// can be checked against it.
+	for i, key := range proof.Keys {
+		var closestNode *node
+		if err := visitPathToKey(view, key, func(n *node) error {
+			closestNode = n
+			return nil
+		}); err == database.ErrNotFound {
+			// The path to [key] goes through a child that isn't in the proof.
+			return fmt.Errorf("%w: %x", ErrMissingPathToKey, key.Bytes())
+		} else if err != nil {
+			return err
+		}
+
+		value := proof.Values[i]
+		if closestNode != nil && closestNode.key == key {
+			if !valueOrHashMatches(hasher, value, closestNode.valueDigest) {
+				return ErrProofValueDoesntMatch
+			}
+			continue
+		}
+		// [key] isn't in the trie.
+		if value.HasValue() {
+			return ErrProofValueDoesntMatch
+		}
+	}
+	return nil
+}
+
+func (proof *MultiProof) ToProto() *pb.MultiProof {
+	pbProof := &pb.MultiProof{
+		Keys:   make([][]byte, len(proof.Keys)),
+		Values: make([]*pb.MaybeBytes, len(proof.Values)),
+		Proof:  make([]*pb.ProofNode, len(proof.Path)),
+	}
+	for i, key := range proof.Keys {
+		pbProof.Keys[i] = key.Bytes()
+	}
+	for i, value := range proof.Values {
+		pbProof.Values[i] = &pb.MaybeBytes{
+			Value:     value.Value(),
+			IsNothing: value.IsNothing(),
+		}
+	}
+	for i, node := range proof.Path {
+		pbProof.Proof[i] = node.ToProto()
+	}
+	return pbProof
+}
+
+func (proof *MultiProof) UnmarshalProto(pbProof *pb.MultiProof) error {
+	if pbProof == nil {
+		return ErrNilMultiProof
+	}
+
+	proof.Keys = make([]Key, len(pbProof.Keys))
+	for i, key := range pbProof.Keys {
+		proof.Keys[i] = ToKey(key)
+	}
+
+	proof.Values = make([]maybe.Maybe[[]byte], len(pbProof.Values))
+	for i, value := range pbProof.Values {
+		switch {
+		case value == nil:
+			return ErrNilValue
+		case value.IsNothing && len(value.Value) != 0:
+			return ErrInvalidMaybe
+		case !value.IsNothing:
+			proof.Values[i] = maybe.Some(value.Value)
+		}
+	}
+
+	proof.Path = make([]ProofNode, len(pbProof.Proof))
+	for i, pbNode := range pbProof.Proof {
+		if err := proof.Path[i].UnmarshalProto(pbNode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type KeyValue struct {
 	Key   []byte
 	Value []byte
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"math/rand"
+	"slices"
 	"testing"
 	"time"
 
@@ -1951,3 +1952,352 @@ func FuzzChangeProofVerification(f *testing.F) {
 		))
 	})
 }
+
+func Test_MultiProof(t *testing.T) {
+	require := require.New(t)
+
+	db, err := getBasicDB()
+	require.NoError(err)
+	writeBasicBatch(t, db)
+
+	ctx := context.Background()
+	_, err = db.GetMultiProof(ctx, nil)
+	require.ErrorIs(err, ErrNoKeys)
+
+	keys := [][]byte{{4}, {2}, {5}, {2}, {1, 0}}
+	proof, err := db.GetMultiProof(ctx, keys)
+	require.NoError(err)
+	require.NoError(proof.Verify(ctx, db.getMerkleRoot(), db.tokenSize, db.hasher))
+
+	// The keys are sorted and deduplicated.
+	require.Equal(
+		[]Key{ToKey([]byte{1, 0}), ToKey([]byte{2}), ToKey([]byte{4}), ToKey([]byte{5})},
+		proof.Keys,
+	)
+	require.Equal(
+		[]maybe.Maybe[[]byte]{maybe.Nothing[[]byte](), maybe.Some([]byte{2}), maybe.Some([]byte{4}), maybe.Nothing[[]byte]()},
+		proof.Values,
+	)
+
+	// The path contains each node of the individual proofs exactly once.
+	expectedNodes := make(map[Key]ProofNode)
+	for _, key := range keys {
+		keyProof, err := db.GetProof(ctx, key)
+		require.NoError(err)
+		for _, node := range keyProof.Path {
+			expectedNodes[node.Key] = node
+		}
+	}
+	require.Len(proof.Path, len(expectedNodes))
+	for _, node := range proof.Path {
+		require.Equal(expectedNodes[node.Key], node)
+	}
+
+	// Proofs can also be generated from views.
+	view, err := db.NewView(ctx, ViewChanges{
+		BatchOps: []database.BatchOp{
+			{Key: []byte{5}, Value: []byte{5}},
+		},
+	})
+	require.NoError(err)
+	viewRoot, err := view.GetMerkleRoot(ctx)
+	require.NoError(err)
+
+	proof, err = view.GetMultiProof(ctx, keys)
+	require.NoError(err)
+	require.NoError(proof.Verify(ctx, viewRoot, db.tokenSize, db.hasher))
+	require.Equal(maybe.Some([]byte{5}), proof.Values[3])
+}
+
+func Test_MultiProof_Verify_Bad_Data(t *testing.T) {
+	indexOf := func(proof *MultiProof, key []byte) int {
+		for i, node := range proof.Path {
+			if node.Key == ToKey(key) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	tests := []struct {
+		name        string
+		malform     func(proof *MultiProof)
+		expectedErr error
+	}{
+		{
+			name:    "happyPath",
+			malform: func(*MultiProof) {},
+		},
+		{
+			name: "empty",
+			malform: func(proof *MultiProof) {
+				proof.Path = nil
+			},
+			expectedErr: ErrEmptyProof,
+		},
+		{
+			name: "no keys",
+			malform: func(proof *MultiProof) {
+				proof.Keys = nil
+				proof.Values = nil
+			},
+			expectedErr: ErrNoKeys,
+		},
+		{
+			name: "extra value",
+			malform: func(proof *MultiProof) {
+				proof.Values = append(proof.Values, maybe.Nothing[[]byte]())
+			},
+			expectedErr: ErrKeysValuesLengthMismatch,
+		},
+		{
+			name: "unsorted keys",
+			malform: func(proof *MultiProof) {
+				proof.Keys[0], proof.Keys[1] = proof.Keys[1], proof.Keys[0]
+				proof.Values[0], proof.Values[1] = proof.Values[1], proof.Values[0]
+			},
+			expectedErr: ErrNonIncreasingValues,
+		},
+		{
+			name: "unsorted proof nodes",
+			malform: func(proof *MultiProof) {
+				proof.Path[0], proof.Path[1] = proof.Path[1], proof.Path[0]
+			},
+			expectedErr: ErrUnsortedProofNodes,
+		},
+		{
+			name: "duplicate proof node",
+			malform: func(proof *MultiProof) {
+				proof.Path = append(proof.Path[:1], proof.Path...)
+			},
+			expectedErr: ErrUnsortedProofNodes,
+		},
+		{
+			name: "odd length key path with value",
+			malform: func(proof *MultiProof) {
+				proof.Path[0].ValueOrHash = maybe.Some([]byte{1, 2})
+			},
+			expectedErr: ErrPartialByteLengthWithValue,
+		},
+		{
+			name: "modified proof node",
+			malform: func(proof *MultiProof) {
+				proof.Path[indexOf(proof, []byte{4})].ValueOrHash = maybe.Some([]byte{10})
+			},
+			expectedErr: ErrInvalidProof,
+		},
+		{
+			name: "missing proof node",
+			malform: func(proof *MultiProof) {
+				proof.Path = slices.Delete(proof.Path, indexOf(proof, []byte{4}), indexOf(proof, []byte{4})+1)
+			},
+			expectedErr: ErrMissingPathToKey,
+		},
+		{
+			name: "missing value",
+			malform: func(proof *MultiProof) {
+				proof.Values[0] = maybe.Nothing[[]byte]()
+			},
+			expectedErr: ErrProofValueDoesntMatch,
+		},
+		{
+			name: "mismatched value",
+			malform: func(proof *MultiProof) {
+				proof.Values[1] = maybe.Some([]byte{10})
+			},
+			expectedErr: ErrProofValueDoesntMatch,
+		},
+		{
+			name: "value of exclusion proof",
+			malform: func(proof *MultiProof) {
+				proof.Values[2] = maybe.Some([]byte{5})
+			},
+			expectedErr: ErrProofValueDoesntMatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			db, err := getBasicDB()
+			require.NoError(err)
+			writeBasicBatch(t, db)
+
+			proof, err := db.GetMultiProof(context.Background(), [][]byte{{2}, {4}, {5}})
+			require.NoError(err)
+
+			tt.malform(proof)
+
+			err = proof.Verify(context.Background(), db.getMerkleRoot(), db.tokenSize, db.hasher)
+			require.ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
+
+func TestMultiProofProtoUnmarshal(t *testing.T) {
+	tests := []struct {
+		name        string
+		proof       *pb.MultiProof
+		expectedErr error
+	}{
+		{
+			name:        "nil",
+			proof:       nil,
+			expectedErr: ErrNilMultiProof,
+		},
+		{
+			name: "nil value",
+			proof: &pb.MultiProof{
+				Keys:   [][]byte{{1}},
+				Values: []*pb.MaybeBytes{nil},
+			},
+			expectedErr: ErrNilValue,
+		},
+		{
+			name: "invalid maybe",
+			proof: &pb.MultiProof{
+				Keys: [][]byte{{1}},
+				Values: []*pb.MaybeBytes{
+					{
+						Value:     []byte{1},
+						IsNothing: true,
+					},
+				},
+			},
+			expectedErr: ErrInvalidMaybe,
+		},
+		{
+			name: "nil proof node",
+			proof: &pb.MultiProof{
+				Proof: []*pb.ProofNode{nil},
+			},
+			expectedErr: ErrNilProofNode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var proof MultiProof
+			err := proof.UnmarshalProto(tt.proof)
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func FuzzMultiProofProtoMarshalUnmarshal(f *testing.F) {
+	f.Fuzz(func(
+		t *testing.T,
+		randSeed int64,
+	) {
+		require := require.New(t)
+		rand := rand.New(rand.NewSource(randSeed)) // #nosec G404
+
+		// Make a random proof.
+		proofLen := rand.Intn(32)
+		proofPath := make([]ProofNode, proofLen)
+		for i := 0; i < proofLen; i++ {
+			proofPath[i] = newRandomProofNode(rand)
+		}
+
+		numKeys := rand.Intn(16)
+		keys := make([]Key, numKeys)
+		values := make([]maybe.Maybe[[]byte], numKeys)
+		for i := 0; i < numKeys; i++ {
+			key := make([]byte, rand.Intn(32))
+			_, _ = rand.Read(key)
+			keys[i] = ToKey(key)
+
+			if rand.Intn(2) == 1 {
+				value := make([]byte, rand.Intn(32))
+				_, _ = rand.Read(value)
+				values[i] = maybe.Some(value)
+			}
+		}
+
+		proof := MultiProof{
+			Path:   proofPath,
+			Keys:   keys,
+			Values: values,
+		}
+
+		// Marshal and unmarshal it.
+		// Assert the unmarshaled one is the same as the original.
+		var unmarshaledProof MultiProof
+		protoProof := proof.ToProto()
+		require.NoError(unmarshaledProof.UnmarshalProto(protoProof))
+		require.Equal(proof, unmarshaledProof)
+
+		// Marshaling again should yield same result.
+		protoUnmarshaledProof := unmarshaledProof.ToProto()
+		require.Equal(protoProof, protoUnmarshaledProof)
+	})
+}
+
+// Generate multi proofs and verify that they are valid and match the
+// individual proofs of each key.
+func FuzzMultiProofVerification(f *testing.F) {
+	deletePortion := 0.25
+	f.Fuzz(func(
+		t *testing.T,
+		randSeed int64,
+		numKeyValues uint,
+		numProvenKeys uint8,
+	) {
+		require := require.New(t)
+		rand := rand.New(rand.NewSource(randSeed)) // #nosec G404
+
+		db, err := getBasicDB()
+		require.NoError(err)
+
+		// Insert a bunch of random key values.
+		insertRandomKeyValues(
+			require,
+			rand,
+			[]database.Database{db},
+			numKeyValues,
+			deletePortion,
+		)
+
+		// Prove a mix of keys that are and aren't in the trie.
+		keys := make([][]byte, 0, numProvenKeys)
+		it := db.NewIterator()
+		for len(keys) < int(numProvenKeys) {
+			if rand.Intn(2) == 0 && it.Next() {
+				keys = append(keys, it.Key())
+				continue
+			}
+			key := make([]byte, rand.Intn(8))
+			_, _ = rand.Read(key)
+			keys = append(keys, key)
+		}
+		it.Release()
+
+		ctx := context.Background()
+		proof, err := db.GetMultiProof(ctx, keys)
+		switch {
+		case len(keys) == 0:
+			require.ErrorIs(err, ErrNoKeys)
+			return
+		case db.getMerkleRoot() == ids.Empty:
+			require.ErrorIs(err, ErrEmptyProof)
+			return
+		}
+		require.NoError(err)
+		require.NoError(proof.Verify(ctx, db.getMerkleRoot(), db.tokenSize, db.hasher))
+
+		for i, key := range proof.Keys {
+			keyProof, err := db.GetProof(ctx, key.Bytes())
+			require.NoError(err)
+			require.Equal(keyProof.Value, proof.Values[i])
+		}
+
+		// Claiming a different value for any key invalidates the proof.
+		i := rand.Intn(len(proof.Keys))
+		if proof.Values[i].IsNothing() {
+			proof.Values[i] = maybe.Some([]byte{})
+		} else {
+			proof.Values[i] = maybe.Nothing[[]byte]()
+		}
+		err = proof.Verify(ctx, db.getMerkleRoot(), db.tokenSize, db.hasher)
+		require.ErrorIs(err, ErrProofValueDoesntMatch)
+	})
+}
@@ -9,8 +9,11 @@ import (
 	"fmt"
 	"slices"
 
+	"golang.org/x/exp/maps"
+
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/maybe"
 )
 
@@ -35,6 +38,14 @@ type ProofGetter interface {
 	GetProof(ctx context.Context, keyBytes []byte) (*Proof, error)
 }
 
+type MultiProofGetter interface {
+	// GetMultiProof generates a single proof of the values associated with
+	// each of [keys], or of their absence from the trie.
+	// Returns ErrEmptyProof if the trie is empty.
+	// Returns ErrNoKeys if [keys] is empty.
+	GetMultiProof(ctx context.Context, keys [][]byte) (*MultiProof, error)
+}
+
 type trieInternals interface {
 	// get the value associated with the key in path form
 	// database.ErrNotFound if the key is not present
@@ -59,6 +70,7 @@ type Trie interface {
 	trieInternals
 	MerkleRootGetter
 	ProofGetter
+	MultiProofGetter
 	database.Iteratee
 
 	// GetValue gets the value associated with the specified key
@@ -191,6 +203,45 @@ func getProof(t Trie, key []byte) (*Proof, error) {
 	return proof, nil
 }
 
+// Returns a proof that each of [keys] is in or not in trie [t].
+// Assumes [t] doesn't change while this function is running.
+func getMultiProof(t Trie, keys [][]byte) (*MultiProof, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	provenKeys := make([]Key, len(keys))
+	for i, key := range keys {
+		provenKeys[i] = ToKey(key)
+	}
+	utils.Sort(provenKeys)
+	provenKeys = slices.Compact(provenKeys)
+
+	proof := &MultiProof{
+		Keys:   provenKeys,
+		Values: make([]maybe.Maybe[[]byte], len(provenKeys)),
+	}
+	proofNodes := make(map[Key]ProofNode)
+	for i, key := range provenKeys {
+		keyProof, err := getProof(t, key.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		proof.Values[i] = keyProof.Value
+		for _, proofNode := range keyProof.Path {
+			proofNodes[proofNode.Key] = proofNode
+		}
+	}
+
+	proofNodeKeys := maps.Keys(proofNodes)
+	utils.Sort(proofNodeKeys)
+	proof.Path = make([]ProofNode, len(proofNodeKeys))
+	for i, key := range proofNodeKeys {
+		proof.Path[i] = proofNodes[key]
+	}
+	return proof, nil
+}
+
 // GetRangeProof returns a range proof for (at least part of) the key range [start, end].
 // The returned proof's [KeyValues] has at most [maxLength] values.
 // [maxLength] must be > 0.
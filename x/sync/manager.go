@@ -11,7 +11,6 @@ import (
 	"math"
 	"slices"
 	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -19,6 +18,7 @@ import (
 	"golang.org/x/exp/maps"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/p2p"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -51,6 +51,7 @@ var (
 	errTooManyKeys                   = errors.New("response contains more than requested keys")
 	errTooManyBytes                  = errors.New("response contains more than requested bytes")
 	errUnexpectedChangeProofResponse = errors.New("unexpected response type")
+	errInvalidResponse               = errors.New("peer served an invalid response")
 )
 
 type priority byte
@@ -136,8 +137,9 @@ type Manager struct {
 	closeOnce sync.Once
 	tokenSize int
 
-	stateSyncNodeIdx uint32
-	metrics          SyncMetrics
+	peers    *peerScorer
+	metrics  SyncMetrics
+	progress *progressTracker
 }
 
 // TODO remove non-config values out of this struct
@@ -152,6 +154,10 @@ type ManagerConfig struct {
 	StateSyncNodes        []ids.NodeID
 	// If not specified, [merkledb.DefaultHasher] will be used.
 	Hasher merkledb.Hasher
+	// ProgressDB persists the progress of the sync, so that it is reported
+	// accurately after a restart. If not specified, the progress is only
+	// tracked in memory.
+	ProgressDB database.KeyValueReaderWriterDeleter
 }
 
 func NewManager(config ManagerConfig, registerer prometheus.Registerer) (*Manager, error) {
@@ -180,13 +186,20 @@ func NewManager(config ManagerConfig, registerer prometheus.Registerer) (*Manage
 		return nil, err
 	}
 
+	progress, err := newProgressTracker(config.ProgressDB, "sync", registerer)
+	if err != nil {
+		return nil, err
+	}
+
 	m := &Manager{
 		config:          config,
 		doneChan:        make(chan struct{}),
 		unprocessedWork: newWorkHeap(),
 		processedWork:   newWorkHeap(),
 		tokenSize:       merkledb.BranchFactorToTokenSize[config.BranchFactor],
+		peers:           newPeerScorer(config.StateSyncNodes),
 		metrics:         metrics,
+		progress:        progress,
 	}
 	m.unprocessedWorkCond.L = &m.workLock
 
@@ -203,6 +216,10 @@ func (m *Manager) Start(ctx context.Context) error {
 
 	m.config.Log.Info("starting sync", zap.Stringer("target root", m.config.TargetRoot))
 
+	if err := m.progress.SetTarget(m.config.TargetRoot); err != nil {
+		return err
+	}
+
 	// Add work item to fetch the entire key range.
 	// Note that this will be the first work item to be processed.
 	m.unprocessedWork.Insert(newWorkItem(ids.Empty, maybe.Nothing[[]byte](), maybe.Nothing[[]byte](), lowPriority, time.Now()))
@@ -368,18 +385,11 @@ func (m *Manager) requestChangeProof(ctx context.Context, work *workItem) {
 		return
 	}
 
-	onResponse := func(ctx context.Context, _ ids.NodeID, responseBytes []byte, err error) {
-		defer m.finishWorkItem()
-
-		if err := m.handleChangeProofResponse(ctx, targetRootID, work, request, responseBytes, err); err != nil {
-			// TODO log responses
-			m.config.Log.Debug("dropping response", zap.Error(err), zap.Stringer("request", request))
-			m.retryWork(work)
-			return
-		}
+	onResponse := func(ctx context.Context, responseBytes []byte, err error) error {
+		return m.handleChangeProofResponse(ctx, targetRootID, work, request, responseBytes, err)
 	}
 
-	if err := m.sendRequest(ctx, m.config.ChangeProofClient, requestBytes, onResponse); err != nil {
+	if err := m.sendRequest(ctx, m.config.ChangeProofClient, work, request, requestBytes, onResponse); err != nil {
 		m.finishWorkItem()
 		m.setError(err)
 		return
@@ -426,18 +436,11 @@ func (m *Manager) requestRangeProof(ctx context.Context, work *workItem) {
 		return
 	}
 
-	onResponse := func(ctx context.Context, _ ids.NodeID, responseBytes []byte, appErr error) {
-		defer m.finishWorkItem()
-
-		if err := m.handleRangeProofResponse(ctx, targetRootID, work, request, responseBytes, appErr); err != nil {
-			// TODO log responses
-			m.config.Log.Debug("dropping response", zap.Error(err), zap.Stringer("request", request))
-			m.retryWork(work)
-			return
-		}
+	onResponse := func(ctx context.Context, responseBytes []byte, appErr error) error {
+		return m.handleRangeProofResponse(ctx, targetRootID, work, request, responseBytes, appErr)
 	}
 
-	if err := m.sendRequest(ctx, m.config.RangeProofClient, requestBytes, onResponse); err != nil {
+	if err := m.sendRequest(ctx, m.config.RangeProofClient, work, request, requestBytes, onResponse); err != nil {
 		m.finishWorkItem()
 		m.setError(err)
 		return
@@ -446,17 +449,68 @@ func (m *Manager) requestRangeProof(ctx context.Context, work *workItem) {
 	m.metrics.RequestMade()
 }
 
-func (m *Manager) sendRequest(ctx context.Context, client *p2p.Client, requestBytes []byte, onResponse p2p.AppResponseCallback) error {
-	if len(m.config.StateSyncNodes) == 0 {
-		return client.AppRequestAny(ctx, requestBytes, onResponse)
+// sendRequest sends [request] to the best scored peer, or to an arbitrary
+// peer if there isn't one, and handles the response with [handleResponse].
+// The peer's score is updated based on the result of [handleResponse].
+// If [handleResponse] returns an error, [work] is retried.
+func (m *Manager) sendRequest(
+	ctx context.Context,
+	client *p2p.Client,
+	work *workItem,
+	request fmt.Stringer,
+	requestBytes []byte,
+	handleResponse func(ctx context.Context, responseBytes []byte, err error) error,
+) error {
+	selectedNodeID, selected, err := m.peers.selectPeer()
+	if err != nil {
+		return err
 	}
 
-	// Get the next nodeID to query using the [nodeIdx] offset.
-	// If we're out of nodes, loop back to 0.
-	// We do this try to query a different node each time if possible.
-	nodeIdx := atomic.AddUint32(&m.stateSyncNodeIdx, 1)
-	nodeID := m.config.StateSyncNodes[nodeIdx%uint32(len(m.config.StateSyncNodes))]
-	return client.AppRequest(ctx, set.Of(nodeID), requestBytes, onResponse)
+	sentTime := time.Now()
+	onResponse := func(ctx context.Context, nodeID ids.NodeID, responseBytes []byte, appErr error) {
+		defer m.finishWorkItem()
+
+		err := handleResponse(ctx, responseBytes, appErr)
+		switch {
+		case appErr != nil || len(responseBytes) == 0:
+			// The peer didn't serve a proof, which an honest peer may do if
+			// it's missing the requested root.
+			m.peers.registerFailure(nodeID, selected)
+		case errors.Is(err, errInvalidResponse):
+			if !m.peers.isBlacklisted(nodeID) {
+				m.config.Log.Info("blacklisting peer for serving an invalid proof",
+					zap.Stringer("nodeID", nodeID),
+					zap.Error(err),
+				)
+			}
+			m.peers.registerInvalidResponse(nodeID, selected)
+		default:
+			// Any other error, such as the request being cancelled or a local
+			// database error, isn't caused by the peer.
+			m.peers.registerResponse(nodeID, selected, time.Since(sentTime))
+		}
+
+		if err != nil {
+			// TODO log responses
+			m.config.Log.Debug("dropping response",
+				zap.Stringer("nodeID", nodeID),
+				zap.Error(err),
+				zap.Stringer("request", request),
+			)
+			m.retryWork(work)
+		}
+	}
+
+	if !selected {
+		return client.AppRequestAnyExcept(ctx, m.peers.blacklisted(), requestBytes, onResponse)
+	}
+
+	m.peers.registerRequest(selectedNodeID)
+	if err := client.AppRequest(ctx, set.Of(selectedNodeID), requestBytes, onResponse); err != nil {
+		m.peers.registerFailure(selectedNodeID, selected)
+		return err
+	}
+	return nil
 }
 
 func (m *Manager) retryWork(work *workItem) {
@@ -492,7 +546,7 @@ func (m *Manager) shouldHandleResponse(
 	}
 
 	if len(responseBytes) > int(bytesLimit) {
-		return fmt.Errorf("%w: (%d) > %d)", errTooManyBytes, len(responseBytes), bytesLimit)
+		return fmt.Errorf("%w: %w: (%d) > %d)", errInvalidResponse, errTooManyBytes, len(responseBytes), bytesLimit)
 	}
 
 	return nil
@@ -512,12 +566,12 @@ func (m *Manager) handleRangeProofResponse(
 
 	var rangeProofProto pb.RangeProof
 	if err := proto.Unmarshal(responseBytes, &rangeProofProto); err != nil {
-		return err
+		return fmt.Errorf("%w: %w", errInvalidResponse, err)
 	}
 
 	var rangeProof merkledb.RangeProof
 	if err := rangeProof.UnmarshalProto(&rangeProofProto); err != nil {
-		return err
+		return fmt.Errorf("%w: %w", errInvalidResponse, err)
 	}
 
 	if err := verifyRangeProof(
@@ -530,7 +584,7 @@ func (m *Manager) handleRangeProofResponse(
 		m.tokenSize,
 		m.config.Hasher,
 	); err != nil {
-		return err
+		return m.verificationFailed(ctx, targetRootID, err)
 	}
 
 	largestHandledKey := work.end
@@ -545,6 +599,10 @@ func (m *Manager) handleRangeProofResponse(
 		largestHandledKey = maybe.Some(rangeProof.KeyValues[len(rangeProof.KeyValues)-1].Key)
 	}
 
+	if err := m.progress.Fetched(len(rangeProof.KeyValues), len(responseBytes)); err != nil {
+		m.setError(err)
+		return nil
+	}
 	m.completeWorkItem(ctx, work, largestHandledKey, targetRootID, rangeProof.EndProof)
 	return nil
 }
@@ -563,7 +621,7 @@ func (m *Manager) handleChangeProofResponse(
 
 	var changeProofResp pb.SyncGetChangeProofResponse
 	if err := proto.Unmarshal(responseBytes, &changeProofResp); err != nil {
-		return err
+		return fmt.Errorf("%w: %w", errInvalidResponse, err)
 	}
 
 	startKey := maybeBytesToMaybe(request.StartKey)
@@ -574,15 +632,15 @@ func (m *Manager) handleChangeProofResponse(
 		// The server had enough history to send us a change proof
 		var changeProof merkledb.ChangeProof
 		if err := changeProof.UnmarshalProto(changeProofResp.ChangeProof); err != nil {
-			return err
+			return fmt.Errorf("%w: %w", errInvalidResponse, err)
 		}
 
 		// Ensure the response does not contain more than the requested number of leaves
 		// and the start and end roots match the requested roots.
 		if len(changeProof.KeyChanges) > int(request.KeyLimit) {
 			return fmt.Errorf(
-				"%w: (%d) > %d)",
-				errTooManyKeys, len(changeProof.KeyChanges), request.KeyLimit,
+				"%w: %w: (%d) > %d)",
+				errInvalidResponse, errTooManyKeys, len(changeProof.KeyChanges), request.KeyLimit,
 			)
 		}
 
@@ -598,7 +656,7 @@ func (m *Manager) handleChangeProofResponse(
 			endKey,
 			endRoot,
 		); err != nil {
-			return fmt.Errorf("%w due to %w", errInvalidChangeProof, err)
+			return m.verificationFailed(ctx, targetRootID, fmt.Errorf("%w due to %w", errInvalidChangeProof, err))
 		}
 
 		largestHandledKey := work.end
@@ -611,11 +669,15 @@ func (m *Manager) handleChangeProofResponse(
 			largestHandledKey = maybe.Some(changeProof.KeyChanges[len(changeProof.KeyChanges)-1].Key)
 		}
 
+		if err := m.progress.Fetched(len(changeProof.KeyChanges), len(responseBytes)); err != nil {
+			m.setError(err)
+			return nil
+		}
 		m.completeWorkItem(ctx, work, largestHandledKey, targetRootID, changeProof.EndProof)
 	case *pb.SyncGetChangeProofResponse_RangeProof:
 		var rangeProof merkledb.RangeProof
 		if err := rangeProof.UnmarshalProto(changeProofResp.RangeProof); err != nil {
-			return err
+			return fmt.Errorf("%w: %w", errInvalidResponse, err)
 		}
 
 		// The server did not have enough history to send us a change proof
@@ -630,7 +692,7 @@ func (m *Manager) handleChangeProofResponse(
 			m.tokenSize,
 			m.config.Hasher,
 		); err != nil {
-			return err
+			return m.verificationFailed(ctx, targetRootID, err)
 		}
 
 		largestHandledKey := work.end
@@ -643,17 +705,41 @@ func (m *Manager) handleChangeProofResponse(
 			largestHandledKey = maybe.Some(rangeProof.KeyValues[len(rangeProof.KeyValues)-1].Key)
 		}
 
+		if err := m.progress.Fetched(len(rangeProof.KeyValues), len(responseBytes)); err != nil {
+			m.setError(err)
+			return nil
+		}
 		m.completeWorkItem(ctx, work, largestHandledKey, targetRootID, rangeProof.EndProof)
 	default:
 		return fmt.Errorf(
-			"%w: %T",
-			errUnexpectedChangeProofResponse, changeProofResp,
+			"%w: %w: %T",
+			errInvalidResponse, errUnexpectedChangeProofResponse, changeProofResp,
 		)
 	}
 
 	return nil
 }
 
+// verificationFailed returns [err], which was returned when verifying a proof
+// for [targetRootID], wrapped with [errInvalidResponse] if the peer that
+// served the proof is responsible for the failure.
+//
+// The peer isn't responsible if verification was interrupted by [ctx] or by
+// the database being closed, or if the sync target changed since the proof
+// was requested, as verification may depend on the local state.
+func (m *Manager) verificationFailed(ctx context.Context, targetRootID ids.ID, err error) error {
+	switch {
+	case ctx.Err() != nil,
+		errors.Is(err, context.Canceled),
+		errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, database.ErrClosed),
+		m.getTargetRoot() != targetRootID:
+		return err
+	default:
+		return fmt.Errorf("%w: %w", errInvalidResponse, err)
+	}
+}
+
 // findNextKey returns the start of the key range that should be fetched next
 // given that we just received a range/change proof that proved a range of
 // key-value pairs ending at [lastReceivedKey].
@@ -858,10 +944,19 @@ func (m *Manager) Wait(ctx context.Context) error {
 		return fmt.Errorf("%w: expected %s, got %s", ErrFinishedWithUnexpectedRoot, targetRootID, root)
 	}
 
+	if err := m.progress.Done(); err != nil {
+		return err
+	}
+
 	m.config.Log.Info("completed", zap.Stringer("root", root))
 	return nil
 }
 
+// Progress returns the progress of the sync.
+func (m *Manager) Progress() Progress {
+	return m.progress.Get()
+}
+
 func (m *Manager) UpdateSyncTarget(syncTargetRoot ids.ID) error {
 	m.syncTargetLock.Lock()
 	defer m.syncTargetLock.Unlock()
@@ -885,6 +980,9 @@ func (m *Manager) UpdateSyncTarget(syncTargetRoot ids.ID) error {
 
 	// move all completed ranges into the work heap with high priority
 	shouldSignal := m.processedWork.Len() > 0
+	if err := m.progress.SetTarget(syncTargetRoot); err != nil {
+		return err
+	}
 	for m.processedWork.Len() > 0 {
 		// Note that [m.processedWork].Close() hasn't
 		// been called because we have [m.workLock]
@@ -972,6 +1070,9 @@ func (m *Manager) completeWorkItem(ctx context.Context, work *workItem, largestH
 		defer m.workLock.Unlock()
 
 		m.processedWork.MergeInsert(newWorkItem(rootID, work.start, largestHandledKey, work.priority, time.Now()))
+		if err := m.progress.RangeCompleted(work.start, largestHandledKey); err != nil {
+			m.setError(err)
+		}
 	}
 
 	// completed the range [work.start, lastKey], log and record in the completed work heap
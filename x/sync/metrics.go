@@ -92,3 +92,44 @@ func (m *metrics) RequestMade() {
 func (m *metrics) RequestSucceeded() {
 	m.requestsSucceeded.Inc()
 }
+
+type serverMetrics struct {
+	bytesServed      prometheus.Counter
+	requestsRejected prometheus.Counter
+	proofCacheHits   prometheus.Counter
+	proofCacheMisses prometheus.Counter
+}
+
+func newServerMetrics(namespace string) *serverMetrics {
+	return &serverMetrics{
+		bytesServed: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "proof_bytes_served",
+			Help:      "cumulative amount of proof bytes sent in response to proof requests",
+		}),
+		requestsRejected: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "proof_requests_rejected",
+			Help:      "cumulative amount of proof requests rejected because the peer exceeded its request budget",
+		}),
+		proofCacheHits: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "proof_cache_hits",
+			Help:      "cumulative amount of proof requests served from the proof cache",
+		}),
+		proofCacheMisses: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "proof_cache_misses",
+			Help:      "cumulative amount of proof requests that required generating a proof",
+		}),
+	}
+}
+
+func (m *serverMetrics) register(reg prometheus.Registerer) error {
+	return errors.Join(
+		reg.Register(m.bytesServed),
+		reg.Register(m.requestsRejected),
+		reg.Register(m.proofCacheHits),
+		reg.Register(m.proofCacheMisses),
+	)
+}
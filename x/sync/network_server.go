@@ -10,8 +10,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/p2p"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
@@ -35,6 +37,9 @@ const (
 	// TODO: refine this estimate. This is almost certainly a large overestimate.
 	estimatedMessageOverhead = 4 * units.KiB
 	maxByteSizeLimit         = constants.DefaultMaxMessageSize - estimatedMessageOverhead
+	// Estimated size, in bytes, of a cached proof excluding its keys and
+	// response bytes.
+	proofCacheEntryOverhead = 2*ids.IDLen + 32
 )
 
 var (
@@ -60,21 +65,141 @@ func maybeBytesToMaybe(mb *pb.MaybeBytes) maybe.Maybe[[]byte] {
 	return maybe.Nothing[[]byte]()
 }
 
-func NewGetChangeProofHandler(log logging.Logger, db DB) *GetChangeProofHandler {
+func maybeBytesToMaybeString(mb maybe.Maybe[[]byte]) maybe.Maybe[string] {
+	return maybe.Bind(mb, func(b []byte) string {
+		return string(b)
+	})
+}
+
+type ServerConfig struct {
+	// ThrottlePeriod and ThrottleLimit bound the number of proof requests
+	// each peer can make. Peers that make more than [ThrottleLimit] requests
+	// during [ThrottlePeriod] have their requests rejected.
+	// If [ThrottleLimit] is 0, requests aren't throttled.
+	ThrottlePeriod time.Duration
+	ThrottleLimit  int
+	// ProofCacheSize is the maximum number of bytes of recently generated
+	// proofs to keep in memory.
+	// If 0, proofs aren't cached.
+	ProofCacheSize int
+}
+
+// proofCacheKey uniquely identifies a proof response.
+type proofCacheKey struct {
+	changeProof bool
+	// Only set for change proofs.
+	startRoot  ids.ID
+	endRoot    ids.ID
+	start      maybe.Maybe[string]
+	end        maybe.Maybe[string]
+	keyLimit   uint32
+	bytesLimit uint32
+}
+
+// Server bounds the resources spent responding to proof requests.
+// Handlers created by the same Server share the per-peer request budgets and
+// the cache of recently generated proofs.
+type Server struct {
+	// Nil if requests aren't throttled.
+	throttler p2p.Throttler
+	proofs    cache.Cacher[proofCacheKey, []byte]
+	metrics   *serverMetrics
+}
+
+func NewServer(config ServerConfig, namespace string, reg prometheus.Registerer) (*Server, error) {
+	s := newUnlimitedServer(namespace)
+	if config.ThrottleLimit > 0 {
+		s.throttler = p2p.NewSlidingWindowThrottler(config.ThrottlePeriod, config.ThrottleLimit)
+	}
+	if config.ProofCacheSize > 0 {
+		s.proofs = cache.NewSizedLRU(config.ProofCacheSize, func(key proofCacheKey, proofBytes []byte) int {
+			return proofCacheEntryOverhead + len(key.start.Value()) + len(key.end.Value()) + len(proofBytes)
+		})
+	}
+	return s, s.metrics.register(reg)
+}
+
+// newUnlimitedServer returns a server that neither throttles requests nor
+// caches proofs, and whose metrics aren't registered.
+func newUnlimitedServer(namespace string) *Server {
+	return &Server{
+		proofs:  &cache.Empty[proofCacheKey, []byte]{},
+		metrics: newServerMetrics(namespace),
+	}
+}
+
+func (s *Server) NewGetChangeProofHandler(log logging.Logger, db DB) *GetChangeProofHandler {
 	return &GetChangeProofHandler{
-		log: log,
-		db:  db,
+		log:    log,
+		db:     db,
+		server: s,
+	}
+}
+
+func (s *Server) NewGetRangeProofHandler(log logging.Logger, db DB) *GetRangeProofHandler {
+	return &GetRangeProofHandler{
+		log:    log,
+		db:     db,
+		server: s,
+	}
+}
+
+// Returns true if a request from [nodeID] is within its request budget.
+func (s *Server) handle(nodeID ids.NodeID) bool {
+	if s.throttler == nil || s.throttler.Handle(nodeID) {
+		return true
+	}
+	s.metrics.requestsRejected.Inc()
+	return false
+}
+
+// serve returns the proof identified by [key] if it was recently generated.
+// Otherwise, it returns the result of [getProof] and caches the proof.
+func (s *Server) serve(
+	key proofCacheKey,
+	getProof func() ([]byte, *common.AppError),
+) ([]byte, *common.AppError) {
+	proofBytes, ok := s.proofs.Get(key)
+	if ok {
+		s.metrics.proofCacheHits.Inc()
+	} else {
+		s.metrics.proofCacheMisses.Inc()
+
+		var err *common.AppError
+		proofBytes, err = getProof()
+		if err != nil {
+			return nil, err
+		}
+		// A nil response means the request was dropped, which may not be
+		// the case for future requests.
+		if proofBytes != nil {
+			s.proofs.Put(key, proofBytes)
+		}
 	}
+
+	s.metrics.bytesServed.Add(float64(len(proofBytes)))
+	return proofBytes, nil
+}
+
+// NewGetChangeProofHandler returns a handler that neither throttles requests
+// nor caches proofs.
+func NewGetChangeProofHandler(log logging.Logger, db DB) *GetChangeProofHandler {
+	return newUnlimitedServer("").NewGetChangeProofHandler(log, db)
 }
 
 type GetChangeProofHandler struct {
-	log logging.Logger
-	db  DB
+	log    logging.Logger
+	db     DB
+	server *Server
 }
 
 func (*GetChangeProofHandler) AppGossip(context.Context, ids.NodeID, []byte) {}
 
-func (g *GetChangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _ time.Time, requestBytes []byte) ([]byte, *common.AppError) {
+func (g *GetChangeProofHandler) AppRequest(ctx context.Context, nodeID ids.NodeID, _ time.Time, requestBytes []byte) ([]byte, *common.AppError) {
+	if !g.server.handle(nodeID) {
+		return nil, p2p.ErrThrottled
+	}
+
 	req := &pb.SyncGetChangeProofRequest{}
 	if err := proto.Unmarshal(requestBytes, req); err != nil {
 		return nil, &common.AppError{
@@ -94,8 +219,6 @@ func (g *GetChangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _
 	var (
 		keyLimit   = min(req.KeyLimit, maxKeyValuesLimit)
 		bytesLimit = min(int(req.BytesLimit), maxByteSizeLimit)
-		start      = maybeBytesToMaybe(req.StartKey)
-		end        = maybeBytesToMaybe(req.EndKey)
 	)
 
 	startRoot, err := ids.ToID(req.StartRootHash)
@@ -114,6 +237,34 @@ func (g *GetChangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _
 		}
 	}
 
+	key := proofCacheKey{
+		changeProof: true,
+		startRoot:   startRoot,
+		endRoot:     endRoot,
+		start:       maybeBytesToMaybeString(maybeBytesToMaybe(req.StartKey)),
+		end:         maybeBytesToMaybeString(maybeBytesToMaybe(req.EndKey)),
+		keyLimit:    keyLimit,
+		bytesLimit:  uint32(bytesLimit),
+	}
+	return g.server.serve(key, func() ([]byte, *common.AppError) {
+		return g.getChangeProof(ctx, req, startRoot, endRoot, keyLimit, bytesLimit)
+	})
+}
+
+// Get the change proof specified by [req], or a range proof of [endRoot] if
+// there's insufficient history to generate the change proof.
+func (g *GetChangeProofHandler) getChangeProof(
+	ctx context.Context,
+	req *pb.SyncGetChangeProofRequest,
+	startRoot ids.ID,
+	endRoot ids.ID,
+	keyLimit uint32,
+	bytesLimit int,
+) ([]byte, *common.AppError) {
+	var (
+		start = maybeBytesToMaybe(req.StartKey)
+		end   = maybeBytesToMaybe(req.EndKey)
+	)
 	for keyLimit > 0 {
 		changeProof, err := g.db.GetChangeProof(ctx, startRoot, endRoot, start, end, int(keyLimit))
 		if err != nil {
@@ -144,8 +295,8 @@ func (g *GetChangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _
 					RootHash:   req.EndRootHash,
 					StartKey:   req.StartKey,
 					EndKey:     req.EndKey,
-					KeyLimit:   req.KeyLimit,
-					BytesLimit: req.BytesLimit,
+					KeyLimit:   keyLimit,
+					BytesLimit: uint32(bytesLimit),
 				},
 				func(rangeProof *merkledb.RangeProof) ([]byte, error) {
 					return proto.Marshal(&pb.SyncGetChangeProofResponse{
@@ -192,21 +343,25 @@ func (g *GetChangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _
 	}
 }
 
+// NewGetRangeProofHandler returns a handler that neither throttles requests
+// nor caches proofs.
 func NewGetRangeProofHandler(log logging.Logger, db DB) *GetRangeProofHandler {
-	return &GetRangeProofHandler{
-		log: log,
-		db:  db,
-	}
+	return newUnlimitedServer("").NewGetRangeProofHandler(log, db)
 }
 
 type GetRangeProofHandler struct {
-	log logging.Logger
-	db  DB
+	log    logging.Logger
+	db     DB
+	server *Server
 }
 
 func (*GetRangeProofHandler) AppGossip(context.Context, ids.NodeID, []byte) {}
 
-func (g *GetRangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _ time.Time, requestBytes []byte) ([]byte, *common.AppError) {
+func (g *GetRangeProofHandler) AppRequest(ctx context.Context, nodeID ids.NodeID, _ time.Time, requestBytes []byte) ([]byte, *common.AppError) {
+	if !g.server.handle(nodeID) {
+		return nil, p2p.ErrThrottled
+	}
+
 	req := &pb.SyncGetRangeProofRequest{}
 	if err := proto.Unmarshal(requestBytes, req); err != nil {
 		return nil, &common.AppError{
@@ -226,22 +381,38 @@ func (g *GetRangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _ t
 	req.KeyLimit = min(req.KeyLimit, maxKeyValuesLimit)
 	req.BytesLimit = min(req.BytesLimit, maxByteSizeLimit)
 
-	proofBytes, err := getRangeProof(
-		ctx,
-		g.db,
-		req,
-		func(rangeProof *merkledb.RangeProof) ([]byte, error) {
-			return proto.Marshal(rangeProof.ToProto())
-		},
-	)
+	root, err := ids.ToID(req.RootHash)
 	if err != nil {
 		return nil, &common.AppError{
 			Code:    p2p.ErrUnexpected.Code,
-			Message: fmt.Sprintf("failed to get range proof: %s", err),
+			Message: fmt.Sprintf("failed to parse root hash: %s", err),
 		}
 	}
 
-	return proofBytes, nil
+	key := proofCacheKey{
+		endRoot:    root,
+		start:      maybeBytesToMaybeString(maybeBytesToMaybe(req.StartKey)),
+		end:        maybeBytesToMaybeString(maybeBytesToMaybe(req.EndKey)),
+		keyLimit:   req.KeyLimit,
+		bytesLimit: req.BytesLimit,
+	}
+	return g.server.serve(key, func() ([]byte, *common.AppError) {
+		proofBytes, err := getRangeProof(
+			ctx,
+			g.db,
+			req,
+			func(rangeProof *merkledb.RangeProof) ([]byte, error) {
+				return proto.Marshal(rangeProof.ToProto())
+			},
+		)
+		if err != nil {
+			return nil, &common.AppError{
+				Code:    p2p.ErrUnexpected.Code,
+				Message: fmt.Sprintf("failed to get range proof: %s", err),
+			}
+		}
+		return proofBytes, nil
+	})
 }
 
 // Get the range proof specified by [req].
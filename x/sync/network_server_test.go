@@ -9,6 +9,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/ava-labs/avalanchego/network/p2p"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/x/merkledb"
 
 	pb "github.com/ava-labs/avalanchego/proto/pb/sync"
@@ -375,3 +378,138 @@ func Test_Server_GetChangeProof(t *testing.T) {
 		})
 	}
 }
+
+func TestServerThrottling(t *testing.T) {
+	require := require.New(t)
+
+	r := rand.New(rand.NewSource(1)) // #nosec G404
+	db, err := generateTrieWithMinKeyLen(t, r, 100, 1)
+	require.NoError(err)
+	root, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	server, err := NewServer(
+		ServerConfig{
+			ThrottlePeriod: time.Hour,
+			ThrottleLimit:  2,
+		},
+		"",
+		prometheus.NewRegistry(),
+	)
+	require.NoError(err)
+	rangeProofHandler := server.NewGetRangeProofHandler(logging.NoLog{}, db)
+	changeProofHandler := server.NewGetChangeProofHandler(logging.NoLog{}, db)
+
+	rangeProofRequest, err := proto.Marshal(&pb.SyncGetRangeProofRequest{
+		RootHash:   root[:],
+		KeyLimit:   defaultRequestKeyLimit,
+		BytesLimit: defaultRequestByteSizeLimit,
+	})
+	require.NoError(err)
+	changeProofRequest, err := proto.Marshal(&pb.SyncGetChangeProofRequest{
+		StartRootHash: ids.Empty[:],
+		EndRootHash:   root[:],
+		KeyLimit:      defaultRequestKeyLimit,
+		BytesLimit:    defaultRequestByteSizeLimit,
+	})
+	require.NoError(err)
+
+	// Both handlers share the same request budget.
+	nodeID := ids.GenerateTestNodeID()
+	_, appErr := rangeProofHandler.AppRequest(context.Background(), nodeID, time.Time{}, rangeProofRequest)
+	require.Nil(appErr)
+	_, appErr = changeProofHandler.AppRequest(context.Background(), nodeID, time.Time{}, changeProofRequest)
+	require.Nil(appErr)
+	_, appErr = rangeProofHandler.AppRequest(context.Background(), nodeID, time.Time{}, rangeProofRequest)
+	require.ErrorIs(appErr, p2p.ErrThrottled)
+	_, appErr = changeProofHandler.AppRequest(context.Background(), nodeID, time.Time{}, changeProofRequest)
+	require.ErrorIs(appErr, p2p.ErrThrottled)
+	require.Equal(float64(2), testutil.ToFloat64(server.metrics.requestsRejected))
+
+	// Other peers have their own budget.
+	_, appErr = rangeProofHandler.AppRequest(context.Background(), ids.GenerateTestNodeID(), time.Time{}, rangeProofRequest)
+	require.Nil(appErr)
+}
+
+func TestServerProofCache(t *testing.T) {
+	require := require.New(t)
+
+	r := rand.New(rand.NewSource(1)) // #nosec G404
+	db, err := generateTrieWithMinKeyLen(t, r, 100, 1)
+	require.NoError(err)
+	root, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	server, err := NewServer(
+		ServerConfig{
+			ProofCacheSize: units.MiB,
+		},
+		"",
+		prometheus.NewRegistry(),
+	)
+	require.NoError(err)
+	rangeProofHandler := server.NewGetRangeProofHandler(logging.NoLog{}, db)
+	changeProofHandler := server.NewGetChangeProofHandler(logging.NoLog{}, db)
+
+	rangeProofRequest := &pb.SyncGetRangeProofRequest{
+		RootHash:   root[:],
+		KeyLimit:   10,
+		BytesLimit: defaultRequestByteSizeLimit,
+	}
+	requestBytes, err := proto.Marshal(rangeProofRequest)
+	require.NoError(err)
+	expectedProofBytes, appErr := rangeProofHandler.AppRequest(context.Background(), ids.EmptyNodeID, time.Time{}, requestBytes)
+	require.Nil(appErr)
+	require.Equal(float64(0), testutil.ToFloat64(server.metrics.proofCacheHits))
+	require.Equal(float64(1), testutil.ToFloat64(server.metrics.proofCacheMisses))
+
+	// Modifying the database doesn't change the proof at [root], so it's
+	// served from the cache.
+	require.NoError(db.Put([]byte{1}, []byte{1}))
+	proofBytes, appErr := rangeProofHandler.AppRequest(context.Background(), ids.EmptyNodeID, time.Time{}, requestBytes)
+	require.Nil(appErr)
+	require.Equal(expectedProofBytes, proofBytes)
+	require.Equal(float64(1), testutil.ToFloat64(server.metrics.proofCacheHits))
+	require.Equal(float64(2*len(proofBytes)), testutil.ToFloat64(server.metrics.bytesServed))
+
+	// Requests with different limits require a new proof.
+	rangeProofRequest.KeyLimit = 5
+	requestBytes, err = proto.Marshal(rangeProofRequest)
+	require.NoError(err)
+	proofBytes, appErr = rangeProofHandler.AppRequest(context.Background(), ids.EmptyNodeID, time.Time{}, requestBytes)
+	require.Nil(appErr)
+	require.NotEqual(expectedProofBytes, proofBytes)
+	require.Equal(float64(2), testutil.ToFloat64(server.metrics.proofCacheMisses))
+
+	// Change proofs aren't confused with range proofs, even if the change
+	// proof request is served with a range proof.
+	fakeRootID := ids.GenerateTestID()
+	requestBytes, err = proto.Marshal(&pb.SyncGetChangeProofRequest{
+		StartRootHash: fakeRootID[:],
+		EndRootHash:   root[:],
+		KeyLimit:      10,
+		BytesLimit:    defaultRequestByteSizeLimit,
+	})
+	require.NoError(err)
+	proofBytes, appErr = changeProofHandler.AppRequest(context.Background(), ids.EmptyNodeID, time.Time{}, requestBytes)
+	require.Nil(appErr)
+	proofResult := &pb.SyncGetChangeProofResponse{}
+	require.NoError(proto.Unmarshal(proofBytes, proofResult))
+	require.NotNil(proofResult.GetRangeProof())
+	require.Equal(float64(3), testutil.ToFloat64(server.metrics.proofCacheMisses))
+
+	// Dropped requests aren't cached.
+	requestBytes, err = proto.Marshal(&pb.SyncGetRangeProofRequest{
+		RootHash:   fakeRootID[:],
+		KeyLimit:   10,
+		BytesLimit: defaultRequestByteSizeLimit,
+	})
+	require.NoError(err)
+	for i := 0; i < 2; i++ {
+		proofBytes, appErr = rangeProofHandler.AppRequest(context.Background(), ids.EmptyNodeID, time.Time{}, requestBytes)
+		require.Nil(appErr)
+		require.Nil(proofBytes)
+	}
+	require.Equal(float64(1), testutil.ToFloat64(server.metrics.proofCacheHits))
+	require.Equal(float64(5), testutil.ToFloat64(server.metrics.proofCacheMisses))
+}
@@ -0,0 +1,185 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sync
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+const (
+	// Latency assumed for a peer that hasn't responded to any requests yet.
+	initialPeerLatency = 100 * time.Millisecond
+	// Latency recorded for a peer when a request to it fails.
+	failedRequestLatency = 10 * time.Second
+	peerLatencyHalflife  = time.Minute
+	// Portion of requests sent to an arbitrary peer, rather than to the best
+	// scored peer, when no state sync nodes are specified.
+	peerExplorationRate = 0.1
+)
+
+var errNoValidPeers = errors.New("all state sync nodes served invalid proofs")
+
+type peerScore struct {
+	// Average time the peer takes to respond to a request.
+	latency safemath.Averager
+	// Number of requests sent to the peer that haven't finished.
+	pendingRequests int
+}
+
+// Returns the expected time until the peer responds to another request.
+// Lower is better.
+func (p *peerScore) score() float64 {
+	return p.latency.Read() * float64(1+p.pendingRequests)
+}
+
+// peerScorer tracks how quickly peers respond to proof requests and whether
+// the proofs they serve are valid, so that requests are spread across fast
+// peers that serve valid proofs.
+type peerScorer struct {
+	lock sync.Mutex
+	// If non-empty, only these peers are sent requests.
+	stateSyncNodes []ids.NodeID
+	// Peers that served an invalid proof. They aren't selected for the
+	// remainder of the sync.
+	blacklist set.Set[ids.NodeID]
+	peers     map[ids.NodeID]*peerScore
+}
+
+func newPeerScorer(stateSyncNodes []ids.NodeID) *peerScorer {
+	return &peerScorer{
+		stateSyncNodes: stateSyncNodes,
+		peers:          make(map[ids.NodeID]*peerScore),
+	}
+}
+
+// selectPeer returns the peer the next request should be sent to.
+// Returns false if the request should be sent to an arbitrary peer.
+// Returns [errNoValidPeers] if every state sync node is blacklisted.
+func (p *peerScorer) selectPeer() (ids.NodeID, bool, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var (
+		bestNodeID ids.NodeID
+		bestScore  = math.Inf(1)
+		found      bool
+	)
+	if len(p.stateSyncNodes) == 0 {
+		// Occasionally request an arbitrary peer to discover peers that are
+		// better than the peers we know about.
+		if rand.Float64() < peerExplorationRate { // #nosec G404
+			return ids.EmptyNodeID, false, nil
+		}
+
+		for nodeID, peer := range p.peers {
+			if p.blacklist.Contains(nodeID) {
+				continue
+			}
+			if score := peer.score(); score < bestScore {
+				bestNodeID, bestScore, found = nodeID, score, true
+			}
+		}
+		return bestNodeID, found, nil
+	}
+
+	for _, nodeID := range p.stateSyncNodes {
+		if p.blacklist.Contains(nodeID) {
+			continue
+		}
+		if score := p.getPeer(nodeID).score(); score < bestScore {
+			bestNodeID, bestScore, found = nodeID, score, true
+		}
+	}
+	if !found {
+		return ids.EmptyNodeID, false, errNoValidPeers
+	}
+	return bestNodeID, true, nil
+}
+
+// registerRequest records that a request was sent to [nodeID].
+func (p *peerScorer) registerRequest(nodeID ids.NodeID) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.getPeer(nodeID).pendingRequests++
+}
+
+// registerResponse records that [nodeID] served a valid proof after
+// [latency].
+// [selected] is true iff the request was sent to a peer returned by
+// [selectPeer].
+func (p *peerScorer) registerResponse(nodeID ids.NodeID, selected bool, latency time.Duration) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.finishRequest(nodeID, selected, latency)
+}
+
+// registerFailure records that a request to [nodeID] failed without [nodeID]
+// serving a proof.
+// [selected] is true iff the request was sent to a peer returned by
+// [selectPeer].
+func (p *peerScorer) registerFailure(nodeID ids.NodeID, selected bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.finishRequest(nodeID, selected, failedRequestLatency)
+}
+
+// registerInvalidResponse records that [nodeID] served an invalid proof and
+// blacklists it.
+// [selected] is true iff the request was sent to a peer returned by
+// [selectPeer].
+func (p *peerScorer) registerInvalidResponse(nodeID ids.NodeID, selected bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.finishRequest(nodeID, selected, failedRequestLatency)
+	p.blacklist.Add(nodeID)
+}
+
+func (p *peerScorer) isBlacklisted(nodeID ids.NodeID) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return p.blacklist.Contains(nodeID)
+}
+
+// blacklisted returns the peers that served an invalid proof.
+func (p *peerScorer) blacklisted() set.Set[ids.NodeID] {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return set.Of(p.blacklist.List()...)
+}
+
+// Assumes [p.lock] is held.
+func (p *peerScorer) finishRequest(nodeID ids.NodeID, selected bool, latency time.Duration) {
+	peer := p.getPeer(nodeID)
+	if selected {
+		peer.pendingRequests--
+	}
+	peer.latency.Observe(float64(latency), time.Now())
+}
+
+// Assumes [p.lock] is held.
+func (p *peerScorer) getPeer(nodeID ids.NodeID) *peerScore {
+	peer, ok := p.peers[nodeID]
+	if !ok {
+		peer = &peerScore{
+			latency: safemath.NewAverager(float64(initialPeerLatency), peerLatencyHalflife, time.Now()),
+		}
+		p.peers[nodeID] = peer
+	}
+	return peer
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+func TestPeerScorerStateSyncNodes(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		fastNodeID = ids.GenerateTestNodeID()
+		slowNodeID = ids.GenerateTestNodeID()
+		peers      = newPeerScorer([]ids.NodeID{slowNodeID, fastNodeID})
+	)
+
+	// Peers that haven't been scored are tried first.
+	nodeID, selected, err := peers.selectPeer()
+	require.NoError(err)
+	require.True(selected)
+	require.Equal(slowNodeID, nodeID)
+	peers.registerRequest(slowNodeID)
+
+	// Pending requests spread work across peers.
+	nodeID, selected, err = peers.selectPeer()
+	require.NoError(err)
+	require.True(selected)
+	require.Equal(fastNodeID, nodeID)
+	peers.registerRequest(fastNodeID)
+
+	peers.registerResponse(slowNodeID, true, time.Second)
+	peers.registerResponse(fastNodeID, true, time.Millisecond)
+
+	// Faster peers are preferred.
+	nodeID, _, err = peers.selectPeer()
+	require.NoError(err)
+	require.Equal(fastNodeID, nodeID)
+
+	// Failed requests lower a peer's score.
+	peers.registerRequest(fastNodeID)
+	peers.registerFailure(fastNodeID, true)
+	nodeID, _, err = peers.selectPeer()
+	require.NoError(err)
+	require.Equal(slowNodeID, nodeID)
+
+	// Peers that serve invalid proofs are never selected again.
+	peers.registerRequest(slowNodeID)
+	peers.registerInvalidResponse(slowNodeID, true)
+	require.True(peers.isBlacklisted(slowNodeID))
+	for i := 0; i < 10; i++ {
+		nodeID, _, err = peers.selectPeer()
+		require.NoError(err)
+		require.Equal(fastNodeID, nodeID)
+	}
+
+	peers.registerInvalidResponse(fastNodeID, false)
+	_, _, err = peers.selectPeer()
+	require.ErrorIs(err, errNoValidPeers)
+}
+
+func TestPeerScorerArbitraryPeers(t *testing.T) {
+	require := require.New(t)
+
+	peers := newPeerScorer(nil)
+
+	// Without any known peers, requests are sent to arbitrary peers.
+	_, selected, err := peers.selectPeer()
+	require.NoError(err)
+	require.False(selected)
+
+	// Peers that responded are selected, except when exploring.
+	nodeID := ids.GenerateTestNodeID()
+	peers.registerResponse(nodeID, false, time.Millisecond)
+	numSelected := 0
+	for i := 0; i < 1000; i++ {
+		selectedNodeID, selected, err := peers.selectPeer()
+		require.NoError(err)
+		if selected {
+			require.Equal(nodeID, selectedNodeID)
+			numSelected++
+		}
+	}
+	require.Greater(numSelected, 800)
+	require.Less(numSelected, 1000)
+
+	// If all known peers are blacklisted, arbitrary peers are requested.
+	peers.registerInvalidResponse(nodeID, false)
+	require.Equal(set.Of(nodeID), peers.blacklisted())
+	for i := 0; i < 10; i++ {
+		_, selected, err = peers.selectPeer()
+		require.NoError(err)
+		require.False(selected)
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sync
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/maybe"
+)
+
+var (
+	rangesCompletedKey = []byte("rangesCompleted")
+	keysFetchedKey     = []byte("keysFetched")
+	bytesFetchedKey    = []byte("bytesFetched")
+	targetRootKey      = []byte("targetRoot")
+	sessionKeysKey     = []byte("sessionKeys")
+	completedKey       = []byte("completed")
+
+	errInvalidCompletedRanges = errors.New("invalid completed ranges")
+)
+
+// Progress of a sync.
+//
+// The counters are persisted, if a progress database was provided, so that
+// they account for the work performed before a restart.
+type Progress struct {
+	// RangesCompleted is the number of key ranges that were fully fetched.
+	RangesCompleted uint64 `json:"rangesCompleted"`
+	// KeysFetched is the number of keys received in proofs, including keys
+	// that were fetched again after a restart or a change of the sync target.
+	KeysFetched uint64 `json:"keysFetched"`
+	// BytesFetched is the number of bytes of proofs received.
+	BytesFetched uint64 `json:"bytesFetched"`
+	// FractionCompleted is the fraction of the key space, in [0, 1], that has
+	// been synced to the current target root.
+	FractionCompleted float64 `json:"fractionCompleted"`
+	// EstimatedRemainingKeys is the number of keys that are expected to still
+	// be fetched, assuming that keys are uniformly distributed over the key
+	// space.
+	EstimatedRemainingKeys uint64 `json:"estimatedRemainingKeys"`
+}
+
+type progressTracker struct {
+	// [db] is nil if the progress isn't persisted.
+	db database.KeyValueReaderWriterDeleter
+
+	lock       sync.Mutex
+	progress   Progress
+	targetRoot ids.ID
+	// sessionKeys is the number of keys fetched since the sync target was
+	// set, which is used to estimate the number of remaining keys.
+	sessionKeys uint64
+	// completed is the sorted set of disjoint intervals of the key space that
+	// have been synced to [targetRoot]. Ranges that are synced again after a
+	// restart are only counted once.
+	completed []keyInterval
+
+	rangesCompleted        prometheus.Gauge
+	keysFetched            prometheus.Gauge
+	bytesFetched           prometheus.Gauge
+	fractionCompleted      prometheus.Gauge
+	estimatedRemainingKeys prometheus.Gauge
+}
+
+func newProgressTracker(
+	db database.KeyValueReaderWriterDeleter,
+	namespace string,
+	reg prometheus.Registerer,
+) (*progressTracker, error) {
+	p := &progressTracker{
+		db: db,
+		rangesCompleted: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "ranges_completed",
+			Help:      "number of key ranges that were fully fetched",
+		}),
+		keysFetched: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "keys_fetched",
+			Help:      "number of keys received in proofs",
+		}),
+		bytesFetched: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "bytes_fetched",
+			Help:      "number of bytes of proofs received",
+		}),
+		fractionCompleted: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "fraction_completed",
+			Help:      "fraction of the key space that has been synced to the current target root",
+		}),
+		estimatedRemainingKeys: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "estimated_remaining_keys",
+			Help:      "estimated number of keys that still need to be fetched",
+		}),
+	}
+	err := errors.Join(
+		reg.Register(p.rangesCompleted),
+		reg.Register(p.keysFetched),
+		reg.Register(p.bytesFetched),
+		reg.Register(p.fractionCompleted),
+		reg.Register(p.estimatedRemainingKeys),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if db != nil {
+		if p.progress.RangesCompleted, err = database.WithDefault(database.GetUInt64, db, rangesCompletedKey, 0); err != nil {
+			return nil, err
+		}
+		if p.progress.KeysFetched, err = database.WithDefault(database.GetUInt64, db, keysFetchedKey, 0); err != nil {
+			return nil, err
+		}
+		if p.progress.BytesFetched, err = database.WithDefault(database.GetUInt64, db, bytesFetchedKey, 0); err != nil {
+			return nil, err
+		}
+		if p.targetRoot, err = database.WithDefault(database.GetID, db, targetRootKey, ids.Empty); err != nil {
+			return nil, err
+		}
+		if p.sessionKeys, err = database.WithDefault(database.GetUInt64, db, sessionKeysKey, 0); err != nil {
+			return nil, err
+		}
+		completedBytes, err := db.Get(completedKey)
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return nil, err
+		}
+		if p.completed, err = parseKeyIntervals(completedBytes); err != nil {
+			return nil, err
+		}
+	}
+	p.updateFraction()
+	p.updateEstimate()
+	p.updateMetrics()
+	return p, nil
+}
+
+// Get returns the current progress.
+func (p *progressTracker) Get() Progress {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return p.progress
+}
+
+// Fetched records that a proof of [numBytes] containing [numKeys] keys was
+// received.
+func (p *progressTracker) Fetched(numKeys int, numBytes int) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.progress.KeysFetched += uint64(numKeys)
+	p.progress.BytesFetched += uint64(numBytes)
+	p.sessionKeys += uint64(numKeys)
+	p.updateEstimate()
+	p.updateMetrics()
+
+	if p.db == nil {
+		return nil
+	}
+	return errors.Join(
+		database.PutUInt64(p.db, keysFetchedKey, p.progress.KeysFetched),
+		database.PutUInt64(p.db, bytesFetchedKey, p.progress.BytesFetched),
+		database.PutUInt64(p.db, sessionKeysKey, p.sessionKeys),
+	)
+}
+
+// RangeCompleted records that the key range [start, end] was synced.
+func (p *progressTracker) RangeCompleted(start, end maybe.Maybe[[]byte]) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.progress.RangesCompleted++
+	p.completed = insertKeyInterval(p.completed, keyInterval{
+		start: keyPosition(start, 0),
+		end:   keyPosition(end, 1),
+	})
+	p.updateFraction()
+	p.updateEstimate()
+	p.updateMetrics()
+
+	if p.db == nil {
+		return nil
+	}
+	return errors.Join(
+		database.PutUInt64(p.db, rangesCompletedKey, p.progress.RangesCompleted),
+		p.db.Put(completedKey, marshalKeyIntervals(p.completed)),
+	)
+}
+
+// SetTarget records that the sync is towards [targetRoot]. If the target
+// differs from the previous one, the previously synced ranges must be synced
+// again to the new target root.
+func (p *progressTracker) SetTarget(targetRoot ids.ID) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.targetRoot == targetRoot {
+		return nil
+	}
+
+	p.targetRoot = targetRoot
+	p.completed = nil
+	p.sessionKeys = 0
+	p.updateFraction()
+	p.updateEstimate()
+	p.updateMetrics()
+
+	if p.db == nil {
+		return nil
+	}
+	return errors.Join(
+		database.PutID(p.db, targetRootKey, targetRoot),
+		p.db.Delete(sessionKeysKey),
+		p.db.Delete(completedKey),
+	)
+}
+
+// Done records that the sync completed and removes the persisted progress, so
+// that a future sync starts from zero.
+func (p *progressTracker) Done() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.progress.FractionCompleted = 1
+	p.progress.EstimatedRemainingKeys = 0
+	p.updateMetrics()
+
+	if p.db == nil {
+		return nil
+	}
+	return errors.Join(
+		p.db.Delete(rangesCompletedKey),
+		p.db.Delete(keysFetchedKey),
+		p.db.Delete(bytesFetchedKey),
+		p.db.Delete(targetRootKey),
+		p.db.Delete(sessionKeysKey),
+		p.db.Delete(completedKey),
+	)
+}
+
+// updateFraction assumes [p.lock] is held.
+func (p *progressTracker) updateFraction() {
+	var fraction float64
+	for _, interval := range p.completed {
+		fraction += interval.end - interval.start
+	}
+	p.progress.FractionCompleted = min(fraction, 1)
+}
+
+// updateEstimate assumes [p.lock] is held.
+func (p *progressTracker) updateEstimate() {
+	fraction := p.progress.FractionCompleted
+	if fraction <= 0 {
+		p.progress.EstimatedRemainingKeys = 0
+		return
+	}
+	estimatedTotal := float64(p.sessionKeys) / fraction
+	p.progress.EstimatedRemainingKeys = uint64(max(estimatedTotal-float64(p.sessionKeys), 0))
+}
+
+// updateMetrics assumes [p.lock] is held.
+func (p *progressTracker) updateMetrics() {
+	p.rangesCompleted.Set(float64(p.progress.RangesCompleted))
+	p.keysFetched.Set(float64(p.progress.KeysFetched))
+	p.bytesFetched.Set(float64(p.progress.BytesFetched))
+	p.fractionCompleted.Set(p.progress.FractionCompleted)
+	p.estimatedRemainingKeys.Set(float64(p.progress.EstimatedRemainingKeys))
+}
+
+// keyPosition returns the position of [key] in the key space as a number in
+// [0, 1]. If [key] is Nothing, [unbounded] is returned.
+func keyPosition(key maybe.Maybe[[]byte], unbounded float64) float64 {
+	if key.IsNothing() {
+		return unbounded
+	}
+
+	var prefix [8]byte
+	copy(prefix[:], key.Value())
+	return float64(binary.BigEndian.Uint64(prefix[:])) / (math.MaxUint64 + 1.0)
+}
+
+// keyIntervalLen is the length of a marshalled [keyInterval].
+const keyIntervalLen = 2 * 8
+
+// keyInterval is an interval of the key space, as returned by [keyPosition].
+type keyInterval struct {
+	start float64
+	end   float64
+}
+
+// insertKeyInterval adds [interval] to the sorted set of disjoint
+// [intervals], merging it with any intervals that it overlaps or touches.
+func insertKeyInterval(intervals []keyInterval, interval keyInterval) []keyInterval {
+	i, _ := slices.BinarySearchFunc(intervals, interval.start, func(existing keyInterval, start float64) int {
+		switch {
+		case existing.end < start:
+			return -1
+		case existing.end > start:
+			return 1
+		default:
+			return 0
+		}
+	})
+	j := i
+	for j < len(intervals) && intervals[j].start <= interval.end {
+		interval.start = min(interval.start, intervals[j].start)
+		interval.end = max(interval.end, intervals[j].end)
+		j++
+	}
+	return slices.Replace(intervals, i, j, interval)
+}
+
+func marshalKeyIntervals(intervals []keyInterval) []byte {
+	b := make([]byte, 0, keyIntervalLen*len(intervals))
+	for _, interval := range intervals {
+		b = binary.BigEndian.AppendUint64(b, math.Float64bits(interval.start))
+		b = binary.BigEndian.AppendUint64(b, math.Float64bits(interval.end))
+	}
+	return b
+}
+
+func parseKeyIntervals(b []byte) ([]keyInterval, error) {
+	if len(b)%keyIntervalLen != 0 {
+		return nil, errInvalidCompletedRanges
+	}
+	intervals := make([]keyInterval, len(b)/keyIntervalLen)
+	for i := range intervals {
+		intervals[i] = keyInterval{
+			start: math.Float64frombits(binary.BigEndian.Uint64(b[keyIntervalLen*i:])),
+			end:   math.Float64frombits(binary.BigEndian.Uint64(b[keyIntervalLen*i+8:])),
+		}
+	}
+	return intervals, nil
+}
@@ -0,0 +1,183 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sync
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/maybe"
+)
+
+func TestKeyPosition(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       maybe.Maybe[[]byte]
+		unbounded float64
+		expected  float64
+	}{
+		{
+			name:      "nothing start",
+			key:       maybe.Nothing[[]byte](),
+			unbounded: 0,
+			expected:  0,
+		},
+		{
+			name:      "nothing end",
+			key:       maybe.Nothing[[]byte](),
+			unbounded: 1,
+			expected:  1,
+		},
+		{
+			name:     "empty key",
+			key:      maybe.Some([]byte{}),
+			expected: 0,
+		},
+		{
+			name:     "middle",
+			key:      maybe.Some([]byte{0x80}),
+			expected: .5,
+		},
+		{
+			name:     "quarter",
+			key:      maybe.Some([]byte{0x40, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff}),
+			expected: .25,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.InDelta(t, test.expected, keyPosition(test.key, test.unbounded), 1e-9)
+		})
+	}
+}
+
+func TestProgressTrackerEstimate(t *testing.T) {
+	require := require.New(t)
+
+	p, err := newProgressTracker(nil, "", prometheus.NewRegistry())
+	require.NoError(err)
+
+	require.NoError(p.Fetched(100, 1000))
+	require.Zero(p.Get().EstimatedRemainingKeys)
+
+	// A quarter of the key space contained 100 keys, so 300 keys are expected
+	// to remain.
+	require.NoError(p.RangeCompleted(maybe.Nothing[[]byte](), maybe.Some([]byte{0x40})))
+	progress := p.Get()
+	require.Equal(Progress{
+		RangesCompleted:        1,
+		KeysFetched:            100,
+		BytesFetched:           1000,
+		FractionCompleted:      .25,
+		EstimatedRemainingKeys: 300,
+	}, progress)
+
+	require.NoError(p.SetTarget(ids.GenerateTestID()))
+	progress = p.Get()
+	require.Zero(progress.FractionCompleted)
+	require.Zero(progress.EstimatedRemainingKeys)
+	require.Equal(uint64(100), progress.KeysFetched)
+
+	require.NoError(p.Done())
+	progress = p.Get()
+	require.Equal(1.0, progress.FractionCompleted)
+	require.Zero(progress.EstimatedRemainingKeys)
+}
+
+func TestProgressTrackerRestart(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		db         = memdb.New()
+		targetRoot = ids.GenerateTestID()
+		quarter    = maybe.Some([]byte{0x40})
+	)
+	p, err := newProgressTracker(db, "", prometheus.NewRegistry())
+	require.NoError(err)
+	require.NoError(p.SetTarget(targetRoot))
+	require.NoError(p.Fetched(100, 1000))
+	require.NoError(p.RangeCompleted(maybe.Nothing[[]byte](), quarter))
+	expected := p.Get()
+
+	p, err = newProgressTracker(db, "", prometheus.NewRegistry())
+	require.NoError(err)
+	require.Equal(expected, p.Get())
+
+	// Syncing a range again after the restart must not count it twice.
+	require.NoError(p.SetTarget(targetRoot))
+	require.NoError(p.RangeCompleted(maybe.Nothing[[]byte](), quarter))
+	progress := p.Get()
+	require.InDelta(.25, progress.FractionCompleted, 1e-9)
+	require.Equal(uint64(300), progress.EstimatedRemainingKeys)
+
+	// Restarting towards a different target must discard the synced ranges.
+	p, err = newProgressTracker(db, "", prometheus.NewRegistry())
+	require.NoError(err)
+	require.NoError(p.SetTarget(ids.GenerateTestID()))
+	progress = p.Get()
+	require.Zero(progress.FractionCompleted)
+	require.Zero(progress.EstimatedRemainingKeys)
+	require.Equal(uint64(100), progress.KeysFetched)
+}
+
+func TestInsertKeyInterval(t *testing.T) {
+	tests := []struct {
+		name      string
+		intervals []keyInterval
+		interval  keyInterval
+		expected  []keyInterval
+	}{
+		{
+			name:     "empty",
+			interval: keyInterval{start: .25, end: .5},
+			expected: []keyInterval{{start: .25, end: .5}},
+		},
+		{
+			name:      "disjoint before",
+			intervals: []keyInterval{{start: .5, end: .75}},
+			interval:  keyInterval{start: 0, end: .25},
+			expected:  []keyInterval{{start: 0, end: .25}, {start: .5, end: .75}},
+		},
+		{
+			name:      "disjoint after",
+			intervals: []keyInterval{{start: 0, end: .25}},
+			interval:  keyInterval{start: .5, end: .75},
+			expected:  []keyInterval{{start: 0, end: .25}, {start: .5, end: .75}},
+		},
+		{
+			name:      "adjacent",
+			intervals: []keyInterval{{start: 0, end: .25}, {start: .5, end: .75}},
+			interval:  keyInterval{start: .25, end: .5},
+			expected:  []keyInterval{{start: 0, end: .75}},
+		},
+		{
+			name:      "duplicate",
+			intervals: []keyInterval{{start: 0, end: .25}},
+			interval:  keyInterval{start: 0, end: .25},
+			expected:  []keyInterval{{start: 0, end: .25}},
+		},
+		{
+			name:      "covering",
+			intervals: []keyInterval{{start: .25, end: .3}, {start: .4, end: .5}},
+			interval:  keyInterval{start: .2, end: .6},
+			expected:  []keyInterval{{start: .2, end: .6}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			intervals := insertKeyInterval(test.intervals, test.interval)
+			require.Equal(test.expected, intervals)
+
+			parsed, err := parseKeyIntervals(marshalKeyIntervals(intervals))
+			require.NoError(err)
+			require.Equal(intervals, parsed)
+		})
+	}
+}
@@ -6,22 +6,28 @@ package sync
 import (
 	"bytes"
 	"context"
+	"errors"
 	"math/rand"
 	"slices"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/p2p"
 	"github.com/ava-labs/avalanchego/network/p2p/p2ptest"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/maybe"
 	"github.com/ava-labs/avalanchego/x/merkledb"
+
+	pb "github.com/ava-labs/avalanchego/proto/pb/sync"
 )
 
 var _ p2p.Handler = (*waitingHandler)(nil)
@@ -777,7 +783,7 @@ func Test_Sync_Result_Correct_Root(t *testing.T) {
 					response.KeyValues = append(response.KeyValues, merkledb.KeyValue{})
 				})
 
-				return p2ptest.NewSelfClient(t, context.Background(), ids.EmptyNodeID, handler)
+				return newClientWithPeers(t, handler, NewGetRangeProofHandler(logging.NoLog{}, db))
 			},
 		},
 		{
@@ -787,7 +793,7 @@ func Test_Sync_Result_Correct_Root(t *testing.T) {
 					response.KeyValues = response.KeyValues[min(1, len(response.KeyValues)):]
 				})
 
-				return p2ptest.NewSelfClient(t, context.Background(), ids.EmptyNodeID, handler)
+				return newClientWithPeers(t, handler, NewGetRangeProofHandler(logging.NoLog{}, db))
 			},
 		},
 		{
@@ -813,7 +819,7 @@ func Test_Sync_Result_Correct_Root(t *testing.T) {
 					}
 				})
 
-				return p2ptest.NewSelfClient(t, context.Background(), ids.EmptyNodeID, handler)
+				return newClientWithPeers(t, handler, NewGetRangeProofHandler(logging.NoLog{}, db))
 			},
 		},
 		{
@@ -824,7 +830,7 @@ func Test_Sync_Result_Correct_Root(t *testing.T) {
 					_ = slices.Delete(response.KeyValues, i, min(len(response.KeyValues), i+1))
 				})
 
-				return p2ptest.NewSelfClient(t, context.Background(), ids.EmptyNodeID, handler)
+				return newClientWithPeers(t, handler, NewGetRangeProofHandler(logging.NoLog{}, db))
 			},
 		},
 		{
@@ -835,7 +841,7 @@ func Test_Sync_Result_Correct_Root(t *testing.T) {
 					response.EndProof = nil
 				})
 
-				return p2ptest.NewSelfClient(t, context.Background(), ids.EmptyNodeID, handler)
+				return newClientWithPeers(t, handler, NewGetRangeProofHandler(logging.NoLog{}, db))
 			},
 		},
 		{
@@ -845,7 +851,7 @@ func Test_Sync_Result_Correct_Root(t *testing.T) {
 					response.EndProof = nil
 				})
 
-				return p2ptest.NewSelfClient(t, context.Background(), ids.EmptyNodeID, handler)
+				return newClientWithPeers(t, handler, NewGetRangeProofHandler(logging.NoLog{}, db))
 			},
 		},
 		{
@@ -857,16 +863,17 @@ func Test_Sync_Result_Correct_Root(t *testing.T) {
 					response.KeyValues = nil
 				})
 
-				return p2ptest.NewSelfClient(t, context.Background(), ids.EmptyNodeID, handler)
+				return newClientWithPeers(t, handler, NewGetRangeProofHandler(logging.NoLog{}, db))
 			},
 		},
 		{
 			name: "range proof server flake",
 			rangeProofClient: func(db merkledb.MerkleDB) *p2p.Client {
-				return p2ptest.NewSelfClient(t, context.Background(), ids.EmptyNodeID, &flakyHandler{
+				handler := &flakyHandler{
 					Handler: NewGetRangeProofHandler(logging.NoLog{}, db),
 					c:       &counter{m: 2},
-				})
+				}
+				return newClientWithPeers(t, handler, handler)
 			},
 		},
 		{
@@ -876,7 +883,7 @@ func Test_Sync_Result_Correct_Root(t *testing.T) {
 					response.KeyChanges = append(response.KeyChanges, make([]merkledb.KeyChange, defaultRequestKeyLimit)...)
 				})
 
-				return p2ptest.NewSelfClient(t, context.Background(), ids.EmptyNodeID, handler)
+				return newClientWithPeers(t, handler, NewGetChangeProofHandler(logging.NoLog{}, db))
 			},
 		},
 		{
@@ -886,7 +893,7 @@ func Test_Sync_Result_Correct_Root(t *testing.T) {
 					response.KeyChanges = response.KeyChanges[min(1, len(response.KeyChanges)):]
 				})
 
-				return p2ptest.NewSelfClient(t, context.Background(), ids.EmptyNodeID, handler)
+				return newClientWithPeers(t, handler, NewGetChangeProofHandler(logging.NoLog{}, db))
 			},
 		},
 		{
@@ -897,7 +904,7 @@ func Test_Sync_Result_Correct_Root(t *testing.T) {
 					_ = slices.Delete(response.KeyChanges, i, min(len(response.KeyChanges), i+1))
 				})
 
-				return p2ptest.NewSelfClient(t, context.Background(), ids.EmptyNodeID, handler)
+				return newClientWithPeers(t, handler, NewGetChangeProofHandler(logging.NoLog{}, db))
 			},
 		},
 		{
@@ -908,16 +915,17 @@ func Test_Sync_Result_Correct_Root(t *testing.T) {
 					response.EndProof = nil
 				})
 
-				return p2ptest.NewSelfClient(t, context.Background(), ids.EmptyNodeID, handler)
+				return newClientWithPeers(t, handler, NewGetChangeProofHandler(logging.NoLog{}, db))
 			},
 		},
 		{
 			name: "change proof flaky server",
 			changeProofClient: func(db merkledb.MerkleDB) *p2p.Client {
-				return p2ptest.NewSelfClient(t, context.Background(), ids.EmptyNodeID, &flakyHandler{
+				handler := &flakyHandler{
 					Handler: NewGetChangeProofHandler(logging.NoLog{}, db),
 					c:       &counter{m: 2},
-				})
+				}
+				return newClientWithPeers(t, handler, handler)
 			},
 		},
 	}
@@ -946,13 +954,13 @@ func Test_Sync_Result_Correct_Root(t *testing.T) {
 			)
 
 			rangeProofHandler := NewGetRangeProofHandler(logging.NoLog{}, dbToSync)
-			rangeProofClient = p2ptest.NewSelfClient(t, ctx, ids.EmptyNodeID, rangeProofHandler)
+			rangeProofClient = newClientWithPeers(t, rangeProofHandler, rangeProofHandler)
 			if tt.rangeProofClient != nil {
 				rangeProofClient = tt.rangeProofClient(dbToSync)
 			}
 
 			changeProofHandler := NewGetChangeProofHandler(logging.NoLog{}, dbToSync)
-			changeProofClient = p2ptest.NewSelfClient(t, ctx, ids.EmptyNodeID, changeProofHandler)
+			changeProofClient = newClientWithPeers(t, changeProofHandler, changeProofHandler)
 			if tt.changeProofClient != nil {
 				changeProofClient = tt.changeProofClient(dbToSync)
 			}
@@ -1077,6 +1085,81 @@ func Test_Sync_Result_Correct_Root_With_Sync_Restart(t *testing.T) {
 	require.Equal(syncRoot, newRoot)
 }
 
+func Test_Sync_Progress_With_Sync_Restart(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Now().UnixNano()
+	t.Logf("seed: %d", now)
+	r := rand.New(rand.NewSource(now)) // #nosec G404
+	dbToSync, err := generateTrie(t, r, 3*maxKeyValuesLimit)
+	require.NoError(err)
+	syncRoot, err := dbToSync.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	db, err := merkledb.New(
+		context.Background(),
+		memdb.New(),
+		newDefaultDBConfig(),
+	)
+	require.NoError(err)
+
+	ctx := context.Background()
+	progressDB := memdb.New()
+	newSyncer := func() *Manager {
+		syncer, err := NewManager(ManagerConfig{
+			DB:                    db,
+			RangeProofClient:      p2ptest.NewSelfClient(t, ctx, ids.EmptyNodeID, NewGetRangeProofHandler(logging.NoLog{}, dbToSync)),
+			ChangeProofClient:     p2ptest.NewSelfClient(t, ctx, ids.EmptyNodeID, NewGetChangeProofHandler(logging.NoLog{}, dbToSync)),
+			TargetRoot:            syncRoot,
+			SimultaneousWorkLimit: 5,
+			Log:                   logging.NoLog{},
+			BranchFactor:          merkledb.BranchFactor16,
+			ProgressDB:            progressDB,
+		}, prometheus.NewRegistry())
+		require.NoError(err)
+		return syncer
+	}
+
+	syncer := newSyncer()
+	require.Zero(syncer.Progress())
+	require.NoError(syncer.Start(context.Background()))
+
+	// Wait until we've processed some work before restarting.
+	require.Eventually(
+		func() bool {
+			return syncer.Progress().RangesCompleted > 0
+		},
+		5*time.Second,
+		5*time.Millisecond,
+	)
+	progress := syncer.Progress()
+	require.Positive(progress.KeysFetched)
+	require.Positive(progress.BytesFetched)
+	require.Positive(progress.FractionCompleted)
+	syncer.Close()
+
+	// The progress made before the restart is still reported.
+	restartedSyncer := newSyncer()
+	restartedProgress := restartedSyncer.Progress()
+	require.GreaterOrEqual(restartedProgress.RangesCompleted, progress.RangesCompleted)
+	require.GreaterOrEqual(restartedProgress.KeysFetched, progress.KeysFetched)
+	require.GreaterOrEqual(restartedProgress.BytesFetched, progress.BytesFetched)
+	require.GreaterOrEqual(restartedProgress.FractionCompleted, progress.FractionCompleted)
+
+	require.NoError(restartedSyncer.Start(context.Background()))
+	require.NoError(restartedSyncer.Wait(context.Background()))
+
+	finalProgress := restartedSyncer.Progress()
+	require.Greater(finalProgress.RangesCompleted, restartedProgress.RangesCompleted)
+	require.Equal(1.0, finalProgress.FractionCompleted)
+	require.Zero(finalProgress.EstimatedRemainingKeys)
+
+	// The persisted progress is removed once the sync completes.
+	size, err := database.Count(progressDB)
+	require.NoError(err)
+	require.Zero(size)
+}
+
 func Test_Sync_Result_Correct_Root_Update_Root_During(t *testing.T) {
 	t.Skip("FLAKY")
 
@@ -1289,3 +1372,154 @@ func generateTrieWithMinKeyLen(t *testing.T, r *rand.Rand, count int, minKeyLen
 	}
 	return db, batch.Write()
 }
+
+func TestSyncBlacklistsPeersServingInvalidProofs(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Now().UnixNano()
+	t.Logf("seed: %d", now)
+	r := rand.New(rand.NewSource(now)) // #nosec G404
+
+	ctx := context.Background()
+	dbToSync, err := generateTrie(t, r, 3*maxKeyValuesLimit)
+	require.NoError(err)
+	syncRoot, err := dbToSync.GetMerkleRoot(ctx)
+	require.NoError(err)
+
+	db, err := merkledb.New(
+		ctx,
+		memdb.New(),
+		newDefaultDBConfig(),
+	)
+	require.NoError(err)
+
+	var (
+		honestNodeID         = ids.GenerateTestNodeID()
+		maliciousNodeID      = ids.GenerateTestNodeID()
+		rangeProofHandler    = NewGetRangeProofHandler(logging.NoLog{}, dbToSync)
+		numMaliciousRequests atomic.Int32
+	)
+	maliciousHandler := &p2p.TestHandler{
+		AppRequestF: func(ctx context.Context, nodeID ids.NodeID, deadline time.Time, requestBytes []byte) ([]byte, *common.AppError) {
+			numMaliciousRequests.Add(1)
+
+			responseBytes, appErr := rangeProofHandler.AppRequest(ctx, nodeID, deadline, requestBytes)
+			if appErr != nil {
+				return nil, appErr
+			}
+
+			response := &pb.RangeProof{}
+			require.NoError(proto.Unmarshal(responseBytes, response))
+			response.EndProof = nil
+			responseBytes, err := proto.Marshal(response)
+			require.NoError(err)
+			return responseBytes, nil
+		},
+	}
+
+	syncer, err := NewManager(ManagerConfig{
+		DB: db,
+		RangeProofClient: p2ptest.NewClientWithPeers(t, ctx, ids.EmptyNodeID, p2p.NoOpHandler{}, map[ids.NodeID]p2p.Handler{
+			honestNodeID:    rangeProofHandler,
+			maliciousNodeID: maliciousHandler,
+		}),
+		ChangeProofClient: p2ptest.NewClientWithPeers(t, ctx, ids.EmptyNodeID, p2p.NoOpHandler{}, map[ids.NodeID]p2p.Handler{
+			honestNodeID:    NewGetChangeProofHandler(logging.NoLog{}, dbToSync),
+			maliciousNodeID: p2p.NoOpHandler{},
+		}),
+		TargetRoot:            syncRoot,
+		SimultaneousWorkLimit: 5,
+		Log:                   logging.NoLog{},
+		BranchFactor:          merkledb.BranchFactor16,
+		// The malicious node is selected first.
+		StateSyncNodes: []ids.NodeID{maliciousNodeID, honestNodeID},
+	}, prometheus.NewRegistry())
+	require.NoError(err)
+
+	require.NoError(syncer.Start(ctx))
+	require.NoError(syncer.Wait(ctx))
+
+	root, err := db.GetMerkleRoot(ctx)
+	require.NoError(err)
+	require.Equal(syncRoot, root)
+
+	require.True(syncer.peers.isBlacklisted(maliciousNodeID))
+	require.False(syncer.peers.isBlacklisted(honestNodeID))
+	require.Equal(int32(1), numMaliciousRequests.Load())
+}
+
+var (
+	flakyPeerNodeID  = ids.GenerateTestNodeID()
+	honestPeerNodeID = ids.GenerateTestNodeID()
+)
+
+// newClientWithPeers returns a client connected to [flakyPeerNodeID], served
+// by [flakyHandler], and to [honestPeerNodeID] and itself, served by
+// [honestHandler], so that syncing completes after [flakyPeerNodeID] is
+// blacklisted.
+//
+// The range and change proof clients of a sync must be connected to the same
+// peers, as peers are scored across both clients.
+func newClientWithPeers(t *testing.T, flakyHandler p2p.Handler, honestHandler p2p.Handler) *p2p.Client {
+	return p2ptest.NewClientWithPeers(t, context.Background(), ids.EmptyNodeID, honestHandler, map[ids.NodeID]p2p.Handler{
+		flakyPeerNodeID:  flakyHandler,
+		honestPeerNodeID: honestHandler,
+	})
+}
+
+func TestVerificationFailed(t *testing.T) {
+	var (
+		targetRoot = ids.GenerateTestID()
+		errTest    = errors.New("non-nil error")
+	)
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		requestRoot ids.ID
+		err         error
+		invalid     bool
+	}{
+		{
+			name:        "invalid proof",
+			ctx:         context.Background(),
+			requestRoot: targetRoot,
+			err:         errTest,
+			invalid:     true,
+		},
+		{
+			name:        "cancelled",
+			ctx:         cancelledCtx,
+			requestRoot: targetRoot,
+			err:         context.Canceled,
+		},
+		{
+			name:        "database closed",
+			ctx:         context.Background(),
+			requestRoot: targetRoot,
+			err:         database.ErrClosed,
+		},
+		{
+			name:        "stale root",
+			ctx:         context.Background(),
+			requestRoot: ids.GenerateTestID(),
+			err:         errTest,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			m := &Manager{
+				config: ManagerConfig{
+					TargetRoot: targetRoot,
+				},
+			}
+			err := m.verificationFailed(test.ctx, test.requestRoot, test.err)
+			require.ErrorIs(err, test.err)
+			require.Equal(test.invalid, errors.Is(err, errInvalidResponse))
+		})
+	}
+}